@@ -3,6 +3,7 @@ package admin
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	stderrors "errors"
 	"fmt"
 	"os"
@@ -519,12 +520,16 @@ argocd admin settings resource-overrides health ./deploy.yaml --argocd-cm-path .
 }
 
 func NewResourceActionListCommand(cmdCtx commandContext) *cobra.Command {
+	var output string
 	command := &cobra.Command{
 		Use:   "list-actions RESOURCE_YAML_PATH",
 		Short: "List available resource actions",
-		Long:  "List actions available for given resource action using the lua scripts configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
+		Long:  "List actions available for given resource action using the lua scripts configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and the built-in resource customizations",
 		Example: `
-argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-cm-path ./argocd-cm.yaml`,
+argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-cm-path ./argocd-cm.yaml
+
+# Print the discovered actions with their parameters as JSON
+argocd admin settings resource-overrides action list /tmp/deploy.yaml -o json --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -533,16 +538,15 @@ argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-c
 				os.Exit(1)
 			}
 
-			executeResourceOverrideCommand(ctx, cmdCtx, args, func(res unstructured.Unstructured, override v1alpha1.ResourceOverride, overrides map[string]v1alpha1.ResourceOverride) {
+			executeResourceOverrideCommand(ctx, cmdCtx, args, func(res unstructured.Unstructured, _ v1alpha1.ResourceOverride, overrides map[string]v1alpha1.ResourceOverride) {
 				gvk := res.GroupVersionKind()
-				if override.Actions == "" {
-					_, _ = fmt.Printf("Actions are not configured for '%s/%s'\n", gvk.Group, gvk.Kind)
-					return
-				}
-
 				luaVM := lua.VM{ResourceOverrides: overrides}
 				discoveryScript, err := luaVM.GetResourceActionDiscovery(&res)
 				errors.CheckError(err)
+				if len(discoveryScript) == 0 {
+					_, _ = fmt.Printf("Actions are not configured for '%s/%s'\n", gvk.Group, gvk.Kind)
+					return
+				}
 
 				availableActions, err := luaVM.ExecuteResourceActionDiscovery(&res, discoveryScript)
 				errors.CheckError(err)
@@ -550,26 +554,68 @@ argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-c
 					return availableActions[i].Name < availableActions[j].Name
 				})
 
-				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-				_, _ = fmt.Fprintf(w, "NAME\tDISABLED\n")
-				for _, action := range availableActions {
-					_, _ = fmt.Fprintf(w, "%s\t%s\n", action.Name, strconv.FormatBool(action.Disabled))
+				switch output {
+				case "json":
+					jsonBytes, err := json.MarshalIndent(availableActions, "", "  ")
+					errors.CheckError(err)
+					fmt.Println(string(jsonBytes))
+				case "yaml":
+					yamlBytes, err := yaml.Marshal(availableActions)
+					errors.CheckError(err)
+					fmt.Print(string(yamlBytes))
+				case "wide":
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					_, _ = fmt.Fprintf(w, "NAME\tDISPLAY NAME\tDISABLED\tPARAMS\n")
+					for _, action := range availableActions {
+						params := make([]string, 0, len(action.Params))
+						for _, param := range action.Params {
+							params = append(params, param.Name)
+						}
+						_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", action.Name, action.DisplayName, strconv.FormatBool(action.Disabled), strings.Join(params, ","))
+					}
+					_ = w.Flush()
+				case "":
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					_, _ = fmt.Fprintf(w, "NAME\tDISABLED\n")
+					for _, action := range availableActions {
+						_, _ = fmt.Fprintf(w, "%s\t%s\n", action.Name, strconv.FormatBool(action.Disabled))
+					}
+					_ = w.Flush()
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 				}
-				_ = w.Flush()
 			})
 		},
 	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide|json|yaml")
 	return command
 }
 
+// parseResourceActionParameters parses action parameters given in the key=value form
+func parseResourceActionParameters(params []string) ([]*lua.ResourceActionParameters, error) {
+	resourceActionParameters := lua.NewParams()
+	for _, param := range params {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid action parameter %q: expected the key=value format", param)
+		}
+		resourceActionParameters.Set(name, value)
+	}
+	return resourceActionParameters.Build(), nil
+}
+
 func NewResourceActionRunCommand(cmdCtx commandContext) *cobra.Command {
+	var params []string
 	command := &cobra.Command{
 		Use:     "run-action RESOURCE_YAML_PATH ACTION",
 		Aliases: []string{"action"},
 		Short:   "Executes resource action",
-		Long:    "Executes resource action using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
+		Long:    "Executes resource action using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap, or the built-in one, and outputs updated fields",
 		Example: `
-argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argocd-cm-path ./argocd-cm.yaml`,
+argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argocd-cm-path ./argocd-cm.yaml
+
+# Pass parameters to the action
+argocd admin settings resource-overrides action /tmp/deploy.yaml scale --param replicas=3 --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -578,20 +624,33 @@ argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argoc
 				os.Exit(1)
 			}
 			action := args[1]
+			resourceActionParameters, err := parseResourceActionParameters(params)
+			errors.CheckError(err)
 
 			executeResourceOverrideCommand(ctx, cmdCtx, args, func(res unstructured.Unstructured, override v1alpha1.ResourceOverride, overrides map[string]v1alpha1.ResourceOverride) {
 				gvk := res.GroupVersionKind()
-				if override.Actions == "" {
+				luaVM := lua.VM{ResourceOverrides: overrides}
+				// The built-in actions are run if the actions of the resource are not configured
+				discoveryScript, err := luaVM.GetResourceActionDiscovery(&res)
+				errors.CheckError(err)
+				if override.Actions == "" && len(discoveryScript) == 0 {
 					_, _ = fmt.Printf("Actions are not configured for '%s/%s'\n", gvk.Group, gvk.Kind)
 					return
 				}
 
-				luaVM := lua.VM{ResourceOverrides: overrides}
 				action, err := luaVM.GetResourceAction(&res, action)
 				errors.CheckError(err)
 
-				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua)
+				actionResult, err := luaVM.ExecuteResourceActionDefinition(&res, action, resourceActionParameters)
 				errors.CheckError(err)
+				modifiedRes := actionResult.ImpactedResources
+
+				if actionResult.Message != "" {
+					_, _ = fmt.Printf("%s\n", actionResult.Message)
+				}
+				for _, warning := range actionResult.Warnings {
+					_, _ = fmt.Printf("Warning: %s\n", warning)
+				}
 
 				for _, impactedResource := range modifiedRes {
 					result := impactedResource.UnstructuredObj
@@ -615,5 +674,6 @@ argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argoc
 			})
 		},
 	}
+	command.Flags().StringArrayVar(&params, "param", []string{}, "Action parameter in the key=value format, this flag can be repeated to specify multiple parameters")
 	return command
 }
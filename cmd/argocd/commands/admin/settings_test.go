@@ -2,11 +2,13 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"os"
 	"testing"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	utils "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
@@ -354,12 +356,16 @@ func TestResourceOverrideAction(t *testing.T) {
 	require.NoError(t, err)
 	defer utils.Close(closer)
 
+	customResourceFile, closer, err := tempFile(testCustomResourceYAML)
+	require.NoError(t, err)
+	defer utils.Close(closer)
+
 	t.Run("NoActions", func(t *testing.T) {
 		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
-			"resource.customizations": `apps/Deployment: {}`,
+			"resource.customizations": `example.com/ExampleResource: {}`,
 		}))
 		out, err := captureStdout(func() {
-			cmd.SetArgs([]string{"run-action", f, "test"})
+			cmd.SetArgs([]string{"run-action", customResourceFile, "test"})
 			err := cmd.Execute()
 			require.NoError(t, err)
 		})
@@ -403,6 +409,68 @@ resume   false
 `)
 	})
 
+	t.Run("BuiltInActions", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"list-actions", f})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, `NAME     DISABLED
+restart  false
+resume   true
+`)
+
+		out, err = captureStdout(func() {
+			cmd.SetArgs([]string{"list-actions", f, "-o", "json"})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		var actions []v1alpha1.ResourceAction
+		require.NoError(t, json.Unmarshal([]byte(out), &actions))
+		assert.Equal(t, []v1alpha1.ResourceAction{
+			{Name: "restart"},
+			{Name: "resume", Disabled: true},
+		}, actions)
+	})
+
+	t.Run("BuiltInActionWithoutOverride", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"run-action", f, "pause"})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "paused: true")
+	})
+
+	t.Run("ActionWithParameters", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
+			"resource.customizations": `apps/Deployment:
+  actions: |
+    discovery.lua: |
+      actions = {}
+      actions["scale"] = {["disabled"] = false}
+      return actions
+    definitions:
+    - name: scale
+      action.lua: |
+        obj.spec.replicas = tonumber(actionParams["replicas"])
+        return obj
+`,
+		}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"run-action", f, "scale", "--param", "replicas=3"})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "replicas: 3")
+	})
+
 	t.Run("NewStyleActionConfigured", func(t *testing.T) {
 		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
 			"resource.customizations": `batch/CronJob:
@@ -450,3 +518,16 @@ resume   false
 		assert.Contains(t, out, "false")
 	})
 }
+
+func TestParseResourceActionParameters(t *testing.T) {
+	params, err := parseResourceActionParameters([]string{"replicas=3", "image=nginx:1.2=3"})
+	require.NoError(t, err)
+	require.Len(t, params, 2)
+	assert.Equal(t, "replicas", params[0].GetName())
+	assert.Equal(t, "3", params[0].GetValue())
+	assert.Equal(t, "image", params[1].GetName())
+	assert.Equal(t, "nginx:1.2=3", params[1].GetValue())
+
+	_, err = parseResourceActionParameters([]string{"replicas"})
+	require.ErrorContains(t, err, `invalid action parameter "replicas"`)
+}
@@ -200,6 +200,10 @@ const (
 	// can be disregarded.
 	AnnotationIgnoreHealthCheck = "argocd.argoproj.io/ignore-healthcheck"
 
+	// AnnotationResourceActions holds resource action definitions overriding the built-in and bundled actions of the
+	// resource it is set on, in the format of the definitions of resource.customizations.actions in argocd-cm
+	AnnotationResourceActions = "argocd.argoproj.io/resource-actions"
+
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
 	// AnnotationValueManagedByArgoCD is a 'managed-by' annotation value for resources managed by Argo CD
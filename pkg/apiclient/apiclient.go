@@ -210,6 +210,10 @@ func NewClient(opts *ClientOptions) (Client, error) {
 		}
 		serverPodLabelSelector := common.LabelKeyAppName + "=" + opts.ServerName
 		port, err := kube.PortForward(8080, opts.PortForwardNamespace, opts.KubeOverrides, serverPodLabelSelector)
+		var upgradeErr *kube.UpgradeError
+		if errors.As(err, &upgradeErr) {
+			return nil, fmt.Errorf("%w - websocket tunneling of port-forwards can be disabled by setting the KUBECTL_PORT_FORWARD_WEBSOCKETS environment variable to false", err)
+		}
 		if err != nil {
 			return nil, err
 		}
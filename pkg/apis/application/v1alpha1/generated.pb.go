@@ -3513,10 +3513,38 @@ func (m *ResourceActionParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 
+func (m *ResourceActionParamCondition) Reset()      { *m = ResourceActionParamCondition{} }
+func (*ResourceActionParamCondition) ProtoMessage() {}
+func (*ResourceActionParamCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{124}
+}
+func (m *ResourceActionParamCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceActionParamCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourceActionParamCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionParamCondition.Merge(m, src)
+}
+func (m *ResourceActionParamCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceActionParamCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionParamCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceActionParamCondition proto.InternalMessageInfo
+
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3572,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3600,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3628,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3656,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3684,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +3712,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3712,7 +3740,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +3768,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +3796,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +3824,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +3852,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +3880,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +3908,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +3936,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +3964,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +3992,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4020,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{142}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4048,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4076,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4104,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4132,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4160,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4188,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4216,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4244,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4272,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4300,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4328,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4356,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4384,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4412,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4440,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4468,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4496,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4496,7 +4524,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4552,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4580,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4580,7 +4608,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4746,8 +4774,10 @@ func init() {
 	proto.RegisterType((*RepositoryCertificateList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RepositoryCertificateList")
 	proto.RegisterType((*RepositoryList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.RepositoryList")
 	proto.RegisterType((*ResourceAction)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceAction")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceAction.LocalizedDisplayNamesEntry")
 	proto.RegisterType((*ResourceActionDefinition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActionDefinition")
 	proto.RegisterType((*ResourceActionParam)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActionParam")
+	proto.RegisterType((*ResourceActionParamCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActionParamCondition")
 	proto.RegisterType((*ResourceActions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceActions")
 	proto.RegisterType((*ResourceDiff)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceDiff")
 	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
@@ -4797,757 +4827,773 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 11997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x6c, 0x24, 0xc9,
-	0x75, 0x18, 0xae, 0x9e, 0xe1, 0x90, 0x33, 0x8f, 0x5f, 0xbb, 0xb5, 0xbb, 0x77, 0xdc, 0xbd, 0x0f,
-	0xae, 0xfb, 0xe4, 0xd3, 0xf9, 0xa7, 0x3b, 0xd2, 0xf7, 0x25, 0xef, 0xcf, 0x67, 0x4b, 0xe6, 0xc7,
-	0x7e, 0x70, 0x97, 0x5c, 0xf2, 0x6a, 0xb8, 0xbb, 0xd2, 0x49, 0xa7, 0x53, 0x73, 0xa6, 0x38, 0xec,
-	0x65, 0x4f, 0xf7, 0x5c, 0x77, 0x0f, 0x97, 0x73, 0x96, 0x64, 0xc9, 0x92, 0x6c, 0xd9, 0xfa, 0x8c,
-	0x64, 0xc4, 0xe7, 0x24, 0x52, 0x64, 0x5b, 0x09, 0x12, 0x04, 0x82, 0x95, 0x18, 0x48, 0x1c, 0xd8,
-	0x86, 0x61, 0x3b, 0x31, 0x94, 0x38, 0x81, 0x1d, 0x41, 0xb0, 0x9c, 0xd8, 0x66, 0xa4, 0x4d, 0x02,
-	0x19, 0x01, 0x62, 0x20, 0x4e, 0xfe, 0x08, 0x36, 0x41, 0x10, 0xd4, 0x77, 0x75, 0x4f, 0x0f, 0x39,
-	0x5c, 0x36, 0xb9, 0x2b, 0xe9, 0xfe, 0x9b, 0xa9, 0xf7, 0xba, 0xde, 0xeb, 0xea, 0xaa, 0xf7, 0x5e,
-	0xbd, 0x7a, 0xef, 0x15, 0x2c, 0x36, 0xdc, 0x78, 0xa3, 0xbd, 0x36, 0x55, 0x0b, 0x9a, 0xd3, 0x4e,
-	0xd8, 0x08, 0x5a, 0x61, 0x70, 0x93, 0xfd, 0x78, 0xaa, 0x56, 0x9f, 0xde, 0x7a, 0x76, 0xba, 0xb5,
-	0xd9, 0x98, 0x76, 0x5a, 0x6e, 0x34, 0xed, 0xb4, 0x5a, 0x9e, 0x5b, 0x73, 0x62, 0x37, 0xf0, 0xa7,
-	0xb7, 0x9e, 0x76, 0xbc, 0xd6, 0x86, 0xf3, 0xf4, 0x74, 0x83, 0xf8, 0x24, 0x74, 0x62, 0x52, 0x9f,
-	0x6a, 0x85, 0x41, 0x1c, 0xa0, 0x1f, 0xd3, 0xbd, 0x4d, 0xc9, 0xde, 0xd8, 0x8f, 0x57, 0x6a, 0xf5,
-	0xa9, 0xad, 0x67, 0xa7, 0x5a, 0x9b, 0x8d, 0x29, 0xda, 0xdb, 0x94, 0xd1, 0xdb, 0x94, 0xec, 0xed,
-	0xcc, 0x53, 0x06, 0x2f, 0x8d, 0xa0, 0x11, 0x4c, 0xb3, 0x4e, 0xd7, 0xda, 0xeb, 0xec, 0x1f, 0xfb,
-	0xc3, 0x7e, 0x71, 0x62, 0x67, 0xec, 0xcd, 0x73, 0xd1, 0x94, 0x1b, 0x50, 0xf6, 0xa6, 0x6b, 0x41,
-	0x48, 0xa6, 0xb7, 0xba, 0x18, 0x3a, 0x73, 0x49, 0xe3, 0x90, 0xed, 0x98, 0xf8, 0x91, 0x1b, 0xf8,
-	0xd1, 0x53, 0x94, 0x05, 0x12, 0x6e, 0x91, 0xd0, 0x7c, 0x3d, 0x03, 0x21, 0xab, 0xa7, 0xe7, 0x74,
-	0x4f, 0x4d, 0xa7, 0xb6, 0xe1, 0xfa, 0x24, 0xec, 0xe8, 0xc7, 0x9b, 0x24, 0x76, 0xb2, 0x9e, 0x9a,
-	0xee, 0xf5, 0x54, 0xd8, 0xf6, 0x63, 0xb7, 0x49, 0xba, 0x1e, 0x78, 0xdb, 0x5e, 0x0f, 0x44, 0xb5,
-	0x0d, 0xd2, 0x74, 0xba, 0x9e, 0x7b, 0xb6, 0xd7, 0x73, 0xed, 0xd8, 0xf5, 0xa6, 0x5d, 0x3f, 0x8e,
-	0xe2, 0x30, 0xfd, 0x90, 0xfd, 0x77, 0x2c, 0x18, 0x9d, 0xb9, 0x51, 0x9d, 0x69, 0xc7, 0x1b, 0x73,
-	0x81, 0xbf, 0xee, 0x36, 0xd0, 0xf3, 0x30, 0x5c, 0xf3, 0xda, 0x51, 0x4c, 0xc2, 0xab, 0x4e, 0x93,
-	0x4c, 0x58, 0x67, 0xad, 0x27, 0x2a, 0xb3, 0x27, 0xbe, 0xb6, 0x33, 0xf9, 0xa6, 0xdb, 0x3b, 0x93,
-	0xc3, 0x73, 0x1a, 0x84, 0x4d, 0x3c, 0xf4, 0x43, 0x30, 0x14, 0x06, 0x1e, 0x99, 0xc1, 0x57, 0x27,
-	0x0a, 0xec, 0x91, 0x71, 0xf1, 0xc8, 0x10, 0xe6, 0xcd, 0x58, 0xc2, 0x29, 0x6a, 0x2b, 0x0c, 0xd6,
-	0x5d, 0x8f, 0x4c, 0x14, 0x93, 0xa8, 0x2b, 0xbc, 0x19, 0x4b, 0xb8, 0xfd, 0x27, 0x05, 0x80, 0x99,
-	0x56, 0x6b, 0x25, 0x0c, 0x6e, 0x92, 0x5a, 0x8c, 0xde, 0x07, 0x65, 0x3a, 0xcc, 0x75, 0x27, 0x76,
-	0x18, 0x63, 0xc3, 0xcf, 0xfc, 0xf0, 0x14, 0x7f, 0xeb, 0x29, 0xf3, 0xad, 0xf5, 0x24, 0xa3, 0xd8,
-	0x53, 0x5b, 0x4f, 0x4f, 0x2d, 0xaf, 0xd1, 0xe7, 0x97, 0x48, 0xec, 0xcc, 0x22, 0x41, 0x0c, 0x74,
-	0x1b, 0x56, 0xbd, 0x22, 0x1f, 0x06, 0xa2, 0x16, 0xa9, 0xb1, 0x77, 0x18, 0x7e, 0x66, 0x71, 0xea,
-	0x20, 0xb3, 0x79, 0x4a, 0x73, 0x5e, 0x6d, 0x91, 0xda, 0xec, 0x88, 0xa0, 0x3c, 0x40, 0xff, 0x61,
-	0x46, 0x07, 0x6d, 0xc1, 0x60, 0x14, 0x3b, 0x71, 0x3b, 0x62, 0x43, 0x31, 0xfc, 0xcc, 0xd5, 0xdc,
-	0x28, 0xb2, 0x5e, 0x67, 0xc7, 0x04, 0xcd, 0x41, 0xfe, 0x1f, 0x0b, 0x6a, 0xf6, 0x5f, 0x58, 0x30,
-	0xa6, 0x91, 0x17, 0xdd, 0x28, 0x46, 0xef, 0xe9, 0x1a, 0xdc, 0xa9, 0xfe, 0x06, 0x97, 0x3e, 0xcd,
-	0x86, 0xf6, 0x98, 0x20, 0x56, 0x96, 0x2d, 0xc6, 0xc0, 0x36, 0xa1, 0xe4, 0xc6, 0xa4, 0x19, 0x4d,
-	0x14, 0xce, 0x16, 0x9f, 0x18, 0x7e, 0xe6, 0x52, 0x5e, 0xef, 0x39, 0x3b, 0x2a, 0x88, 0x96, 0x16,
-	0x68, 0xf7, 0x98, 0x53, 0xb1, 0xff, 0x7a, 0xd4, 0x7c, 0x3f, 0x3a, 0xe0, 0xe8, 0x69, 0x18, 0x8e,
-	0x82, 0x76, 0x58, 0x23, 0x98, 0xb4, 0x82, 0x68, 0xc2, 0x3a, 0x5b, 0xa4, 0x53, 0x8f, 0x4e, 0xea,
-	0xaa, 0x6e, 0xc6, 0x26, 0x0e, 0xfa, 0xb4, 0x05, 0x23, 0x75, 0x12, 0xc5, 0xae, 0xcf, 0xe8, 0x4b,
-	0xe6, 0x57, 0x0f, 0xcc, 0xbc, 0x6c, 0x9c, 0xd7, 0x9d, 0xcf, 0x9e, 0x14, 0x2f, 0x32, 0x62, 0x34,
-	0x46, 0x38, 0x41, 0x9f, 0x2e, 0xce, 0x3a, 0x89, 0x6a, 0xa1, 0xdb, 0xa2, 0xff, 0xc5, 0xf2, 0x51,
-	0x8b, 0x73, 0x5e, 0x83, 0xb0, 0x89, 0x87, 0x7c, 0x28, 0xd1, 0xc5, 0x17, 0x4d, 0x0c, 0x30, 0xfe,
-	0x17, 0x0e, 0xc6, 0xbf, 0x18, 0x54, 0xba, 0xae, 0xf5, 0xe8, 0xd3, 0x7f, 0x11, 0xe6, 0x64, 0xd0,
-	0xa7, 0x2c, 0x98, 0x10, 0xc2, 0x01, 0x13, 0x3e, 0xa0, 0x37, 0x36, 0xdc, 0x98, 0x78, 0x6e, 0x14,
-	0x4f, 0x94, 0x18, 0x0f, 0xd3, 0xfd, 0xcd, 0xad, 0x8b, 0x61, 0xd0, 0x6e, 0x5d, 0x71, 0xfd, 0xfa,
-	0xec, 0x59, 0x41, 0x69, 0x62, 0xae, 0x47, 0xc7, 0xb8, 0x27, 0x49, 0xf4, 0x79, 0x0b, 0xce, 0xf8,
-	0x4e, 0x93, 0x44, 0x2d, 0x87, 0x7e, 0x5a, 0x0e, 0x9e, 0xf5, 0x9c, 0xda, 0x26, 0xe3, 0x68, 0xf0,
-	0xee, 0x38, 0xb2, 0x05, 0x47, 0x67, 0xae, 0xf6, 0xec, 0x1a, 0xef, 0x42, 0x16, 0xfd, 0xaa, 0x05,
-	0xc7, 0x83, 0xb0, 0xb5, 0xe1, 0xf8, 0xa4, 0x2e, 0xa1, 0xd1, 0xc4, 0x10, 0x5b, 0x7a, 0xef, 0x3d,
-	0xd8, 0x27, 0x5a, 0x4e, 0x77, 0xbb, 0x14, 0xf8, 0x6e, 0x1c, 0x84, 0x55, 0x12, 0xc7, 0xae, 0xdf,
-	0x88, 0x66, 0x4f, 0xdd, 0xde, 0x99, 0x3c, 0xde, 0x85, 0x85, 0xbb, 0xf9, 0x41, 0x3f, 0x09, 0xc3,
-	0x51, 0xc7, 0xaf, 0xdd, 0x70, 0xfd, 0x7a, 0x70, 0x2b, 0x9a, 0x28, 0xe7, 0xb1, 0x7c, 0xab, 0xaa,
-	0x43, 0xb1, 0x00, 0x35, 0x01, 0x6c, 0x52, 0xcb, 0xfe, 0x70, 0x7a, 0x2a, 0x55, 0xf2, 0xfe, 0x70,
-	0x7a, 0x32, 0xed, 0x42, 0x16, 0xfd, 0xac, 0x05, 0xa3, 0x91, 0xdb, 0xf0, 0x9d, 0xb8, 0x1d, 0x92,
-	0x2b, 0xa4, 0x13, 0x4d, 0x00, 0x63, 0xe4, 0xf2, 0x01, 0x47, 0xc5, 0xe8, 0x72, 0xf6, 0x94, 0xe0,
-	0x71, 0xd4, 0x6c, 0x8d, 0x70, 0x92, 0x6e, 0xd6, 0x42, 0xd3, 0xd3, 0x7a, 0x38, 0xdf, 0x85, 0xa6,
-	0x27, 0x75, 0x4f, 0x92, 0xe8, 0x27, 0xe0, 0x18, 0x6f, 0x52, 0x23, 0x1b, 0x4d, 0x8c, 0x30, 0x41,
-	0x7b, 0xf2, 0xf6, 0xce, 0xe4, 0xb1, 0x6a, 0x0a, 0x86, 0xbb, 0xb0, 0xd1, 0xab, 0x30, 0xd9, 0x22,
-	0x61, 0xd3, 0x8d, 0x97, 0x7d, 0xaf, 0x23, 0xc5, 0x77, 0x2d, 0x68, 0x91, 0xba, 0x60, 0x27, 0x9a,
-	0x18, 0x3d, 0x6b, 0x3d, 0x51, 0x9e, 0x7d, 0x8b, 0x60, 0x73, 0x72, 0x65, 0x77, 0x74, 0xbc, 0x57,
-	0x7f, 0xe8, 0x0f, 0x2c, 0x38, 0x63, 0x48, 0xd9, 0x2a, 0x09, 0xb7, 0xdc, 0x1a, 0x99, 0xa9, 0xd5,
-	0x82, 0xb6, 0x1f, 0x47, 0x13, 0x63, 0x6c, 0x18, 0xd7, 0x0e, 0x43, 0xe6, 0x27, 0x49, 0xe9, 0x79,
-	0xd9, 0x13, 0x25, 0xc2, 0xbb, 0x70, 0x6a, 0xff, 0xab, 0x02, 0x1c, 0x4b, 0x5b, 0x00, 0xe8, 0xef,
-	0x5b, 0x30, 0x7e, 0xf3, 0x56, 0xbc, 0x1a, 0x6c, 0x12, 0x3f, 0x9a, 0xed, 0x50, 0x39, 0xcd, 0x74,
-	0xdf, 0xf0, 0x33, 0xb5, 0x7c, 0x6d, 0x8d, 0xa9, 0xcb, 0x49, 0x2a, 0xe7, 0xfd, 0x38, 0xec, 0xcc,
-	0x3e, 0x28, 0xde, 0x69, 0xfc, 0xf2, 0x8d, 0x55, 0x13, 0x8a, 0xd3, 0x4c, 0x9d, 0xf9, 0x84, 0x05,
-	0x27, 0xb3, 0xba, 0x40, 0xc7, 0xa0, 0xb8, 0x49, 0x3a, 0xdc, 0x12, 0xc5, 0xf4, 0x27, 0x7a, 0x19,
-	0x4a, 0x5b, 0x8e, 0xd7, 0x26, 0xc2, 0x4c, 0xbb, 0x78, 0xb0, 0x17, 0x51, 0x9c, 0x61, 0xde, 0xeb,
-	0x8f, 0x16, 0xce, 0x59, 0xf6, 0x1f, 0x15, 0x61, 0xd8, 0xf8, 0x68, 0x47, 0x60, 0x7a, 0x06, 0x09,
-	0xd3, 0x73, 0x29, 0xb7, 0xf9, 0xd6, 0xd3, 0xf6, 0xbc, 0x95, 0xb2, 0x3d, 0x97, 0xf3, 0x23, 0xb9,
-	0xab, 0xf1, 0x89, 0x62, 0xa8, 0x04, 0x2d, 0xba, 0x0d, 0xa1, 0x36, 0xcc, 0x40, 0x1e, 0x9f, 0x70,
-	0x59, 0x76, 0x37, 0x3b, 0x7a, 0x7b, 0x67, 0xb2, 0xa2, 0xfe, 0x62, 0x4d, 0xc8, 0xfe, 0xa6, 0x05,
-	0x27, 0x0d, 0x1e, 0xe7, 0x02, 0xbf, 0xee, 0xb2, 0x4f, 0x7b, 0x16, 0x06, 0xe2, 0x4e, 0x4b, 0x6e,
-	0x75, 0xd4, 0x48, 0xad, 0x76, 0x5a, 0x04, 0x33, 0x08, 0xdd, 0xb1, 0x34, 0x49, 0x14, 0x39, 0x0d,
-	0x92, 0xde, 0xdc, 0x2c, 0xf1, 0x66, 0x2c, 0xe1, 0x28, 0x04, 0xe4, 0x39, 0x51, 0xbc, 0x1a, 0x3a,
-	0x7e, 0xc4, 0xba, 0x5f, 0x75, 0x9b, 0x44, 0x0c, 0xf0, 0xff, 0xd7, 0xdf, 0x8c, 0xa1, 0x4f, 0xcc,
-	0x3e, 0x70, 0x7b, 0x67, 0x12, 0x2d, 0x76, 0xf5, 0x84, 0x33, 0x7a, 0xb7, 0x3f, 0x6f, 0xc1, 0x03,
-	0xd9, 0x02, 0x06, 0x3d, 0x0e, 0x83, 0x7c, 0x9f, 0x2b, 0xde, 0x4e, 0x7f, 0x12, 0xd6, 0x8a, 0x05,
-	0x14, 0x4d, 0x43, 0x45, 0x29, 0x3c, 0xf1, 0x8e, 0xc7, 0x05, 0x6a, 0x45, 0x6b, 0x49, 0x8d, 0x43,
-	0x07, 0x8d, 0xfe, 0x11, 0x26, 0xa8, 0x1a, 0x34, 0xb6, 0x31, 0x64, 0x10, 0xfb, 0x1b, 0x16, 0xbc,
-	0xb9, 0x1f, 0xb1, 0x77, 0x78, 0x3c, 0x56, 0xe1, 0x54, 0x9d, 0xac, 0x3b, 0x6d, 0x2f, 0x4e, 0x52,
-	0x14, 0x4c, 0x3f, 0x22, 0x1e, 0x3e, 0x35, 0x9f, 0x85, 0x84, 0xb3, 0x9f, 0xb5, 0xff, 0xa3, 0x05,
-	0xe3, 0xc6, 0x6b, 0x1d, 0xc1, 0xd6, 0xc9, 0x4f, 0x6e, 0x9d, 0x16, 0x72, 0x5b, 0xa6, 0x3d, 0xf6,
-	0x4e, 0x9f, 0xb2, 0xe0, 0x8c, 0x81, 0xb5, 0xe4, 0xc4, 0xb5, 0x8d, 0xf3, 0xdb, 0xad, 0x90, 0x44,
-	0x11, 0x9d, 0x52, 0x8f, 0x18, 0xe2, 0x78, 0x76, 0x58, 0xf4, 0x50, 0xbc, 0x42, 0x3a, 0x5c, 0x36,
-	0x3f, 0x09, 0x65, 0xbe, 0xe6, 0x82, 0x50, 0x7c, 0x24, 0xf5, 0x6e, 0xcb, 0xa2, 0x1d, 0x2b, 0x0c,
-	0x64, 0xc3, 0x20, 0x93, 0xb9, 0x54, 0x06, 0x51, 0x33, 0x01, 0xe8, 0x77, 0xbf, 0xce, 0x5a, 0xb0,
-	0x80, 0xd8, 0x51, 0x82, 0x9d, 0x95, 0x90, 0xb0, 0xf9, 0x50, 0xbf, 0xe0, 0x12, 0xaf, 0x1e, 0xd1,
-	0x6d, 0x9d, 0xe3, 0xfb, 0x41, 0x2c, 0x76, 0x68, 0xc6, 0xb6, 0x6e, 0x46, 0x37, 0x63, 0x13, 0x87,
-	0x12, 0xf5, 0x9c, 0x35, 0xe2, 0xf1, 0x11, 0x15, 0x44, 0x17, 0x59, 0x0b, 0x16, 0x10, 0xfb, 0x76,
-	0x81, 0x6d, 0x20, 0x95, 0x44, 0x23, 0x47, 0xe1, 0x7d, 0x08, 0x13, 0x2a, 0x60, 0x25, 0x3f, 0x79,
-	0x4c, 0x7a, 0x7b, 0x20, 0x5e, 0x4b, 0x69, 0x01, 0x9c, 0x2b, 0xd5, 0xdd, 0xbd, 0x10, 0x1f, 0x2a,
-	0xc2, 0x64, 0xf2, 0x81, 0x2e, 0x25, 0x42, 0xb7, 0xbc, 0x06, 0xa1, 0xb4, 0x3f, 0xca, 0xc0, 0xc7,
-	0x26, 0x5e, 0x0f, 0x39, 0x5c, 0x38, 0x4c, 0x39, 0x6c, 0xaa, 0x89, 0xe2, 0x1e, 0x6a, 0xe2, 0x71,
-	0x35, 0xea, 0x03, 0x29, 0x99, 0x97, 0x54, 0x95, 0x67, 0x61, 0x20, 0x8a, 0x49, 0x6b, 0xa2, 0x94,
-	0x14, 0xb3, 0xd5, 0x98, 0xb4, 0x30, 0x83, 0xa0, 0x1f, 0x87, 0xf1, 0xd8, 0x09, 0x1b, 0x24, 0x0e,
-	0xc9, 0x96, 0xcb, 0x7c, 0x97, 0x6c, 0x3f, 0x5b, 0x99, 0x3d, 0x41, 0xad, 0xae, 0x55, 0x06, 0xc2,
-	0x12, 0x84, 0xd3, 0xb8, 0xf6, 0x7f, 0x2d, 0xc0, 0x83, 0xc9, 0x4f, 0xa0, 0x15, 0xe3, 0x3b, 0x12,
-	0x8a, 0xf1, 0xad, 0xa6, 0x62, 0xbc, 0xb3, 0x33, 0xf9, 0x50, 0x8f, 0xc7, 0xbe, 0x6b, 0xf4, 0x26,
-	0xba, 0x98, 0xfa, 0x08, 0xd3, 0xc9, 0x8f, 0x70, 0x67, 0x67, 0xf2, 0x91, 0x1e, 0xef, 0x98, 0xfa,
-	0x4a, 0x8f, 0xc3, 0x60, 0x48, 0x9c, 0x28, 0xf0, 0xc5, 0x77, 0x52, 0x5f, 0x13, 0xb3, 0x56, 0x2c,
-	0xa0, 0xf6, 0xd7, 0x2b, 0xe9, 0xc1, 0xbe, 0xc8, 0xfd, 0xb1, 0x41, 0x88, 0x5c, 0x18, 0x60, 0xbb,
-	0x36, 0x2e, 0x59, 0xae, 0x1c, 0x6c, 0x15, 0x52, 0x2d, 0xa2, 0xba, 0x9e, 0x2d, 0xd3, 0xaf, 0x46,
-	0x9b, 0x30, 0x23, 0x81, 0xb6, 0xa1, 0x5c, 0x93, 0x9b, 0xa9, 0x42, 0x1e, 0x6e, 0x47, 0xb1, 0x95,
-	0xd2, 0x14, 0x47, 0xa8, 0xb8, 0x57, 0x3b, 0x30, 0x45, 0x0d, 0x11, 0x28, 0x36, 0xdc, 0x58, 0x7c,
-	0xd6, 0x03, 0x6e, 0x97, 0x2f, 0xba, 0xc6, 0x2b, 0x0e, 0x51, 0x1d, 0x74, 0xd1, 0x8d, 0x31, 0xed,
-	0x1f, 0x7d, 0xcc, 0x82, 0xe1, 0xa8, 0xd6, 0x5c, 0x09, 0x83, 0x2d, 0xb7, 0x4e, 0x42, 0x61, 0x63,
-	0x1e, 0x50, 0xb2, 0x55, 0xe7, 0x96, 0x64, 0x87, 0x9a, 0x2e, 0x77, 0x5f, 0x68, 0x08, 0x36, 0xe9,
-	0xd2, 0xbd, 0xd7, 0x83, 0xe2, 0xdd, 0xe7, 0x49, 0x8d, 0xad, 0x38, 0xb9, 0x67, 0x66, 0x33, 0xe5,
-	0xc0, 0x36, 0xf7, 0x7c, 0xbb, 0xb6, 0x49, 0xd7, 0x9b, 0x66, 0xe8, 0xa1, 0xdb, 0x3b, 0x93, 0x0f,
-	0xce, 0x65, 0xd3, 0xc4, 0xbd, 0x98, 0x61, 0x03, 0xd6, 0x6a, 0x7b, 0x1e, 0x26, 0xaf, 0xb6, 0x09,
-	0xf3, 0x88, 0xe5, 0x30, 0x60, 0x2b, 0xba, 0xc3, 0xd4, 0x80, 0x19, 0x10, 0x6c, 0xd2, 0x45, 0xaf,
-	0xc2, 0x60, 0xd3, 0x89, 0x43, 0x77, 0x5b, 0xb8, 0xc1, 0x0e, 0xb8, 0x0b, 0x5a, 0x62, 0x7d, 0x69,
-	0xe2, 0x4c, 0xd1, 0xf3, 0x46, 0x2c, 0x08, 0xa1, 0x26, 0x94, 0x9a, 0x24, 0x6c, 0x90, 0x89, 0x72,
-	0x1e, 0x2e, 0xff, 0x25, 0xda, 0x95, 0x26, 0x58, 0xa1, 0xc6, 0x15, 0x6b, 0xc3, 0x9c, 0x0a, 0x7a,
-	0x19, 0xca, 0x11, 0xf1, 0x48, 0x8d, 0x9a, 0x47, 0x15, 0x46, 0xf1, 0xd9, 0x3e, 0x4d, 0x45, 0x6a,
-	0x97, 0x54, 0xc5, 0xa3, 0x7c, 0x81, 0xc9, 0x7f, 0x58, 0x75, 0x49, 0x07, 0xb0, 0xe5, 0xb5, 0x1b,
-	0xae, 0x3f, 0x01, 0x79, 0x0c, 0xe0, 0x0a, 0xeb, 0x2b, 0x35, 0x80, 0xbc, 0x11, 0x0b, 0x42, 0xf6,
-	0x7f, 0xb1, 0x00, 0x25, 0x85, 0xda, 0x11, 0xd8, 0xc4, 0xaf, 0x26, 0x6d, 0xe2, 0xc5, 0x3c, 0x8d,
-	0x96, 0x1e, 0x66, 0xf1, 0x6f, 0x56, 0x20, 0xa5, 0x0e, 0xae, 0x92, 0x28, 0x26, 0xf5, 0x37, 0x44,
-	0xf8, 0x1b, 0x22, 0xfc, 0x0d, 0x11, 0xae, 0x44, 0xf8, 0x5a, 0x4a, 0x84, 0xbf, 0xdd, 0x58, 0xf5,
-	0xfa, 0x7c, 0xfd, 0x15, 0x75, 0x00, 0x6f, 0x72, 0x60, 0x20, 0x50, 0x49, 0x70, 0xb9, 0xba, 0x7c,
-	0x35, 0x53, 0x66, 0xbf, 0x92, 0x94, 0xd9, 0x07, 0x25, 0xf1, 0xfd, 0x20, 0xa5, 0xff, 0xc0, 0x82,
-	0xb7, 0x24, 0xa5, 0x97, 0x9c, 0x39, 0x0b, 0x0d, 0x3f, 0x08, 0xc9, 0xbc, 0xbb, 0xbe, 0x4e, 0x42,
-	0xe2, 0xd7, 0x48, 0xa4, 0x7c, 0x3b, 0x56, 0x2f, 0xdf, 0x0e, 0x7a, 0x0e, 0x46, 0x6e, 0x46, 0x81,
-	0xbf, 0x12, 0xb8, 0xbe, 0x10, 0x41, 0x74, 0xc7, 0x71, 0xec, 0xf6, 0xce, 0xe4, 0x08, 0x1d, 0x51,
-	0xd9, 0x8e, 0x13, 0x58, 0x68, 0x0e, 0x8e, 0xdf, 0x7c, 0x75, 0xc5, 0x89, 0x0d, 0x6f, 0x82, 0xdc,
-	0xf7, 0xb3, 0xf3, 0xa8, 0xcb, 0x2f, 0xa6, 0x80, 0xb8, 0x1b, 0xdf, 0xfe, 0xdb, 0x05, 0x38, 0x9d,
-	0x7a, 0x91, 0xc0, 0xf3, 0x82, 0x76, 0x4c, 0xf7, 0x44, 0xe8, 0x8b, 0x16, 0x1c, 0x6b, 0x26, 0x1d,
-	0x16, 0x91, 0x70, 0x77, 0xbf, 0x33, 0x37, 0x1d, 0x91, 0xf2, 0x88, 0xcc, 0x4e, 0x88, 0x11, 0x3a,
-	0x96, 0x02, 0x44, 0xb8, 0x8b, 0x17, 0xf4, 0x32, 0x54, 0x9a, 0xce, 0xf6, 0xb5, 0x56, 0xdd, 0x89,
-	0xe5, 0x76, 0xb4, 0xb7, 0x17, 0xa1, 0x1d, 0xbb, 0xde, 0x14, 0x8f, 0xdc, 0x98, 0x5a, 0xf0, 0xe3,
-	0xe5, 0xb0, 0x1a, 0x87, 0xae, 0xdf, 0xe0, 0x4e, 0xce, 0x25, 0xd9, 0x0d, 0xd6, 0x3d, 0xda, 0x5f,
-	0xb0, 0xd2, 0x4a, 0x4a, 0x8d, 0x4e, 0xe8, 0xc4, 0xa4, 0xd1, 0x41, 0xef, 0x87, 0x12, 0xdd, 0x37,
-	0xca, 0x51, 0xb9, 0x91, 0xa7, 0xe6, 0x34, 0xbe, 0x84, 0x56, 0xa2, 0xf4, 0x5f, 0x84, 0x39, 0x51,
-	0xfb, 0x8b, 0x95, 0xb4, 0xb1, 0xc0, 0xce, 0xe6, 0x9f, 0x01, 0x68, 0x04, 0xab, 0xa4, 0xd9, 0xf2,
-	0xe8, 0xb0, 0x58, 0xec, 0x80, 0x47, 0xb9, 0x4a, 0x2e, 0x2a, 0x08, 0x36, 0xb0, 0xd0, 0xcf, 0x59,
-	0x00, 0x0d, 0x39, 0xe7, 0xa5, 0x21, 0x70, 0x2d, 0xcf, 0xd7, 0xd1, 0x2b, 0x4a, 0xf3, 0xa2, 0x08,
-	0x62, 0x83, 0x38, 0xfa, 0x69, 0x0b, 0xca, 0xb1, 0x64, 0x9f, 0xab, 0xc6, 0xd5, 0x3c, 0x39, 0x91,
-	0x2f, 0xad, 0x6d, 0x22, 0x35, 0x24, 0x8a, 0x2e, 0xfa, 0x19, 0x0b, 0x20, 0xea, 0xf8, 0xb5, 0x95,
-	0xc0, 0x73, 0x6b, 0x1d, 0xa1, 0x31, 0xaf, 0xe7, 0xea, 0xce, 0x51, 0xbd, 0xcf, 0x8e, 0xd1, 0xd1,
-	0xd0, 0xff, 0xb1, 0x41, 0x19, 0x7d, 0x10, 0xca, 0x91, 0x98, 0x6e, 0x42, 0x47, 0xae, 0xe6, 0xeb,
-	0x54, 0xe2, 0x7d, 0x0b, 0xf1, 0x2a, 0xfe, 0x61, 0x45, 0x13, 0xfd, 0xa2, 0x05, 0xe3, 0xad, 0xa4,
-	0x9b, 0x50, 0xa8, 0xc3, 0xfc, 0x64, 0x40, 0xca, 0x0d, 0xc9, 0xbd, 0x2d, 0xa9, 0x46, 0x9c, 0xe6,
-	0x82, 0x4a, 0x40, 0x3d, 0x83, 0x97, 0x5b, 0xdc, 0x65, 0x39, 0xa4, 0x25, 0xe0, 0xc5, 0x34, 0x10,
-	0x77, 0xe3, 0xa3, 0x15, 0x38, 0x49, 0xb9, 0xeb, 0x70, 0xf3, 0x53, 0xaa, 0x97, 0x88, 0x29, 0xc3,
-	0xf2, 0xec, 0xc3, 0x62, 0x86, 0xb0, 0xb3, 0x8e, 0x34, 0x0e, 0xce, 0x7c, 0x12, 0xfd, 0x91, 0x05,
-	0x0f, 0xbb, 0x4c, 0x0d, 0x98, 0x0e, 0x7b, 0xad, 0x11, 0xc4, 0x41, 0x3b, 0xc9, 0x55, 0x56, 0xf4,
-	0x52, 0x3f, 0xb3, 0x6f, 0x16, 0x6f, 0xf0, 0xf0, 0xc2, 0x2e, 0x2c, 0xe1, 0x5d, 0x19, 0x46, 0x3f,
-	0x02, 0xa3, 0x72, 0x5d, 0xac, 0x50, 0x11, 0xcc, 0x14, 0x6d, 0x65, 0xf6, 0xf8, 0xed, 0x9d, 0xc9,
-	0xd1, 0x55, 0x13, 0x80, 0x93, 0x78, 0xf6, 0xbf, 0x2e, 0x26, 0x4e, 0x89, 0x94, 0x0f, 0x93, 0x89,
-	0x9b, 0x9a, 0xf4, 0xff, 0x48, 0xe9, 0x99, 0xab, 0xb8, 0x51, 0xde, 0x25, 0x2d, 0x6e, 0x54, 0x53,
-	0x84, 0x0d, 0xe2, 0xd4, 0x28, 0x3d, 0xee, 0xa4, 0x3d, 0xa5, 0x42, 0x02, 0xbe, 0x9c, 0x27, 0x4b,
-	0xdd, 0x67, 0x7a, 0xa7, 0x05, 0x6b, 0xc7, 0xbb, 0x40, 0xb8, 0x9b, 0x25, 0xf4, 0x01, 0xa8, 0x84,
-	0x2a, 0xb2, 0xa5, 0x98, 0xc7, 0x56, 0x4d, 0x4e, 0x1b, 0xc1, 0x8e, 0x3a, 0x00, 0xd2, 0x31, 0x2c,
-	0x9a, 0xa2, 0xfd, 0x87, 0xc9, 0x83, 0x31, 0x43, 0x76, 0xf4, 0x71, 0xe8, 0xf7, 0x69, 0x0b, 0x86,
-	0xc3, 0xc0, 0xf3, 0x5c, 0xbf, 0x41, 0xe5, 0x9c, 0x50, 0xd6, 0xef, 0x3e, 0x14, 0x7d, 0x29, 0x04,
-	0x1a, 0xb3, 0xac, 0xb1, 0xa6, 0x89, 0x4d, 0x06, 0xec, 0xbf, 0xb0, 0x60, 0xa2, 0x97, 0x3c, 0x46,
-	0x04, 0x1e, 0x92, 0xc2, 0x46, 0x0d, 0xc5, 0xb2, 0x3f, 0x4f, 0x3c, 0xa2, 0xdc, 0xe6, 0xe5, 0xd9,
-	0xc7, 0xc4, 0x6b, 0x3e, 0xb4, 0xd2, 0x1b, 0x15, 0xef, 0xd6, 0x0f, 0x7a, 0x09, 0x8e, 0x19, 0xef,
-	0x15, 0xa9, 0x81, 0xa9, 0xcc, 0x4e, 0x51, 0x03, 0x68, 0x26, 0x05, 0xbb, 0xb3, 0x33, 0xf9, 0x40,
-	0xba, 0x4d, 0x28, 0x8c, 0xae, 0x7e, 0xec, 0x2f, 0x17, 0xd2, 0x5f, 0x4b, 0xe9, 0xfa, 0xd7, 0xad,
-	0x2e, 0x6f, 0xc2, 0x3b, 0x0f, 0x43, 0xbf, 0x32, 0xbf, 0x83, 0x0a, 0xc3, 0xe8, 0x8d, 0x73, 0x0f,
-	0x8f, 0xed, 0xed, 0x7f, 0x33, 0x00, 0xbb, 0x70, 0xd6, 0x87, 0xf1, 0xbe, 0xef, 0x73, 0xd4, 0x4f,
-	0x5a, 0xea, 0xc0, 0x8c, 0xaf, 0xe1, 0xfa, 0x61, 0x8d, 0x3d, 0xdf, 0x3f, 0x45, 0x3c, 0x74, 0x44,
-	0x79, 0xd1, 0x93, 0x47, 0x73, 0xe8, 0x4b, 0x56, 0xf2, 0xc8, 0x8f, 0x07, 0x35, 0xba, 0x87, 0xc6,
-	0x93, 0x71, 0x8e, 0xc8, 0x19, 0xd3, 0xa7, 0x4f, 0xbd, 0x4e, 0x18, 0xa7, 0x00, 0xd6, 0x5d, 0xdf,
-	0xf1, 0xdc, 0xd7, 0xe8, 0xee, 0xa8, 0xc4, 0x14, 0x3c, 0xb3, 0x98, 0x2e, 0xa8, 0x56, 0x6c, 0x60,
-	0x9c, 0xf9, 0xff, 0x61, 0xd8, 0x78, 0xf3, 0x8c, 0x88, 0x97, 0x93, 0x66, 0xc4, 0x4b, 0xc5, 0x08,
-	0x54, 0x39, 0xf3, 0x76, 0x38, 0x96, 0x66, 0x70, 0x3f, 0xcf, 0xdb, 0xff, 0x6b, 0x28, 0x7d, 0x06,
-	0xb7, 0x4a, 0xc2, 0x26, 0x65, 0xed, 0x0d, 0xc7, 0xd6, 0x1b, 0x8e, 0xad, 0x37, 0x1c, 0x5b, 0xe6,
-	0xd9, 0x84, 0x70, 0xda, 0x0c, 0x1d, 0x91, 0xd3, 0x26, 0xe1, 0x86, 0x2a, 0xe7, 0xee, 0x86, 0xb2,
-	0x3f, 0xd6, 0xe5, 0xb9, 0x5f, 0x0d, 0x09, 0x41, 0x01, 0x94, 0xfc, 0xa0, 0x4e, 0xa4, 0x8d, 0x7b,
-	0x39, 0x1f, 0x83, 0xed, 0x6a, 0x50, 0x37, 0xc2, 0xc5, 0xe9, 0xbf, 0x08, 0x73, 0x3a, 0xf6, 0x47,
-	0x07, 0x21, 0x61, 0x4e, 0xf2, 0xef, 0xfe, 0x43, 0x30, 0x14, 0x92, 0x56, 0x70, 0x0d, 0x2f, 0x0a,
-	0x5d, 0xa6, 0x33, 0x4a, 0x78, 0x33, 0x96, 0x70, 0xaa, 0xf3, 0x5a, 0x4e, 0xbc, 0x21, 0x94, 0x99,
-	0xd2, 0x79, 0x2b, 0x4e, 0xbc, 0x81, 0x19, 0x04, 0xbd, 0x1d, 0xc6, 0xe2, 0xc4, 0x51, 0xb8, 0x38,
-	0xf2, 0x7d, 0x40, 0xe0, 0x8e, 0x25, 0x0f, 0xca, 0x71, 0x0a, 0x1b, 0xbd, 0x0a, 0x03, 0x1b, 0xc4,
-	0x6b, 0x8a, 0x4f, 0x5f, 0xcd, 0x4f, 0xd7, 0xb0, 0x77, 0xbd, 0x44, 0xbc, 0x26, 0x97, 0x84, 0xf4,
-	0x17, 0x66, 0xa4, 0xe8, 0xbc, 0xaf, 0x6c, 0xb6, 0xa3, 0x38, 0x68, 0xba, 0xaf, 0x49, 0x4f, 0xe7,
-	0x3b, 0x73, 0x26, 0x7c, 0x45, 0xf6, 0xcf, 0x5d, 0x4a, 0xea, 0x2f, 0xd6, 0x94, 0x19, 0x1f, 0x75,
-	0x37, 0x64, 0x53, 0xa6, 0x23, 0x1c, 0x96, 0x79, 0xf3, 0x31, 0x2f, 0xfb, 0xe7, 0x7c, 0xa8, 0xbf,
-	0x58, 0x53, 0x46, 0x1d, 0xb5, 0xfe, 0x86, 0x19, 0x0f, 0xd7, 0x72, 0xe6, 0x81, 0xaf, 0xbd, 0xcc,
-	0x75, 0xf8, 0x18, 0x94, 0x6a, 0x1b, 0x4e, 0x18, 0x4f, 0x8c, 0xb0, 0x49, 0xa3, 0x66, 0xf1, 0x1c,
-	0x6d, 0xc4, 0x1c, 0x86, 0x1e, 0x81, 0x62, 0x48, 0xd6, 0x59, 0x74, 0xb2, 0x11, 0x17, 0x85, 0xc9,
-	0x3a, 0xa6, 0xed, 0xca, 0x2e, 0x1b, 0xeb, 0x19, 0x30, 0xf7, 0xcb, 0x85, 0xa4, 0x61, 0x97, 0x1c,
-	0x19, 0xbe, 0x1e, 0x6a, 0xed, 0x30, 0x92, 0x0e, 0x32, 0x63, 0x3d, 0xb0, 0x66, 0x2c, 0xe1, 0xe8,
-	0xc3, 0x16, 0x0c, 0xdd, 0x8c, 0x02, 0xdf, 0x27, 0xb1, 0x50, 0xa2, 0xd7, 0x73, 0x1e, 0xac, 0xcb,
-	0xbc, 0x77, 0xcd, 0x83, 0x68, 0xc0, 0x92, 0x2e, 0x65, 0x97, 0x6c, 0xd7, 0xbc, 0x76, 0xbd, 0x2b,
-	0x18, 0xe6, 0x3c, 0x6f, 0xc6, 0x12, 0x4e, 0x51, 0x5d, 0x9f, 0xa3, 0x0e, 0x24, 0x51, 0x17, 0x7c,
-	0x81, 0x2a, 0xe0, 0xf6, 0xaf, 0x97, 0xe1, 0x54, 0xe6, 0xf2, 0xa1, 0x26, 0x17, 0x33, 0x6a, 0x2e,
-	0xb8, 0x1e, 0x91, 0x61, 0x60, 0xcc, 0xe4, 0xba, 0xae, 0x5a, 0xb1, 0x81, 0x81, 0x7e, 0x0a, 0xa0,
-	0xe5, 0x84, 0x4e, 0x93, 0x28, 0x07, 0xf6, 0x81, 0x2d, 0x1b, 0xca, 0xc7, 0x8a, 0xec, 0x53, 0x6f,
-	0xe2, 0x55, 0x53, 0x84, 0x0d, 0x92, 0xe8, 0x79, 0x18, 0x0e, 0x89, 0x47, 0x9c, 0x88, 0x85, 0xbf,
-	0xa7, 0x73, 0x79, 0xb0, 0x06, 0x61, 0x13, 0x0f, 0x3d, 0xae, 0x22, 0xe6, 0x52, 0x91, 0x43, 0xc9,
-	0xa8, 0x39, 0xf4, 0x19, 0x0b, 0xc6, 0xd6, 0x5d, 0x8f, 0x68, 0xea, 0x22, 0xf3, 0x66, 0xf9, 0xe0,
-	0x2f, 0x79, 0xc1, 0xec, 0x57, 0xcb, 0xd0, 0x44, 0x73, 0x84, 0x53, 0xe4, 0xe9, 0x67, 0xde, 0x22,
-	0x21, 0x13, 0xbe, 0x83, 0xc9, 0xcf, 0x7c, 0x9d, 0x37, 0x63, 0x09, 0x47, 0x33, 0x30, 0xde, 0x72,
-	0xa2, 0x68, 0x2e, 0x24, 0x75, 0xe2, 0xc7, 0xae, 0xe3, 0xf1, 0xbc, 0x98, 0xb2, 0x0e, 0x27, 0x5f,
-	0x49, 0x82, 0x71, 0x1a, 0x1f, 0xbd, 0x0b, 0x1e, 0xe4, 0x1e, 0xa2, 0x25, 0x37, 0x8a, 0x5c, 0xbf,
-	0xa1, 0xa7, 0x81, 0x70, 0x94, 0x4d, 0x8a, 0xae, 0x1e, 0x5c, 0xc8, 0x46, 0xc3, 0xbd, 0x9e, 0x47,
-	0x4f, 0x42, 0x39, 0xda, 0x74, 0x5b, 0x73, 0x61, 0x3d, 0x62, 0xa7, 0x43, 0x65, 0xed, 0x96, 0xad,
-	0x8a, 0x76, 0xac, 0x30, 0x50, 0x0d, 0x46, 0xf8, 0x27, 0xe1, 0x21, 0x7f, 0x42, 0x82, 0x3e, 0xd5,
-	0x53, 0x91, 0x8b, 0x34, 0xcf, 0x29, 0xec, 0xdc, 0x3a, 0x2f, 0xcf, 0xaa, 0xf8, 0xd1, 0xca, 0x75,
-	0xa3, 0x1b, 0x9c, 0xe8, 0x34, 0xb9, 0xa7, 0x1b, 0xee, 0x63, 0x4f, 0xf7, 0x3c, 0x0c, 0x6f, 0xb6,
-	0xd7, 0x88, 0x18, 0x79, 0x21, 0xd8, 0xd4, 0xec, 0xbb, 0xa2, 0x41, 0xd8, 0xc4, 0x63, 0xd1, 0x96,
-	0x2d, 0x57, 0xfc, 0x8b, 0x26, 0x46, 0x8d, 0x68, 0xcb, 0x95, 0x05, 0xd9, 0x8c, 0x4d, 0x1c, 0xca,
-	0x1a, 0x1d, 0x8b, 0x55, 0x12, 0xb1, 0x64, 0x0a, 0x3a, 0x5c, 0x8a, 0xb5, 0xaa, 0x04, 0x60, 0x8d,
-	0x83, 0x56, 0xe0, 0x24, 0xfd, 0x53, 0x65, 0x69, 0xae, 0xd7, 0x1d, 0xcf, 0xad, 0xf3, 0xd0, 0xbf,
-	0xf1, 0xa4, 0x7f, 0xb3, 0x9a, 0x81, 0x83, 0x33, 0x9f, 0xb4, 0x7f, 0xa9, 0x90, 0xf4, 0x9c, 0x98,
-	0x22, 0x0c, 0x45, 0x54, 0x50, 0xc5, 0xd7, 0x9d, 0x50, 0x1a, 0x3c, 0x07, 0x4c, 0x6e, 0x12, 0xfd,
-	0x5e, 0x77, 0x42, 0x53, 0xe4, 0x31, 0x02, 0x58, 0x52, 0x42, 0x37, 0x61, 0x20, 0xf6, 0x9c, 0x9c,
-	0xb2, 0x21, 0x0d, 0x8a, 0xda, 0x91, 0xb5, 0x38, 0x13, 0x61, 0x46, 0x03, 0x3d, 0x4c, 0x77, 0x6f,
-	0x6b, 0xf2, 0xa4, 0x4d, 0x6c, 0xb8, 0xd6, 0x22, 0xcc, 0x5a, 0xed, 0x5f, 0x18, 0xcd, 0xd0, 0x3a,
-	0xca, 0x10, 0x40, 0xcf, 0x00, 0xd0, 0x49, 0xb3, 0x12, 0x92, 0x75, 0x77, 0x5b, 0x18, 0x62, 0x4a,
-	0xb2, 0x5d, 0x55, 0x10, 0x6c, 0x60, 0xc9, 0x67, 0xaa, 0xed, 0x75, 0xfa, 0x4c, 0xa1, 0xfb, 0x19,
-	0x0e, 0xc1, 0x06, 0x16, 0x7a, 0x0e, 0x06, 0xdd, 0xa6, 0xd3, 0x50, 0x81, 0xc0, 0x0f, 0x53, 0x91,
-	0xb6, 0xc0, 0x5a, 0xee, 0xec, 0x4c, 0x8e, 0x29, 0x86, 0x58, 0x13, 0x16, 0xb8, 0xe8, 0xcb, 0x16,
-	0x8c, 0xd4, 0x82, 0x66, 0x33, 0xf0, 0xf9, 0xf6, 0x59, 0xf8, 0x02, 0x6e, 0x1e, 0x96, 0x99, 0x34,
-	0x35, 0x67, 0x10, 0xe3, 0xce, 0x00, 0x95, 0xb6, 0x69, 0x82, 0x70, 0x82, 0x2b, 0x53, 0xf2, 0x95,
-	0xf6, 0x90, 0x7c, 0xbf, 0x61, 0xc1, 0x71, 0xfe, 0xac, 0xb1, 0xab, 0x17, 0x19, 0x8a, 0xc1, 0x21,
-	0xbf, 0x56, 0x97, 0xa3, 0x43, 0x39, 0x7b, 0xbb, 0xe0, 0xb8, 0x9b, 0x49, 0x74, 0x11, 0x8e, 0xaf,
-	0x07, 0x61, 0x8d, 0x98, 0x03, 0x21, 0xc4, 0xb6, 0xea, 0xe8, 0x42, 0x1a, 0x01, 0x77, 0x3f, 0x83,
-	0xae, 0xc3, 0x03, 0x46, 0xa3, 0x39, 0x0e, 0x5c, 0x72, 0x3f, 0x2a, 0x7a, 0x7b, 0xe0, 0x42, 0x26,
-	0x16, 0xee, 0xf1, 0x74, 0x52, 0x48, 0x56, 0xfa, 0x10, 0x92, 0xaf, 0xc0, 0xe9, 0x5a, 0xf7, 0xc8,
-	0x6c, 0x45, 0xed, 0xb5, 0x88, 0xcb, 0xf1, 0xf2, 0xec, 0x0f, 0x88, 0x0e, 0x4e, 0xcf, 0xf5, 0x42,
-	0xc4, 0xbd, 0xfb, 0x40, 0xef, 0x87, 0x72, 0x48, 0xd8, 0x57, 0x89, 0x44, 0xba, 0xde, 0x01, 0xbd,
-	0x1d, 0xda, 0x82, 0xe7, 0xdd, 0x6a, 0xcd, 0x24, 0x1a, 0x22, 0xac, 0x28, 0xa2, 0x5b, 0x30, 0xd4,
-	0x72, 0xe2, 0xda, 0x86, 0x48, 0xd2, 0x3b, 0xb0, 0x6f, 0x5e, 0x11, 0x67, 0x47, 0x29, 0x46, 0x5a,
-	0x3f, 0x27, 0x82, 0x25, 0x35, 0x6a, 0xab, 0xd5, 0x82, 0x66, 0x2b, 0xf0, 0x89, 0x1f, 0x4b, 0x25,
-	0x32, 0xc6, 0xcf, 0x3b, 0x64, 0x2b, 0x36, 0x30, 0xba, 0x74, 0xb9, 0x46, 0x9b, 0x38, 0xbe, 0x8b,
-	0x2e, 0x37, 0x7a, 0xeb, 0xf5, 0x3c, 0x55, 0x36, 0xcc, 0xad, 0x78, 0xc3, 0x8d, 0x37, 0x82, 0x76,
-	0x2c, 0x77, 0xc9, 0x42, 0x51, 0x29, 0x65, 0xb3, 0x98, 0x81, 0x83, 0x33, 0x9f, 0x4c, 0x6b, 0xd6,
-	0xf1, 0xbb, 0xd3, 0xac, 0xc7, 0xfa, 0xd0, 0xac, 0x55, 0x38, 0xc5, 0x38, 0x10, 0x56, 0xb2, 0x74,
-	0x5a, 0x46, 0x13, 0x88, 0x31, 0xaf, 0xf2, 0x5b, 0x16, 0xb3, 0x90, 0x70, 0xf6, 0xb3, 0x67, 0xde,
-	0x01, 0xc7, 0xbb, 0x84, 0xdc, 0xbe, 0x1c, 0x92, 0xf3, 0xf0, 0x40, 0xb6, 0x38, 0xd9, 0x97, 0x5b,
-	0xf2, 0xd7, 0x53, 0x71, 0xe9, 0xc6, 0x16, 0xad, 0x0f, 0x17, 0xb7, 0x03, 0x45, 0xe2, 0x6f, 0x09,
-	0xed, 0x7a, 0xe1, 0x60, 0xb3, 0xfa, 0xbc, 0xbf, 0xc5, 0xa5, 0x21, 0xf3, 0xe3, 0x9d, 0xf7, 0xb7,
-	0x30, 0xed, 0x1b, 0x7d, 0xce, 0x4a, 0x6c, 0x20, 0xb8, 0x63, 0xfc, 0xbd, 0x87, 0xb2, 0x27, 0xed,
-	0x7b, 0x4f, 0x61, 0xff, 0xdb, 0x02, 0x9c, 0xdd, 0xab, 0x93, 0x3e, 0x86, 0xef, 0x31, 0x18, 0x8c,
-	0x58, 0xa4, 0x89, 0x50, 0x57, 0xc3, 0x74, 0x15, 0xf3, 0xd8, 0x93, 0x57, 0xb0, 0x00, 0x21, 0x0f,
-	0x8a, 0x4d, 0xa7, 0x25, 0xfc, 0xa5, 0x0b, 0x07, 0xcd, 0xdf, 0xa3, 0xff, 0x1d, 0x6f, 0xc9, 0x69,
-	0xf1, 0x39, 0x6f, 0x34, 0x60, 0x4a, 0x06, 0xc5, 0x50, 0x72, 0xc2, 0xd0, 0x91, 0x61, 0x0d, 0x57,
-	0xf2, 0xa1, 0x37, 0x43, 0xbb, 0xe4, 0xa7, 0xc2, 0x89, 0x26, 0xcc, 0x89, 0xd9, 0xbf, 0x58, 0x4e,
-	0x24, 0x7b, 0xb1, 0x58, 0x95, 0x08, 0x06, 0x85, 0x9b, 0xd4, 0xca, 0x3b, 0x6d, 0x92, 0x67, 0x53,
-	0x33, 0x0f, 0x84, 0xa8, 0x49, 0x21, 0x48, 0xa1, 0x4f, 0x58, 0xac, 0xf2, 0x83, 0xcc, 0xa0, 0x13,
-	0xbb, 0xfa, 0xc3, 0x29, 0x44, 0x61, 0xd6, 0x93, 0x90, 0x8d, 0xd8, 0xa4, 0x2e, 0x2a, 0xb8, 0xb0,
-	0xdd, 0x4c, 0x77, 0x05, 0x17, 0xb6, 0x3b, 0x91, 0x70, 0xb4, 0x9d, 0x11, 0x93, 0x92, 0x43, 0xf5,
-	0x80, 0x3e, 0xa2, 0x50, 0xbe, 0x64, 0xc1, 0x71, 0x37, 0x1d, 0x5c, 0x20, 0xf6, 0xc0, 0x37, 0xf2,
-	0xf1, 0x69, 0x76, 0xc7, 0x2e, 0x28, 0x43, 0xa7, 0x0b, 0x84, 0xbb, 0x99, 0x41, 0x75, 0x18, 0x70,
-	0xfd, 0xf5, 0x40, 0x98, 0x77, 0xb3, 0x07, 0x63, 0x6a, 0xc1, 0x5f, 0x0f, 0xf4, 0x6a, 0xa6, 0xff,
-	0x30, 0xeb, 0x1d, 0x2d, 0xc2, 0x49, 0x99, 0xef, 0x73, 0xc9, 0x8d, 0xe2, 0x20, 0xec, 0x2c, 0xba,
-	0x4d, 0x37, 0x66, 0xa6, 0x59, 0x71, 0x76, 0x82, 0xaa, 0x37, 0x9c, 0x01, 0xc7, 0x99, 0x4f, 0xa1,
-	0xd7, 0x60, 0x48, 0x1e, 0xe8, 0x97, 0xf3, 0xf0, 0x27, 0x74, 0xcf, 0x7f, 0x35, 0x99, 0xaa, 0xe2,
-	0x44, 0x5f, 0x12, 0x44, 0x1f, 0xb7, 0x60, 0x8c, 0xff, 0xbe, 0xd4, 0xa9, 0xf3, 0x14, 0xc3, 0x4a,
-	0x1e, 0x51, 0xfb, 0xd5, 0x44, 0x9f, 0xb3, 0xe8, 0xf6, 0xce, 0xe4, 0x58, 0xb2, 0x0d, 0xa7, 0xe8,
-	0xda, 0x5f, 0x1e, 0x81, 0xee, 0x10, 0x88, 0x64, 0xbc, 0x83, 0x75, 0xd4, 0xf1, 0x0e, 0x74, 0x57,
-	0x19, 0xe9, 0x50, 0x85, 0x1c, 0x96, 0x99, 0xa0, 0xaa, 0x8f, 0xa1, 0x3b, 0x7e, 0x0d, 0x33, 0x1a,
-	0x28, 0x84, 0xc1, 0x0d, 0xe2, 0x78, 0xf1, 0x46, 0x3e, 0x27, 0x66, 0x97, 0x58, 0x5f, 0xe9, 0x7c,
-	0x41, 0xde, 0x8a, 0x05, 0x25, 0xb4, 0x0d, 0x43, 0x1b, 0x7c, 0x2e, 0x8a, 0x8d, 0xde, 0xd2, 0x41,
-	0x07, 0x37, 0x31, 0xc1, 0xf5, 0xcc, 0x13, 0x0d, 0x58, 0x92, 0x63, 0xb1, 0x75, 0x46, 0xf4, 0x0f,
-	0x97, 0x22, 0xf9, 0xa5, 0x4a, 0xf6, 0x1f, 0xfa, 0xf3, 0x3e, 0x18, 0x09, 0x49, 0x2d, 0xf0, 0x6b,
-	0xae, 0x47, 0xea, 0x33, 0xf2, 0x34, 0x6c, 0x3f, 0x19, 0x72, 0xcc, 0x95, 0x84, 0x8d, 0x3e, 0x70,
-	0xa2, 0x47, 0xb6, 0xc8, 0x54, 0xd6, 0x3c, 0xfd, 0x20, 0x44, 0x9c, 0x7a, 0x2c, 0xe6, 0x94, 0xa3,
-	0xcf, 0xfa, 0xe4, 0x8b, 0x2c, 0xd9, 0x86, 0x53, 0x74, 0xd1, 0x4b, 0x00, 0xc1, 0x1a, 0x0f, 0xa0,
-	0x9b, 0x89, 0xc5, 0x11, 0xc8, 0x7e, 0x5e, 0x75, 0x8c, 0x67, 0xda, 0xca, 0x1e, 0xb0, 0xd1, 0x1b,
-	0xba, 0x02, 0xc0, 0x97, 0xcd, 0x6a, 0xa7, 0x25, 0x77, 0x83, 0x32, 0xc5, 0x11, 0xaa, 0x0a, 0x72,
-	0x67, 0x67, 0xb2, 0xdb, 0xe1, 0xcc, 0xa2, 0x84, 0x8c, 0xc7, 0xd1, 0x4f, 0xc2, 0x50, 0xd4, 0x6e,
-	0x36, 0x1d, 0x75, 0x40, 0x92, 0x63, 0xee, 0x2e, 0xef, 0xd7, 0x90, 0x8a, 0xbc, 0x01, 0x4b, 0x8a,
-	0xe8, 0x26, 0x95, 0xef, 0x42, 0x3c, 0xf1, 0x55, 0xc4, 0xcd, 0x13, 0xee, 0x06, 0x7c, 0x9b, 0xdc,
-	0xc2, 0xe0, 0x0c, 0x9c, 0x3b, 0x3b, 0x93, 0x0f, 0x24, 0xdb, 0x17, 0x03, 0x91, 0x4d, 0x9b, 0xd9,
-	0x27, 0xba, 0x2c, 0x8b, 0x68, 0xd1, 0xd7, 0x96, 0xb5, 0x5d, 0x9e, 0xd0, 0x45, 0xb4, 0x58, 0x73,
-	0xef, 0x31, 0x33, 0x1f, 0x46, 0x4b, 0x70, 0xa2, 0x16, 0xf8, 0x71, 0x18, 0x78, 0x1e, 0x2f, 0x22,
-	0xc7, 0x37, 0xe6, 0xfc, 0x00, 0xe5, 0x21, 0xc1, 0xf6, 0x89, 0xb9, 0x6e, 0x14, 0x9c, 0xf5, 0x1c,
-	0x35, 0xc8, 0xd3, 0xca, 0x61, 0x2c, 0x97, 0xb3, 0xf5, 0x44, 0x9f, 0x42, 0x42, 0x29, 0x9f, 0xf7,
-	0x1e, 0x6a, 0xc2, 0x4f, 0x9e, 0xb0, 0x8a, 0x2f, 0xf6, 0x1c, 0x8c, 0x90, 0xed, 0x98, 0x84, 0xbe,
-	0xe3, 0x5d, 0xc3, 0x8b, 0xf2, 0xb4, 0x82, 0x2d, 0xcc, 0xf3, 0x46, 0x3b, 0x4e, 0x60, 0x21, 0x5b,
-	0xb9, 0xc8, 0x8c, 0xb4, 0x75, 0xee, 0x22, 0x93, 0x0e, 0x31, 0xfb, 0xab, 0xc5, 0x84, 0xc1, 0x7a,
-	0x4f, 0xce, 0x73, 0x59, 0x7d, 0x24, 0x59, 0x48, 0x8a, 0x01, 0xc4, 0x46, 0x2c, 0x4f, 0xca, 0xaa,
-	0x3e, 0xd2, 0xb2, 0x49, 0x08, 0x27, 0xe9, 0xa2, 0x4d, 0x28, 0x6d, 0x04, 0x51, 0x2c, 0xb7, 0x67,
-	0x07, 0xdc, 0x09, 0x5e, 0x0a, 0xa2, 0x98, 0x59, 0x59, 0xea, 0xb5, 0x69, 0x4b, 0x84, 0x39, 0x0d,
-	0xba, 0xf1, 0x8f, 0x36, 0x9c, 0xb0, 0x1e, 0xcd, 0xb1, 0x22, 0x13, 0x03, 0xcc, 0xbc, 0x52, 0xc6,
-	0x74, 0x55, 0x83, 0xb0, 0x89, 0x67, 0x7f, 0xc7, 0x4a, 0x1c, 0x69, 0xdd, 0x60, 0x19, 0x03, 0x5b,
-	0xc4, 0xa7, 0x22, 0xca, 0x8c, 0x51, 0xfc, 0x91, 0x54, 0xfe, 0xf5, 0x5b, 0x7a, 0xd5, 0x7b, 0xbc,
-	0x45, 0x7b, 0x98, 0x62, 0x5d, 0x18, 0xe1, 0x8c, 0x1f, 0xb2, 0x92, 0x89, 0xf4, 0x85, 0x3c, 0xf6,
-	0x6d, 0x66, 0x31, 0x89, 0x3d, 0x73, 0xf2, 0xed, 0xcf, 0x59, 0x30, 0x34, 0xeb, 0xd4, 0x36, 0x83,
-	0xf5, 0x75, 0xf4, 0x24, 0x94, 0xeb, 0xed, 0xd0, 0xcc, 0xe9, 0x57, 0x9e, 0xaa, 0x79, 0xd1, 0x8e,
-	0x15, 0x06, 0x9d, 0xfa, 0xeb, 0x4e, 0x4d, 0x96, 0x94, 0x28, 0xf2, 0xa9, 0x7f, 0x81, 0xb5, 0x60,
-	0x01, 0xa1, 0xc3, 0xdf, 0x74, 0xb6, 0xe5, 0xc3, 0xe9, 0xf3, 0xb4, 0x25, 0x0d, 0xc2, 0x26, 0x9e,
-	0xfd, 0x2f, 0x2c, 0x98, 0x98, 0x75, 0x22, 0xb7, 0x36, 0xd3, 0x8e, 0x37, 0x66, 0xdd, 0x78, 0xad,
-	0x5d, 0xdb, 0x24, 0x31, 0x2f, 0x3d, 0x42, 0xb9, 0x6c, 0x47, 0x74, 0x05, 0xaa, 0xed, 0xb2, 0xe2,
-	0xf2, 0x9a, 0x68, 0xc7, 0x0a, 0x03, 0xbd, 0x06, 0xc3, 0x2d, 0x27, 0x8a, 0x6e, 0x05, 0x61, 0x1d,
-	0x93, 0xf5, 0x7c, 0x8a, 0x13, 0x55, 0x49, 0x2d, 0x24, 0x31, 0x26, 0xeb, 0x22, 0x3a, 0x45, 0xf7,
-	0x8f, 0x4d, 0x62, 0xf6, 0xcf, 0x59, 0x70, 0x72, 0x96, 0x38, 0x21, 0x09, 0x59, 0x2d, 0x23, 0xf5,
-	0x22, 0xe8, 0x55, 0x28, 0xc7, 0xb4, 0x85, 0x72, 0x64, 0xe5, 0xcb, 0x11, 0x8b, 0x2b, 0x59, 0x15,
-	0x9d, 0x63, 0x45, 0xc6, 0xfe, 0xb4, 0x05, 0xa7, 0xb3, 0x78, 0x99, 0xf3, 0x82, 0x76, 0xfd, 0x5e,
-	0x30, 0xf4, 0xb7, 0x2c, 0x18, 0x61, 0x67, 0xf5, 0xf3, 0x24, 0x76, 0x5c, 0xaf, 0xab, 0x8e, 0xa2,
-	0xd5, 0x67, 0x1d, 0xc5, 0xb3, 0x30, 0xb0, 0x11, 0x34, 0x49, 0x3a, 0xce, 0xe4, 0x52, 0xd0, 0x24,
-	0x98, 0x41, 0xd0, 0xd3, 0x74, 0x12, 0xba, 0x7e, 0xec, 0xd0, 0xe5, 0x28, 0xcf, 0x32, 0xc6, 0xf9,
-	0x04, 0x54, 0xcd, 0xd8, 0xc4, 0xb1, 0x7f, 0xb7, 0x02, 0x43, 0x22, 0x28, 0xaa, 0xef, 0x52, 0x38,
-	0xd2, 0x85, 0x53, 0xe8, 0xe9, 0xc2, 0x89, 0x60, 0xb0, 0xc6, 0x0a, 0xba, 0x0a, 0xf3, 0xfc, 0x4a,
-	0x2e, 0x51, 0x74, 0xbc, 0x46, 0xac, 0x66, 0x8b, 0xff, 0xc7, 0x82, 0x14, 0xfa, 0xac, 0x05, 0xe3,
-	0xb5, 0xc0, 0xf7, 0x49, 0x4d, 0xdb, 0x8e, 0x03, 0x79, 0x04, 0x4b, 0xcd, 0x25, 0x3b, 0xd5, 0xc7,
-	0xc0, 0x29, 0x00, 0x4e, 0x93, 0x47, 0x2f, 0xc0, 0x28, 0x1f, 0xb3, 0xeb, 0x89, 0x03, 0x18, 0x5d,
-	0x5e, 0xcf, 0x04, 0xe2, 0x24, 0x2e, 0x9a, 0xe2, 0x07, 0x59, 0xa2, 0x90, 0xdd, 0xa0, 0xf6, 0x53,
-	0x1b, 0x25, 0xec, 0x0c, 0x0c, 0x14, 0x02, 0x0a, 0xc9, 0x7a, 0x48, 0xa2, 0x0d, 0x11, 0x34, 0xc6,
-	0xec, 0xd6, 0xa1, 0xbb, 0x2b, 0x62, 0x81, 0xbb, 0x7a, 0xc2, 0x19, 0xbd, 0xa3, 0x4d, 0xe1, 0x43,
-	0x28, 0xe7, 0x21, 0xcf, 0xc5, 0x67, 0xee, 0xe9, 0x4a, 0x98, 0x84, 0x12, 0x53, 0x5d, 0xcc, 0x5e,
-	0x2e, 0xf2, 0xc4, 0x49, 0xa6, 0xd8, 0x30, 0x6f, 0x47, 0xf3, 0x70, 0x2c, 0x55, 0x1c, 0x30, 0x12,
-	0x07, 0x25, 0x2a, 0x49, 0x2e, 0x55, 0x56, 0x30, 0xc2, 0x5d, 0x4f, 0x98, 0xfe, 0xa5, 0xe1, 0x3d,
-	0xfc, 0x4b, 0x1d, 0x15, 0x9a, 0xcc, 0x8f, 0x30, 0x5e, 0xcc, 0x65, 0x00, 0xfa, 0x8a, 0x43, 0xfe,
-	0x54, 0x2a, 0x0e, 0x79, 0x94, 0x31, 0x70, 0x3d, 0x1f, 0x06, 0xf6, 0x1f, 0x74, 0x7c, 0x2f, 0x83,
-	0x88, 0xff, 0xa7, 0x05, 0xf2, 0xbb, 0xce, 0x39, 0xb5, 0x0d, 0x42, 0xa7, 0x0c, 0x7a, 0x3b, 0x8c,
-	0x29, 0xd7, 0x04, 0x37, 0x89, 0x2c, 0x36, 0x6b, 0x94, 0xed, 0x8c, 0x13, 0x50, 0x9c, 0xc2, 0x46,
-	0xd3, 0x50, 0xa1, 0xe3, 0xc4, 0x1f, 0xe5, 0x7a, 0x5f, 0xb9, 0x3f, 0x66, 0x56, 0x16, 0xc4, 0x53,
-	0x1a, 0x07, 0x05, 0x70, 0xdc, 0x73, 0xa2, 0x98, 0x71, 0x50, 0xed, 0xf8, 0xb5, 0xbb, 0x2c, 0x21,
-	0xc3, 0x32, 0xb1, 0x16, 0xd3, 0x1d, 0xe1, 0xee, 0xbe, 0xed, 0x7f, 0x57, 0x82, 0xd1, 0x84, 0x64,
-	0xdc, 0xa7, 0xc1, 0xf0, 0x24, 0x94, 0xa5, 0x0e, 0x4f, 0xd7, 0xca, 0x52, 0x8a, 0x5e, 0x61, 0x50,
-	0xa5, 0xb5, 0xa6, 0xb5, 0x6a, 0xda, 0xc0, 0x31, 0x14, 0x2e, 0x36, 0xf1, 0x98, 0x50, 0x8e, 0xbd,
-	0x68, 0xce, 0x73, 0x89, 0x1f, 0x73, 0x36, 0xf3, 0x11, 0xca, 0xab, 0x8b, 0x55, 0xb3, 0x53, 0x2d,
-	0x94, 0x53, 0x00, 0x9c, 0x26, 0x8f, 0x3e, 0x6a, 0xc1, 0xa8, 0x73, 0x2b, 0xd2, 0x55, 0xc7, 0x45,
-	0xc4, 0xf1, 0x01, 0x95, 0x54, 0xa2, 0x90, 0x39, 0xf7, 0xea, 0x27, 0x9a, 0x70, 0x92, 0x28, 0x7a,
-	0xdd, 0x02, 0x44, 0xb6, 0x49, 0x4d, 0xc6, 0x44, 0x0b, 0x5e, 0x06, 0xf3, 0xd8, 0xc1, 0x9f, 0xef,
-	0xea, 0x97, 0x4b, 0xf5, 0xee, 0x76, 0x9c, 0xc1, 0x03, 0xba, 0x0c, 0xa8, 0xee, 0x46, 0xce, 0x9a,
-	0x47, 0xe6, 0x82, 0xa6, 0xcc, 0x1e, 0x16, 0x87, 0xe9, 0x67, 0xc4, 0x38, 0xa3, 0xf9, 0x2e, 0x0c,
-	0x9c, 0xf1, 0x14, 0x9b, 0x65, 0x61, 0xb0, 0xdd, 0xb9, 0x16, 0x7a, 0x4c, 0x4b, 0x98, 0xb3, 0x4c,
-	0xb4, 0x63, 0x85, 0x61, 0xff, 0x65, 0x51, 0x2d, 0x65, 0x9d, 0x00, 0xe0, 0x18, 0x81, 0xc8, 0xd6,
-	0xdd, 0x07, 0x22, 0xeb, 0x30, 0xa9, 0xee, 0x9c, 0xf8, 0x44, 0x0a, 0x6d, 0xe1, 0x1e, 0xa5, 0xd0,
-	0xfe, 0xb4, 0x95, 0xa8, 0x47, 0x37, 0xfc, 0xcc, 0x4b, 0xf9, 0x26, 0x1f, 0x4c, 0xf1, 0x10, 0xae,
-	0x94, 0x5e, 0x49, 0x45, 0xee, 0x3d, 0x09, 0xe5, 0x75, 0xcf, 0x61, 0x55, 0x54, 0xd8, 0x42, 0x35,
-	0xc2, 0xcb, 0x2e, 0x88, 0x76, 0xac, 0x30, 0xa8, 0xd4, 0x37, 0x3a, 0xdd, 0x97, 0xd4, 0xfe, 0x0f,
-	0x45, 0x18, 0x36, 0x34, 0x7e, 0xa6, 0xf9, 0x66, 0xdd, 0x67, 0xe6, 0x5b, 0x61, 0x1f, 0xe6, 0xdb,
-	0x4f, 0x41, 0xa5, 0x26, 0xb5, 0x51, 0x3e, 0xf5, 0xf5, 0xd3, 0x3a, 0x4e, 0x2b, 0x24, 0xd5, 0x84,
-	0x35, 0x4d, 0x74, 0x31, 0x91, 0xa6, 0x99, 0xf0, 0x0b, 0x64, 0xe5, 0x51, 0x0a, 0x8d, 0xd6, 0xfd,
-	0x4c, 0x3a, 0x38, 0xa0, 0xb4, 0x77, 0x70, 0x80, 0xfd, 0x4d, 0x4b, 0x7d, 0xdc, 0x23, 0xa8, 0xc7,
-	0x73, 0x33, 0x59, 0x8f, 0xe7, 0x7c, 0x2e, 0xc3, 0xdc, 0xa3, 0x10, 0xcf, 0x55, 0x18, 0x9a, 0x0b,
-	0x9a, 0x4d, 0xc7, 0xaf, 0xa3, 0x1f, 0x84, 0xa1, 0x1a, 0xff, 0x29, 0x7c, 0x68, 0xec, 0xa4, 0x5a,
-	0x40, 0xb1, 0x84, 0xa1, 0x87, 0x61, 0xc0, 0x09, 0x1b, 0xd2, 0x6f, 0xc6, 0x22, 0xe0, 0x66, 0xc2,
-	0x46, 0x84, 0x59, 0xab, 0xfd, 0x4f, 0x06, 0x80, 0x05, 0x9e, 0x38, 0x21, 0xa9, 0xaf, 0x06, 0xac,
-	0x2c, 0xee, 0xa1, 0x9e, 0xef, 0xea, 0x4d, 0xdd, 0xfd, 0x7c, 0xc6, 0x6b, 0x9c, 0xf3, 0x15, 0x8f,
-	0xfa, 0x9c, 0x2f, 0xfb, 0xe8, 0x76, 0xe0, 0x3e, 0x3a, 0xba, 0xb5, 0x3f, 0x69, 0x01, 0x52, 0x61,
-	0x44, 0x3a, 0xb6, 0x62, 0x1a, 0x2a, 0x2a, 0x6e, 0x49, 0x18, 0x80, 0x5a, 0x44, 0x48, 0x00, 0xd6,
-	0x38, 0x7d, 0xec, 0xe4, 0x1f, 0x93, 0xf2, 0xbb, 0x98, 0x4c, 0x3e, 0x60, 0x52, 0x5f, 0x88, 0x73,
-	0xfb, 0xf7, 0x0a, 0xf0, 0x00, 0x37, 0x1d, 0x96, 0x1c, 0xdf, 0x69, 0x90, 0x26, 0xe5, 0xaa, 0xdf,
-	0x68, 0x99, 0x1a, 0xdd, 0x42, 0xba, 0x32, 0x55, 0xe0, 0xa0, 0x6b, 0x97, 0xaf, 0x39, 0xbe, 0xca,
-	0x16, 0x7c, 0x37, 0xc6, 0xac, 0x73, 0x14, 0x41, 0x59, 0x5e, 0x3e, 0x23, 0x64, 0x71, 0x4e, 0x84,
-	0x94, 0x58, 0x12, 0x5a, 0x96, 0x60, 0x45, 0x88, 0xaa, 0x52, 0x2f, 0xa8, 0x6d, 0x62, 0xd2, 0x0a,
-	0xd2, 0xaa, 0x74, 0x51, 0xb4, 0x63, 0x85, 0x61, 0x37, 0x61, 0x5c, 0x8e, 0x61, 0xeb, 0x0a, 0xe9,
-	0x60, 0xb2, 0x4e, 0xf5, 0x4f, 0x4d, 0x36, 0x19, 0xf7, 0xe1, 0x28, 0xfd, 0x33, 0x67, 0x02, 0x71,
-	0x12, 0x57, 0x56, 0xca, 0x2d, 0x64, 0x57, 0xca, 0xb5, 0x7f, 0xcf, 0x82, 0xb4, 0x02, 0x34, 0xea,
-	0x82, 0x5a, 0xbb, 0xd6, 0x05, 0xdd, 0x47, 0x65, 0xcd, 0xf7, 0xc0, 0xb0, 0x13, 0x53, 0x0b, 0x87,
-	0x7b, 0x23, 0x8a, 0x77, 0x77, 0x8a, 0xb6, 0x14, 0xd4, 0xdd, 0x75, 0x97, 0x79, 0x21, 0xcc, 0xee,
-	0xec, 0xd7, 0x2d, 0xa8, 0xcc, 0x87, 0x9d, 0xfd, 0xe7, 0x6c, 0x75, 0x67, 0x64, 0x15, 0xf6, 0x95,
-	0x91, 0x25, 0x73, 0xbe, 0x8a, 0xbd, 0x72, 0xbe, 0xec, 0xbf, 0x1e, 0x80, 0xe3, 0x5d, 0x49, 0x88,
-	0xe8, 0x1c, 0x8c, 0xa8, 0xaf, 0x24, 0x5d, 0x90, 0x15, 0x33, 0x8a, 0x57, 0xc3, 0x70, 0x02, 0xb3,
-	0x8f, 0xa5, 0xba, 0x00, 0x27, 0x42, 0xf2, 0x6a, 0x9b, 0xb4, 0xc9, 0xcc, 0x7a, 0x4c, 0xc2, 0x2a,
-	0xa9, 0x05, 0x7e, 0x9d, 0x17, 0xd6, 0x2d, 0xce, 0x3e, 0x78, 0x7b, 0x67, 0xf2, 0x04, 0xee, 0x06,
-	0xe3, 0xac, 0x67, 0x50, 0x0b, 0x46, 0x3d, 0xd3, 0x76, 0x16, 0x5b, 0xb6, 0xbb, 0x32, 0xbb, 0xd5,
-	0x6c, 0x4d, 0x34, 0xe3, 0x24, 0x81, 0xa4, 0x01, 0x5e, 0xba, 0x47, 0x06, 0xf8, 0x47, 0xb4, 0x01,
-	0xce, 0x83, 0x62, 0xde, 0x9d, 0x73, 0x12, 0x6a, 0x3f, 0x16, 0xf8, 0x41, 0x6c, 0xea, 0x17, 0xa1,
-	0x2c, 0x03, 0x06, 0xfb, 0x0a, 0xb4, 0x33, 0xfb, 0xe9, 0x21, 0xdb, 0x1f, 0x87, 0x37, 0x9f, 0x0f,
-	0x43, 0x63, 0x30, 0xaf, 0x06, 0xf1, 0x8c, 0xe7, 0x05, 0xb7, 0xa8, 0xb9, 0x72, 0x2d, 0x22, 0xc2,
-	0x27, 0x66, 0xdf, 0x29, 0x40, 0xc6, 0xf6, 0x92, 0xae, 0x49, 0x6d, 0x23, 0x25, 0xd6, 0xe4, 0xfe,
-	0xec, 0x24, 0xb4, 0xcd, 0x83, 0x2a, 0xb9, 0x35, 0xf0, 0xae, 0xbc, 0xb7, 0xc7, 0x3a, 0xce, 0x52,
-	0x49, 0x4a, 0x15, 0x6b, 0xf9, 0x0c, 0x80, 0x36, 0x6d, 0x45, 0xde, 0x93, 0x0a, 0x94, 0xd0, 0x16,
-	0x30, 0x36, 0xb0, 0xd0, 0xf3, 0x30, 0xec, 0xfa, 0x51, 0xec, 0x78, 0xde, 0x25, 0xd7, 0x8f, 0x85,
-	0xdb, 0x57, 0x99, 0x3d, 0x0b, 0x1a, 0x84, 0x4d, 0xbc, 0x33, 0x6f, 0x33, 0xbe, 0xdf, 0x7e, 0xbe,
-	0xfb, 0x06, 0x9c, 0xbe, 0xe8, 0xc6, 0x2a, 0x5b, 0x4f, 0xcd, 0x37, 0x6a, 0xb9, 0x2a, 0x59, 0x65,
-	0xf5, 0xcc, 0x4f, 0x35, 0xb2, 0xe5, 0x0a, 0xc9, 0xe4, 0xbe, 0x74, 0xb6, 0x9c, 0x7d, 0x0e, 0x4e,
-	0x5e, 0x74, 0xe3, 0x0b, 0xae, 0x47, 0xf6, 0x49, 0xc4, 0xfe, 0x9d, 0x41, 0x18, 0x31, 0x33, 0xd3,
-	0xf7, 0x23, 0xae, 0x3f, 0x4d, 0x8d, 0x53, 0xf1, 0x76, 0xae, 0x3a, 0xd1, 0xbd, 0x71, 0xe0, 0x34,
-	0xf9, 0xec, 0x11, 0x33, 0xec, 0x53, 0x4d, 0x13, 0x9b, 0x0c, 0xa0, 0x5b, 0x50, 0x5a, 0x67, 0xd9,
-	0x5c, 0xc5, 0x3c, 0x62, 0x71, 0xb2, 0x46, 0x54, 0x2f, 0x47, 0x9e, 0x0f, 0xc6, 0xe9, 0x51, 0x9b,
-	0x22, 0x4c, 0x26, 0x11, 0x1b, 0x31, 0xf6, 0x42, 0x59, 0x29, 0x8c, 0x5e, 0x2a, 0xa1, 0x74, 0x17,
-	0x2a, 0x21, 0x21, 0xa0, 0x07, 0xef, 0x91, 0x80, 0x66, 0x99, 0x79, 0xf1, 0x06, 0xb3, 0x78, 0x45,
-	0x52, 0xd0, 0x10, 0x1b, 0x04, 0x23, 0x33, 0x2f, 0x01, 0xc6, 0x69, 0x7c, 0xf4, 0x41, 0x25, 0xe2,
-	0xcb, 0x79, 0x78, 0xcc, 0xcd, 0x19, 0x7d, 0xd8, 0xd2, 0xfd, 0x93, 0x05, 0x18, 0xbb, 0xe8, 0xb7,
-	0x57, 0x2e, 0xae, 0xb4, 0xd7, 0x3c, 0xb7, 0x76, 0x85, 0x74, 0xa8, 0x08, 0xdf, 0x24, 0x9d, 0x85,
-	0x79, 0xb1, 0x82, 0xd4, 0x9c, 0xb9, 0x42, 0x1b, 0x31, 0x87, 0x51, 0x61, 0xb4, 0xee, 0xfa, 0x0d,
-	0x12, 0xb6, 0x42, 0x57, 0x38, 0xb3, 0x0d, 0x61, 0x74, 0x41, 0x83, 0xb0, 0x89, 0x47, 0xfb, 0x0e,
-	0x6e, 0xf9, 0x24, 0x4c, 0x9b, 0xfe, 0xcb, 0xb4, 0x11, 0x73, 0x18, 0x45, 0x8a, 0xc3, 0xb6, 0xf0,
-	0x15, 0x19, 0x48, 0xab, 0xb4, 0x11, 0x73, 0x18, 0x5d, 0xe9, 0x51, 0x7b, 0x8d, 0x85, 0x3a, 0xa5,
-	0x32, 0x90, 0xaa, 0xbc, 0x19, 0x4b, 0x38, 0x45, 0xdd, 0x24, 0x9d, 0x79, 0x27, 0x76, 0xd2, 0x69,
-	0x9a, 0x57, 0x78, 0x33, 0x96, 0x70, 0x56, 0xfa, 0x37, 0x39, 0x1c, 0xdf, 0x75, 0xa5, 0x7f, 0x93,
-	0xec, 0xf7, 0xf0, 0x38, 0xfc, 0xcd, 0x02, 0x8c, 0x98, 0x01, 0x8a, 0xa8, 0x91, 0x32, 0xd3, 0x97,
-	0xbb, 0x2a, 0xc7, 0xff, 0x78, 0xd6, 0xad, 0xaa, 0x0d, 0x37, 0x0e, 0x5a, 0xd1, 0x53, 0xc4, 0x6f,
-	0xb8, 0x3e, 0x61, 0xb1, 0x1a, 0x3c, 0xb0, 0x31, 0x11, 0xfd, 0x38, 0x17, 0xd4, 0xc9, 0xdd, 0xd8,
-	0xf9, 0xf7, 0xe2, 0xe6, 0x99, 0x1b, 0x70, 0xbc, 0x2b, 0x1f, 0xb8, 0x0f, 0xb3, 0x67, 0xcf, 0x7a,
-	0x0d, 0x36, 0x86, 0x61, 0xda, 0xb1, 0x2c, 0x79, 0x37, 0x07, 0xc7, 0xf9, 0xe2, 0xa5, 0x94, 0x58,
-	0x7a, 0xa7, 0xca, 0xf1, 0x66, 0xa7, 0x35, 0xd7, 0xd3, 0x40, 0xdc, 0x8d, 0x6f, 0x7f, 0xca, 0x82,
-	0xd1, 0x44, 0x8a, 0x76, 0x4e, 0x06, 0x1a, 0x5b, 0xdd, 0x01, 0x8b, 0xd1, 0x65, 0x39, 0x13, 0x45,
-	0xa6, 0xc0, 0xf5, 0xea, 0xd6, 0x20, 0x6c, 0xe2, 0xd9, 0x9f, 0x2b, 0x40, 0x59, 0x86, 0x14, 0xf5,
-	0xc1, 0xca, 0x27, 0x2c, 0x18, 0x55, 0x27, 0x64, 0xcc, 0xa5, 0x59, 0xc8, 0x23, 0x63, 0x8c, 0x72,
-	0xa0, 0x9c, 0x22, 0xfe, 0x7a, 0xa0, 0x77, 0x0b, 0xd8, 0x24, 0x86, 0x93, 0xb4, 0xd1, 0x75, 0x80,
-	0xa8, 0x13, 0xc5, 0xa4, 0x69, 0x38, 0x57, 0x6d, 0x63, 0x96, 0x4d, 0xd5, 0x82, 0x90, 0xd0, 0x39,
-	0x75, 0x35, 0xa8, 0x93, 0xaa, 0xc2, 0xd4, 0x66, 0x9b, 0x6e, 0xc3, 0x46, 0x4f, 0xf6, 0xaf, 0x15,
-	0xe0, 0x58, 0x9a, 0x25, 0xf4, 0x6e, 0x18, 0x91, 0xd4, 0x8d, 0x4d, 0xb8, 0x0c, 0x88, 0x1a, 0xc1,
-	0x06, 0xec, 0xce, 0xce, 0xe4, 0x64, 0xf7, 0xad, 0xc0, 0x53, 0x26, 0x0a, 0x4e, 0x74, 0xc6, 0x8f,
-	0x29, 0xc5, 0x79, 0xfa, 0x6c, 0x67, 0xa6, 0xd5, 0x12, 0x67, 0x8d, 0xc6, 0x31, 0xa5, 0x09, 0xc5,
-	0x29, 0x6c, 0xb4, 0x02, 0x27, 0x8d, 0x96, 0xab, 0xc4, 0x6d, 0x6c, 0xac, 0x05, 0xa1, 0xdc, 0xf5,
-	0x3d, 0xac, 0xc3, 0x2f, 0xbb, 0x71, 0x70, 0xe6, 0x93, 0xd4, 0xc2, 0xa8, 0x39, 0x2d, 0xa7, 0xe6,
-	0xc6, 0x1d, 0xe1, 0x2d, 0x56, 0xf2, 0x70, 0x4e, 0xb4, 0x63, 0x85, 0x61, 0xff, 0xca, 0x00, 0x1c,
-	0xe3, 0xf1, 0x86, 0x44, 0x85, 0xd3, 0xa2, 0x77, 0x43, 0x25, 0x8a, 0x9d, 0x90, 0x6f, 0xf9, 0xad,
-	0x7d, 0xcb, 0x00, 0x9d, 0xa0, 0x2d, 0x3b, 0xc1, 0xba, 0x3f, 0xf4, 0x12, 0xab, 0x6e, 0xe5, 0x46,
-	0x1b, 0xac, 0xf7, 0xc2, 0xdd, 0x39, 0x14, 0x2e, 0xa8, 0x1e, 0xb0, 0xd1, 0x1b, 0xfa, 0x31, 0x28,
-	0xb5, 0x36, 0x9c, 0x48, 0x7a, 0xbb, 0x1e, 0x97, 0x0b, 0x6e, 0x85, 0x36, 0xde, 0xd9, 0x99, 0x3c,
-	0x95, 0x7e, 0x55, 0x06, 0xc0, 0xfc, 0x21, 0x53, 0x5c, 0x0e, 0xec, 0x7d, 0x03, 0x4b, 0x3d, 0xec,
-	0x54, 0x2f, 0xcd, 0xa4, 0xef, 0xec, 0x98, 0x67, 0xad, 0x58, 0x40, 0xe9, 0xe2, 0xde, 0xe0, 0x24,
-	0xeb, 0x14, 0x79, 0x30, 0xa9, 0xba, 0x2f, 0x69, 0x10, 0x36, 0xf1, 0xd0, 0x27, 0xbb, 0xa3, 0x51,
-	0x87, 0x0e, 0x21, 0x55, 0xa1, 0xdf, 0x38, 0xd4, 0xf3, 0x50, 0x11, 0xac, 0xae, 0x06, 0xe8, 0x1c,
-	0x8c, 0x70, 0x67, 0xca, 0x6c, 0xe8, 0xf8, 0xb5, 0x8d, 0xb4, 0x0b, 0x64, 0xd5, 0x80, 0xe1, 0x04,
-	0xa6, 0xbd, 0x04, 0x03, 0x7d, 0x4a, 0xab, 0xbe, 0x76, 0xb6, 0x2f, 0x42, 0x99, 0x76, 0x27, 0xb7,
-	0x2f, 0x79, 0x74, 0x19, 0x40, 0x59, 0xde, 0xe7, 0x87, 0x6c, 0x28, 0xba, 0x8e, 0x8c, 0x3a, 0x50,
-	0x4b, 0x68, 0x21, 0x8a, 0xda, 0x6c, 0xda, 0x51, 0x20, 0x7a, 0x0c, 0x8a, 0x64, 0xbb, 0x95, 0x0e,
-	0x2f, 0x38, 0xbf, 0xdd, 0x72, 0x43, 0x12, 0x51, 0x24, 0xb2, 0xdd, 0x42, 0x67, 0xa0, 0xe0, 0xd6,
-	0xc5, 0x8c, 0x04, 0x81, 0x53, 0x58, 0x98, 0xc7, 0x05, 0xb7, 0x6e, 0x6f, 0x43, 0x45, 0x5d, 0x20,
-	0x88, 0x36, 0xa5, 0x6d, 0x62, 0xe5, 0x11, 0x6f, 0x2a, 0xfb, 0xed, 0x61, 0x95, 0xb4, 0x01, 0x74,
-	0xe6, 0x7f, 0x5e, 0xba, 0xec, 0x2c, 0x0c, 0xd4, 0x02, 0x51, 0xb3, 0xa5, 0xac, 0xbb, 0x61, 0x46,
-	0x09, 0x83, 0xd8, 0x37, 0x60, 0xec, 0x8a, 0x1f, 0xdc, 0x62, 0xf7, 0xfc, 0xb0, 0xb2, 0xb6, 0xb4,
-	0xe3, 0x75, 0xfa, 0x23, 0x6d, 0x02, 0x33, 0x28, 0xe6, 0x30, 0x55, 0x70, 0xb3, 0xd0, 0xab, 0xe0,
-	0xa6, 0xfd, 0x21, 0x0b, 0x46, 0x54, 0x0a, 0xf1, 0xc5, 0xad, 0x4d, 0xda, 0x6f, 0x23, 0x0c, 0xda,
-	0xad, 0x74, 0xbf, 0xec, 0xae, 0x52, 0xcc, 0x61, 0x66, 0x6e, 0x7d, 0x61, 0x8f, 0xdc, 0xfa, 0xb3,
-	0x30, 0xb0, 0xe9, 0xfa, 0xf5, 0xb4, 0xcb, 0xf0, 0x8a, 0xeb, 0xd7, 0x31, 0x83, 0x50, 0x16, 0x8e,
-	0x29, 0x16, 0xa4, 0xf1, 0x71, 0x0e, 0x46, 0xd6, 0xda, 0xae, 0x57, 0x97, 0xf5, 0x7a, 0x53, 0xcb,
-	0x65, 0xd6, 0x80, 0xe1, 0x04, 0x26, 0x7a, 0x06, 0x60, 0xcd, 0xf5, 0x9d, 0xb0, 0xb3, 0xa2, 0xad,
-	0x1d, 0xa5, 0x00, 0x67, 0x15, 0x04, 0x1b, 0x58, 0xf6, 0x67, 0x8a, 0x30, 0x96, 0x4c, 0xa4, 0xee,
-	0xc3, 0x7d, 0xf0, 0x18, 0x94, 0x58, 0x6e, 0x75, 0xfa, 0xd3, 0xf2, 0x12, 0xb7, 0x1c, 0x86, 0x22,
-	0x18, 0xe4, 0x8b, 0x39, 0x9f, 0xfb, 0x1e, 0x15, 0x93, 0xca, 0xcf, 0xc8, 0xa2, 0x72, 0x85, 0xdb,
-	0x56, 0x90, 0x42, 0x1f, 0xb5, 0x60, 0x28, 0x68, 0x99, 0x85, 0x1a, 0xdf, 0x95, 0x67, 0x92, 0xb9,
-	0xc8, 0xe4, 0x14, 0x3b, 0x3e, 0xf5, 0xe9, 0xe5, 0xe7, 0x90, 0xa4, 0xcf, 0xfc, 0x28, 0x8c, 0x98,
-	0x98, 0x7b, 0x6d, 0xfa, 0xca, 0xe6, 0xa6, 0xef, 0x13, 0xe6, 0xa4, 0x10, 0x69, 0xf4, 0x7d, 0x2c,
-	0xb7, 0x6b, 0x50, 0xaa, 0xa9, 0xd0, 0xa5, 0xbb, 0xaa, 0xf2, 0xae, 0xca, 0x4c, 0xb1, 0x63, 0x61,
-	0xde, 0x9b, 0xfd, 0x4d, 0xcb, 0x98, 0x1f, 0x98, 0x44, 0x0b, 0x75, 0x14, 0x42, 0xb1, 0xb1, 0xb5,
-	0x29, 0xd4, 0xfc, 0xe5, 0x9c, 0x86, 0xf7, 0xe2, 0xd6, 0xa6, 0x9e, 0xe3, 0x66, 0x2b, 0xa6, 0xc4,
-	0xfa, 0x70, 0x86, 0x27, 0xaa, 0x2d, 0x14, 0xf7, 0xae, 0xb6, 0x60, 0xbf, 0x5e, 0x80, 0xe3, 0x5d,
-	0x93, 0x0a, 0xbd, 0x06, 0xa5, 0x90, 0xbe, 0xa5, 0x78, 0xbd, 0xc5, 0xdc, 0xea, 0x23, 0x44, 0x0b,
-	0x75, 0xad, 0x3e, 0x93, 0xed, 0x98, 0x93, 0x44, 0x97, 0x01, 0xe9, 0x00, 0x3b, 0xe5, 0x89, 0xe7,
-	0xaf, 0xac, 0xa2, 0x70, 0x66, 0xba, 0x30, 0x70, 0xc6, 0x53, 0xe8, 0x85, 0xb4, 0x43, 0xbf, 0x98,
-	0x3c, 0x49, 0xda, 0xcd, 0x37, 0x6f, 0xff, 0x56, 0x01, 0x46, 0x13, 0x75, 0x33, 0x91, 0x07, 0x65,
-	0xe2, 0xb1, 0x63, 0x3e, 0xa9, 0x6c, 0x0e, 0x7a, 0x0b, 0x86, 0x52, 0x90, 0xe7, 0x45, 0xbf, 0x58,
-	0x51, 0xb8, 0x3f, 0x82, 0x73, 0xce, 0xc1, 0x88, 0x64, 0xe8, 0x5d, 0x4e, 0xd3, 0x13, 0x03, 0xa8,
-	0xe6, 0xe8, 0x79, 0x03, 0x86, 0x13, 0x98, 0xf6, 0xef, 0x17, 0x61, 0x82, 0x9f, 0x8b, 0xd6, 0xd5,
-	0xcc, 0x5b, 0x92, 0xfe, 0x84, 0x9f, 0xd7, 0xd5, 0x6d, 0xad, 0x3c, 0xae, 0x7a, 0xee, 0x45, 0xa8,
-	0xaf, 0x98, 0xd2, 0x2f, 0xa6, 0x62, 0x4a, 0xf9, 0x16, 0xaf, 0x71, 0x48, 0x1c, 0x7d, 0x77, 0x05,
-	0x99, 0xfe, 0x83, 0x02, 0x8c, 0xa7, 0x6e, 0xf4, 0x42, 0x9f, 0x49, 0x5e, 0x02, 0x61, 0xe5, 0x71,
-	0x66, 0xb4, 0xeb, 0x25, 0x4f, 0xfb, 0xbb, 0x0a, 0xe2, 0x1e, 0x2d, 0x15, 0xfb, 0x1b, 0x05, 0x18,
-	0x4b, 0x5e, 0x45, 0x76, 0x1f, 0x8e, 0xd4, 0x5b, 0xa1, 0xc2, 0x6e, 0xdb, 0x61, 0x37, 0xe8, 0xf3,
-	0x23, 0x27, 0x7e, 0xb1, 0x89, 0x6c, 0xc4, 0x1a, 0x7e, 0x5f, 0xdc, 0xb0, 0x61, 0xff, 0x23, 0x0b,
-	0x4e, 0xf1, 0xb7, 0x4c, 0xcf, 0xc3, 0xbf, 0x91, 0x35, 0xba, 0x2f, 0xe7, 0xcb, 0x60, 0xaa, 0x2a,
-	0xf3, 0x5e, 0xe3, 0xcb, 0x2e, 0xbc, 0x16, 0xdc, 0x26, 0xa7, 0xc2, 0x7d, 0xc8, 0xec, 0xbe, 0x26,
-	0x83, 0xfd, 0x8d, 0x22, 0xe8, 0x3b, 0xbe, 0x91, 0x2b, 0xb2, 0xde, 0x73, 0xa9, 0x4e, 0x5d, 0xed,
-	0xf8, 0x35, 0x7d, 0x9b, 0x78, 0x39, 0x95, 0xf4, 0xfe, 0xb3, 0x16, 0x0c, 0xbb, 0xbe, 0x1b, 0xbb,
-	0x0e, 0x73, 0xd9, 0xe4, 0x73, 0x51, 0xaf, 0x22, 0xb7, 0xc0, 0x7b, 0x0e, 0x42, 0xf3, 0x9c, 0x52,
-	0x11, 0xc3, 0x26, 0x65, 0xf4, 0x3e, 0x91, 0xf6, 0x51, 0xcc, 0xad, 0x74, 0x44, 0x39, 0x95, 0xeb,
-	0xd1, 0xa2, 0x86, 0x57, 0x1c, 0xe6, 0x54, 0x71, 0x05, 0xd3, 0xae, 0xd4, 0x45, 0x07, 0xca, 0xb4,
-	0x65, 0xcd, 0x98, 0x13, 0xb2, 0x23, 0x40, 0xdd, 0x63, 0xb1, 0xcf, 0x90, 0xfa, 0x69, 0xa8, 0x38,
-	0xed, 0x38, 0x68, 0xd2, 0x61, 0x12, 0x47, 0xa9, 0x3a, 0x69, 0x40, 0x02, 0xb0, 0xc6, 0xb1, 0x3f,
-	0x53, 0x82, 0x54, 0x1a, 0x3a, 0xda, 0x36, 0xef, 0xa7, 0xb7, 0xf2, 0xbd, 0x9f, 0x5e, 0x31, 0x93,
-	0x75, 0x47, 0x3d, 0x6a, 0x48, 0xef, 0x17, 0xb7, 0x31, 0x5f, 0x4c, 0x7b, 0xbf, 0x7e, 0xa2, 0xbf,
-	0x53, 0x05, 0x3a, 0x57, 0xa7, 0x79, 0xd5, 0xb1, 0xa9, 0x3d, 0x1d, 0x65, 0x7b, 0x5d, 0x55, 0xfc,
-	0x61, 0x71, 0xad, 0x10, 0x26, 0x51, 0xdb, 0x8b, 0xc5, 0x6c, 0x78, 0x31, 0xc7, 0x55, 0xc6, 0x3b,
-	0xd6, 0xb5, 0x5c, 0xf8, 0x7f, 0x6c, 0x10, 0x4d, 0xba, 0x33, 0x07, 0x0f, 0xd5, 0x9d, 0x39, 0x94,
-	0xab, 0x3b, 0xf3, 0x19, 0x00, 0x36, 0xb7, 0x79, 0xe8, 0x6f, 0x99, 0x79, 0x99, 0x94, 0x28, 0xc4,
-	0x0a, 0x82, 0x0d, 0x2c, 0xfb, 0x87, 0x21, 0x59, 0x8c, 0x08, 0x4d, 0xca, 0xda, 0x47, 0xfc, 0xc4,
-	0x83, 0x65, 0x5d, 0x25, 0xca, 0x14, 0xfd, 0x86, 0x05, 0x66, 0xc5, 0x24, 0xf4, 0x2a, 0x2f, 0xcd,
-	0x64, 0xe5, 0x71, 0x32, 0x6e, 0xf4, 0x3b, 0xb5, 0xe4, 0xb4, 0x52, 0x21, 0x1a, 0xb2, 0x3e, 0xd3,
-	0x99, 0xb7, 0x41, 0x59, 0x42, 0xf7, 0x65, 0xd4, 0x7d, 0x10, 0x4e, 0xc8, 0x0c, 0x6e, 0xe9, 0xa3,
-	0x17, 0xa7, 0xaa, 0x7b, 0xbb, 0x7e, 0xa4, 0x3f, 0xa7, 0xd0, 0xcb, 0x9f, 0xa3, 0x76, 0xa9, 0xc5,
-	0x9e, 0x45, 0x97, 0x7f, 0xd3, 0x82, 0xb3, 0x69, 0x06, 0xa2, 0xa5, 0xc0, 0x77, 0xe3, 0x20, 0xac,
-	0x92, 0x38, 0x76, 0xfd, 0x06, 0xab, 0xa0, 0x79, 0xcb, 0x09, 0xe5, 0x2d, 0x2a, 0x4c, 0x50, 0xde,
-	0x70, 0x42, 0x1f, 0xb3, 0x56, 0xd4, 0x81, 0x41, 0x1e, 0x1f, 0x2a, 0xac, 0xf5, 0x03, 0xae, 0x8d,
-	0x8c, 0xe1, 0xd0, 0xdb, 0x05, 0x1e, 0x9b, 0x8a, 0x05, 0x41, 0xfb, 0x5b, 0x16, 0xa0, 0xe5, 0x2d,
-	0x12, 0x86, 0x6e, 0xdd, 0x88, 0x68, 0x65, 0xd7, 0xf3, 0x19, 0xd7, 0xf0, 0x99, 0xf5, 0x05, 0x52,
-	0xd7, 0xf3, 0x19, 0xff, 0xb2, 0xaf, 0xe7, 0x2b, 0xec, 0xef, 0x7a, 0x3e, 0xb4, 0x0c, 0xa7, 0x9a,
-	0x7c, 0xbb, 0xc1, 0xaf, 0xbc, 0xe2, 0x7b, 0x0f, 0x95, 0x0a, 0x7b, 0xfa, 0xf6, 0xce, 0xe4, 0xa9,
-	0xa5, 0x2c, 0x04, 0x9c, 0xfd, 0x9c, 0xfd, 0x36, 0x40, 0x3c, 0x90, 0x75, 0x2e, 0x2b, 0x16, 0xaf,
-	0xa7, 0xfb, 0xc5, 0xfe, 0x42, 0x09, 0xc6, 0x53, 0x35, 0xf6, 0xe9, 0x56, 0xaf, 0x3b, 0xf8, 0xef,
-	0xc0, 0xfa, 0xbb, 0x9b, 0xbd, 0xbe, 0xc2, 0x09, 0x7d, 0x28, 0xb9, 0x7e, 0xab, 0x1d, 0xe7, 0x93,
-	0x89, 0xcf, 0x99, 0x58, 0xa0, 0x1d, 0x1a, 0xee, 0x62, 0xfa, 0x17, 0x73, 0x32, 0x79, 0x06, 0x27,
-	0x26, 0x8c, 0xf1, 0x81, 0x7b, 0xe4, 0x0e, 0xf8, 0xb0, 0x0e, 0x15, 0x2c, 0xe5, 0xe1, 0x58, 0x4c,
-	0x4d, 0x96, 0xc3, 0x0e, 0x25, 0xf9, 0x6a, 0x01, 0x86, 0x8d, 0x8f, 0x86, 0x7e, 0x39, 0x59, 0x4f,
-	0xd0, 0xca, 0xef, 0x95, 0x58, 0xff, 0x53, 0xba, 0x62, 0x20, 0x7f, 0xa5, 0xc7, 0xbb, 0x4b, 0x09,
-	0xde, 0xd9, 0x99, 0x3c, 0x96, 0x2a, 0x16, 0x98, 0x28, 0x2f, 0x78, 0xe6, 0x03, 0x30, 0x9e, 0xea,
-	0x26, 0xe3, 0x95, 0x57, 0xcd, 0x57, 0x3e, 0xb0, 0x5b, 0xca, 0x1c, 0xb2, 0xaf, 0xd0, 0x21, 0x13,
-	0x09, 0xc0, 0x81, 0x47, 0xfa, 0xf0, 0xc1, 0xa6, 0xf2, 0xfc, 0x0b, 0x7d, 0xe6, 0xf9, 0x3f, 0x01,
-	0xe5, 0x56, 0xe0, 0xb9, 0x35, 0x57, 0x95, 0x23, 0x66, 0x95, 0x05, 0x56, 0x44, 0x1b, 0x56, 0x50,
-	0x74, 0x0b, 0x2a, 0x37, 0x6f, 0xc5, 0xfc, 0xf4, 0x47, 0xf8, 0xb7, 0xf3, 0x3a, 0xf4, 0x51, 0x46,
-	0x8b, 0x3a, 0x5e, 0xc2, 0x9a, 0x16, 0xb2, 0x61, 0x90, 0x29, 0x41, 0x99, 0x0c, 0xc4, 0x7c, 0xef,
-	0x4c, 0x3b, 0x46, 0x58, 0x40, 0xec, 0xef, 0x00, 0x9c, 0xcc, 0xba, 0xe8, 0x04, 0xbd, 0x1f, 0x06,
-	0x39, 0x8f, 0xf9, 0xdc, 0xa5, 0x95, 0x45, 0xe3, 0x22, 0xeb, 0x50, 0xb0, 0xc5, 0x7e, 0x63, 0x41,
-	0x53, 0x50, 0xf7, 0x9c, 0x35, 0x31, 0x43, 0x0e, 0x87, 0xfa, 0xa2, 0xa3, 0xa9, 0x2f, 0x3a, 0x9c,
-	0xba, 0xe7, 0xac, 0xa1, 0x6d, 0x28, 0x35, 0xdc, 0x98, 0x38, 0xc2, 0x89, 0x70, 0xe3, 0x50, 0x88,
-	0x13, 0x87, 0x5b, 0x69, 0xec, 0x27, 0xe6, 0x04, 0xd1, 0x97, 0x2c, 0x18, 0x5f, 0x4b, 0x16, 0x18,
-	0x11, 0xc2, 0xd3, 0x39, 0x84, 0xcb, 0x6c, 0x92, 0x84, 0xf8, 0xfd, 0x94, 0xa9, 0x46, 0x9c, 0x66,
-	0x07, 0x7d, 0xc4, 0x82, 0xa1, 0x75, 0xd7, 0x33, 0x6e, 0x0b, 0x38, 0x84, 0x8f, 0x73, 0x81, 0x11,
-	0xd0, 0x3b, 0x0e, 0xfe, 0x3f, 0xc2, 0x92, 0x72, 0x2f, 0x4d, 0x35, 0x78, 0x50, 0x4d, 0x35, 0x74,
-	0x8f, 0x34, 0xd5, 0xc7, 0x2d, 0xa8, 0xa8, 0x91, 0x16, 0x85, 0x1a, 0xde, 0x7d, 0x88, 0x9f, 0x9c,
-	0x7b, 0x4e, 0xd4, 0x5f, 0xac, 0x89, 0xa3, 0xcf, 0x5a, 0x30, 0xec, 0xbc, 0xd6, 0x0e, 0x49, 0x9d,
-	0x6c, 0x05, 0xad, 0x48, 0x94, 0x4f, 0x7c, 0x39, 0x7f, 0x66, 0x66, 0x28, 0x91, 0x79, 0xb2, 0xb5,
-	0xdc, 0x8a, 0x44, 0xa2, 0xa2, 0x6e, 0xc0, 0x26, 0x0b, 0xe8, 0x67, 0xb4, 0x1e, 0x87, 0x3c, 0x8a,
-	0xe8, 0x66, 0x71, 0x73, 0xd8, 0xca, 0x7c, 0xa7, 0x00, 0x93, 0x7b, 0x8c, 0x02, 0x3a, 0x07, 0x23,
-	0x41, 0xd8, 0x70, 0x7c, 0xf7, 0x35, 0xb3, 0xea, 0x91, 0xb2, 0x14, 0x97, 0x0d, 0x18, 0x4e, 0x60,
-	0x9a, 0xe5, 0x30, 0x0a, 0x7b, 0x94, 0xc3, 0x38, 0x0b, 0x03, 0x21, 0x69, 0x05, 0xe9, 0x0d, 0x0f,
-	0x4b, 0x74, 0x62, 0x10, 0xf4, 0x08, 0x14, 0x9d, 0x96, 0x2b, 0xc2, 0x63, 0xd4, 0x3e, 0x6e, 0x66,
-	0x65, 0x01, 0xd3, 0xf6, 0x44, 0x75, 0x9e, 0xd2, 0x91, 0x54, 0xe7, 0xa1, 0xaa, 0x4c, 0x9c, 0xbf,
-	0x0c, 0x6a, 0x55, 0x96, 0x3c, 0x17, 0xb1, 0x5f, 0x2f, 0xc2, 0x23, 0xbb, 0xce, 0x79, 0x1d, 0x2b,
-	0x6b, 0xed, 0x12, 0x2b, 0x2b, 0x87, 0xa7, 0xb0, 0xd7, 0xf0, 0x14, 0x7b, 0x0c, 0xcf, 0x47, 0xe8,
-	0x52, 0x96, 0xd5, 0xa2, 0xf2, 0xb9, 0x62, 0xb9, 0x57, 0xf1, 0x29, 0xb1, 0x8a, 0x25, 0x14, 0x6b,
-	0xba, 0x74, 0x1f, 0x93, 0x28, 0x05, 0x51, 0xca, 0x43, 0x95, 0xf5, 0xac, 0xd8, 0xc4, 0xd7, 0x6f,
-	0xaf, 0xfa, 0x12, 0xf6, 0x6f, 0x0f, 0xc0, 0x63, 0x7d, 0x68, 0x20, 0x73, 0x16, 0x5b, 0x7d, 0xce,
-	0xe2, 0xef, 0xf2, 0xcf, 0xf4, 0xb1, 0xcc, 0xcf, 0x84, 0xf3, 0xff, 0x4c, 0xbb, 0x7f, 0x21, 0xf4,
-	0x24, 0x94, 0x5d, 0x3f, 0x22, 0xb5, 0x76, 0xc8, 0xf3, 0x06, 0x8c, 0x2c, 0xc8, 0x05, 0xd1, 0x8e,
-	0x15, 0x06, 0xdd, 0x97, 0xd6, 0x1c, 0xba, 0xfc, 0x87, 0x72, 0x4a, 0xfd, 0x37, 0x13, 0x2a, 0xb9,
-	0x59, 0x34, 0x37, 0x43, 0x25, 0x00, 0x27, 0x63, 0xff, 0x82, 0x05, 0x67, 0x7a, 0x9b, 0x09, 0xe8,
-	0x69, 0x18, 0x5e, 0x63, 0xc1, 0x67, 0xec, 0x72, 0x7d, 0x39, 0x75, 0xd8, 0xfb, 0xea, 0x66, 0x6c,
-	0xe2, 0xa0, 0x39, 0x38, 0x6e, 0x46, 0xad, 0x2d, 0x19, 0x91, 0x31, 0xcc, 0x91, 0xb1, 0x9a, 0x06,
-	0xe2, 0x6e, 0x7c, 0xfb, 0xdb, 0xc5, 0x6c, 0xb6, 0xb8, 0x39, 0xb9, 0x9f, 0xd9, 0x2c, 0xe6, 0x6a,
-	0xa1, 0x0f, 0x89, 0x5b, 0x3c, 0x6a, 0x89, 0x3b, 0xd0, 0x4b, 0xe2, 0xa2, 0x79, 0x38, 0x66, 0xdc,
-	0x7e, 0xc8, 0x8b, 0x41, 0xf0, 0x48, 0x49, 0x55, 0xc9, 0x69, 0x25, 0x05, 0xc7, 0x5d, 0x4f, 0xdc,
-	0xe7, 0x53, 0xef, 0x57, 0x0a, 0x70, 0xba, 0xa7, 0x05, 0x7f, 0x44, 0x1a, 0xc5, 0xfc, 0xfc, 0x03,
-	0x47, 0xf3, 0xf9, 0xcd, 0x8f, 0x52, 0xda, 0xeb, 0xa3, 0xd8, 0x7f, 0x52, 0xe8, 0xb9, 0x10, 0xe8,
-	0x6e, 0xee, 0x7b, 0x76, 0x94, 0x5e, 0x80, 0x51, 0xa7, 0xd5, 0xe2, 0x78, 0x2c, 0xea, 0x3c, 0x55,
-	0x39, 0x6e, 0xc6, 0x04, 0xe2, 0x24, 0x6e, 0x5f, 0x36, 0xcd, 0x9f, 0x5b, 0x50, 0xc1, 0x64, 0x9d,
-	0x4b, 0x23, 0x74, 0x53, 0x0c, 0x91, 0x95, 0x47, 0xed, 0x6e, 0x3a, 0xb0, 0x91, 0xcb, 0x6a, 0x5a,
-	0x67, 0x0d, 0xf6, 0x41, 0x73, 0xaf, 0xd5, 0x7d, 0x88, 0xc5, 0xde, 0xf7, 0x21, 0xda, 0xff, 0xad,
-	0x4c, 0x5f, 0xaf, 0x15, 0xcc, 0x85, 0xa4, 0x1e, 0xd1, 0xef, 0xdb, 0x0e, 0x3d, 0x31, 0x49, 0xd4,
-	0xf7, 0xbd, 0x86, 0x17, 0x31, 0x6d, 0x4f, 0x1c, 0xf2, 0x15, 0xf6, 0x55, 0x37, 0xab, 0xb8, 0x67,
-	0xdd, 0xac, 0x17, 0x60, 0x34, 0x8a, 0x36, 0x56, 0x42, 0x77, 0xcb, 0x89, 0xc9, 0x15, 0xd2, 0x11,
-	0xb6, 0xaf, 0xae, 0x21, 0x53, 0xbd, 0xa4, 0x81, 0x38, 0x89, 0x8b, 0x2e, 0xc2, 0x71, 0x5d, 0xbd,
-	0x8a, 0x84, 0x31, 0xcb, 0x8b, 0xe2, 0x33, 0x41, 0x15, 0x8c, 0xd0, 0xf5, 0xae, 0x04, 0x02, 0xee,
-	0x7e, 0x86, 0xca, 0xd3, 0x44, 0x23, 0x65, 0x64, 0x30, 0x29, 0x4f, 0x13, 0xfd, 0x50, 0x5e, 0xba,
-	0x9e, 0x40, 0x4b, 0x70, 0x82, 0x4f, 0x8c, 0x99, 0x56, 0xcb, 0x78, 0xa3, 0xa1, 0x64, 0xcd, 0xe4,
-	0x8b, 0xdd, 0x28, 0x38, 0xeb, 0x39, 0xf4, 0x3c, 0x0c, 0xab, 0xe6, 0x85, 0x79, 0x71, 0x3e, 0xa5,
-	0xfc, 0x63, 0xaa, 0x9b, 0x85, 0x3a, 0x36, 0xf1, 0xd0, 0xbb, 0xe0, 0x41, 0xfd, 0x97, 0x27, 0xcf,
-	0xf2, 0x43, 0xdb, 0x79, 0x51, 0x18, 0x50, 0xdd, 0xc7, 0x73, 0x31, 0x13, 0xad, 0x8e, 0x7b, 0x3d,
-	0x8f, 0xd6, 0xe0, 0x8c, 0x02, 0x9d, 0xf7, 0x63, 0x96, 0x09, 0x17, 0x91, 0x59, 0x27, 0x22, 0xd7,
-	0x42, 0x8f, 0x95, 0x12, 0xac, 0xe8, 0x0b, 0xda, 0x2f, 0xba, 0xf1, 0xa5, 0x2c, 0x4c, 0xbc, 0x88,
-	0x77, 0xe9, 0x05, 0x4d, 0x43, 0x85, 0xf8, 0xce, 0x9a, 0x47, 0x96, 0xe7, 0x16, 0x58, 0x81, 0x41,
-	0xe3, 0x8c, 0xf8, 0xbc, 0x04, 0x60, 0x8d, 0xa3, 0x62, 0x97, 0x47, 0x7a, 0xc5, 0x2e, 0xa3, 0x15,
-	0x38, 0xd9, 0xa8, 0xb5, 0xa8, 0x45, 0xe8, 0xd6, 0xc8, 0x4c, 0x8d, 0x85, 0x6a, 0xd2, 0x0f, 0xc3,
-	0x8b, 0x59, 0xab, 0x24, 0x90, 0x8b, 0x73, 0x2b, 0x5d, 0x38, 0x38, 0xf3, 0x49, 0x16, 0xd2, 0x1b,
-	0x06, 0xdb, 0x9d, 0x89, 0x13, 0xa9, 0x90, 0x5e, 0xda, 0x88, 0x39, 0x0c, 0x5d, 0x06, 0xc4, 0x32,
-	0x8a, 0x2e, 0xc5, 0x71, 0x4b, 0x99, 0xa0, 0x13, 0x27, 0x93, 0x65, 0xc2, 0x2e, 0x74, 0x61, 0xe0,
-	0x8c, 0xa7, 0xa8, 0x45, 0xe3, 0x07, 0xac, 0xf7, 0x89, 0x07, 0x93, 0x16, 0xcd, 0x55, 0xde, 0x8c,
-	0x25, 0x1c, 0xbd, 0x07, 0x26, 0xda, 0x11, 0x61, 0x9b, 0xdb, 0x1b, 0x41, 0xb8, 0xe9, 0x05, 0x4e,
-	0x7d, 0x81, 0x5d, 0xbc, 0x18, 0x77, 0x26, 0x26, 0x18, 0xf1, 0xb3, 0xe2, 0xd9, 0x89, 0x6b, 0x3d,
-	0xf0, 0x70, 0xcf, 0x1e, 0xd2, 0x75, 0xee, 0x4e, 0xf7, 0x57, 0xe7, 0xce, 0xfe, 0x33, 0x0b, 0x46,
-	0x95, 0xbc, 0x39, 0x82, 0x3c, 0x44, 0x2f, 0x99, 0x87, 0x78, 0xf1, 0xe0, 0x12, 0x9b, 0x71, 0xde,
-	0x23, 0xd8, 0xff, 0x5f, 0x8e, 0x00, 0x68, 0xa9, 0xae, 0x14, 0xaa, 0xd5, 0x53, 0xa1, 0xde, 0xb7,
-	0x12, 0x35, 0xab, 0xca, 0x58, 0xe9, 0xde, 0x56, 0x19, 0xab, 0xc2, 0x29, 0x69, 0xee, 0xf0, 0x53,
-	0xd4, 0x4b, 0x41, 0xa4, 0x04, 0xb4, 0x71, 0x91, 0xd6, 0x42, 0x16, 0x12, 0xce, 0x7e, 0x36, 0x61,
-	0x65, 0x0d, 0xed, 0x69, 0xfa, 0x2a, 0x99, 0xb4, 0xb8, 0x2e, 0xaf, 0xb9, 0x4b, 0xc9, 0xa4, 0xc5,
-	0x0b, 0x55, 0xac, 0x71, 0xb2, 0x15, 0x53, 0x25, 0x27, 0xc5, 0x04, 0xfb, 0x56, 0x4c, 0x52, 0x44,
-	0x0e, 0xf7, 0x14, 0x91, 0xf2, 0xb4, 0x66, 0xa4, 0xe7, 0x69, 0xcd, 0xdb, 0x61, 0xcc, 0xf5, 0x37,
-	0x48, 0xe8, 0xc6, 0xa4, 0xce, 0xd6, 0x02, 0x13, 0x9f, 0x65, 0x6d, 0x96, 0x2c, 0x24, 0xa0, 0x38,
-	0x85, 0x9d, 0x94, 0xeb, 0x63, 0x7d, 0xc8, 0xf5, 0x1e, 0xda, 0x74, 0x3c, 0x1f, 0x6d, 0x7a, 0xec,
-	0xe0, 0xda, 0xf4, 0xf8, 0xa1, 0x6a, 0x53, 0x94, 0x8b, 0x36, 0xed, 0x4b, 0x51, 0x19, 0xdb, 0xe5,
-	0x93, 0x7b, 0x6c, 0x97, 0x7b, 0xa9, 0xd2, 0x53, 0x77, 0xad, 0x4a, 0xb3, 0xb5, 0xe4, 0x03, 0xdf,
-	0x97, 0x5a, 0xf2, 0xe3, 0x05, 0x38, 0xa5, 0xf5, 0x08, 0x5d, 0xbd, 0xee, 0x3a, 0x95, 0xa4, 0xec,
-	0xa6, 0x57, 0x7e, 0x22, 0x6b, 0xa4, 0xd8, 0xea, 0x6c, 0x5d, 0x05, 0xc1, 0x06, 0x16, 0xcb, 0x54,
-	0x25, 0x21, 0xbb, 0x66, 0x20, 0xad, 0x64, 0xe6, 0x44, 0x3b, 0x56, 0x18, 0x94, 0x65, 0xfa, 0x5b,
-	0x54, 0x1c, 0x48, 0x17, 0xb0, 0x9d, 0xd3, 0x20, 0x6c, 0xe2, 0xa1, 0x27, 0x38, 0x11, 0x26, 0xe0,
-	0xa8, 0xa2, 0x19, 0xe1, 0x5b, 0x36, 0x25, 0xd3, 0x14, 0x54, 0xb2, 0xc3, 0x52, 0x92, 0x4b, 0xdd,
-	0xec, 0xb0, 0xe0, 0x46, 0x85, 0x61, 0xff, 0x0f, 0x0b, 0x4e, 0x67, 0x0e, 0xc5, 0x11, 0x18, 0x0f,
-	0xdb, 0x49, 0xe3, 0xa1, 0x9a, 0xd7, 0x76, 0xcf, 0x78, 0x8b, 0x1e, 0x86, 0xc4, 0xbf, 0xb7, 0x60,
-	0x4c, 0xe3, 0x1f, 0xc1, 0xab, 0xba, 0xc9, 0x57, 0xcd, 0x6f, 0x67, 0x5b, 0xe9, 0x7a, 0xb7, 0xdf,
-	0x2f, 0x80, 0x2a, 0x2a, 0x3d, 0x53, 0x93, 0x25, 0xfb, 0xf7, 0x88, 0x11, 0xe8, 0xc0, 0x20, 0x0b,
-	0x71, 0x88, 0xf2, 0x09, 0xdf, 0x4a, 0xd2, 0x67, 0xe1, 0x12, 0xfa, 0xc4, 0x89, 0xfd, 0x8d, 0xb0,
-	0x20, 0xc8, 0x2e, 0xc1, 0xe0, 0xf5, 0x7a, 0xeb, 0x22, 0xe1, 0x52, 0x5f, 0x82, 0x21, 0xda, 0xb1,
-	0xc2, 0xa0, 0xea, 0xcd, 0xad, 0x05, 0xfe, 0x9c, 0xe7, 0x44, 0xf2, 0xf2, 0x77, 0xa5, 0xde, 0x16,
-	0x24, 0x00, 0x6b, 0x1c, 0x16, 0xfd, 0xe0, 0x46, 0x2d, 0xcf, 0xe9, 0x18, 0xfe, 0x0b, 0xa3, 0xb2,
-	0x8e, 0x02, 0x61, 0x13, 0xcf, 0x6e, 0xc2, 0x44, 0xf2, 0x25, 0xe6, 0xc9, 0x3a, 0x0b, 0x3d, 0xee,
-	0x6b, 0x38, 0xa7, 0xa1, 0xe2, 0xb0, 0xa7, 0x16, 0xdb, 0x8e, 0x90, 0x09, 0x3a, 0x00, 0x57, 0x02,
-	0xb0, 0xc6, 0xb1, 0xff, 0xa1, 0x05, 0x27, 0x32, 0x06, 0x2d, 0xc7, 0x84, 0xd6, 0x58, 0x4b, 0x9b,
-	0x2c, 0xc3, 0xe4, 0x87, 0x60, 0xa8, 0x4e, 0xd6, 0x1d, 0x19, 0xdc, 0x6a, 0x88, 0xf4, 0x79, 0xde,
-	0x8c, 0x25, 0xdc, 0xfe, 0xad, 0x02, 0x8c, 0x27, 0x79, 0x8d, 0x58, 0x92, 0x18, 0x1f, 0x26, 0x37,
-	0xaa, 0x05, 0x5b, 0x24, 0xec, 0xd0, 0x37, 0xb7, 0x52, 0x49, 0x62, 0x5d, 0x18, 0x38, 0xe3, 0x29,
-	0x56, 0x52, 0xbe, 0xae, 0x46, 0x5b, 0xce, 0xc8, 0xeb, 0x79, 0xce, 0x48, 0xfd, 0x31, 0xcd, 0x40,
-	0x18, 0x45, 0x12, 0x9b, 0xf4, 0xa9, 0x81, 0xc4, 0xa2, 0xee, 0x67, 0xdb, 0xae, 0x17, 0xbb, 0xbe,
-	0x78, 0x65, 0x31, 0x57, 0x95, 0x81, 0xb4, 0xd4, 0x8d, 0x82, 0xb3, 0x9e, 0xb3, 0xbf, 0x35, 0x00,
-	0xaa, 0x58, 0x03, 0x0b, 0x54, 0xcc, 0x29, 0xcc, 0x73, 0xbf, 0xa9, 0x86, 0x6a, 0x6e, 0x0d, 0xec,
-	0x16, 0x39, 0xc4, 0x9d, 0x5e, 0xa6, 0xe7, 0x5b, 0x0d, 0xd8, 0xaa, 0x06, 0x61, 0x13, 0x8f, 0x72,
-	0xe2, 0xb9, 0x5b, 0x84, 0x3f, 0x34, 0x98, 0xe4, 0x64, 0x51, 0x02, 0xb0, 0xc6, 0xa1, 0x9c, 0xd4,
-	0xdd, 0xf5, 0x75, 0xe1, 0xc1, 0x51, 0x9c, 0xd0, 0xd1, 0xc1, 0x0c, 0xc2, 0x2f, 0x1d, 0x09, 0x36,
-	0xc5, 0xa6, 0xc0, 0xb8, 0x74, 0x24, 0xd8, 0xc4, 0x0c, 0x42, 0xbf, 0x92, 0x1f, 0x84, 0x4d, 0xc7,
-	0x73, 0x5f, 0x23, 0x75, 0x45, 0x45, 0x6c, 0x06, 0xd4, 0x57, 0xba, 0xda, 0x8d, 0x82, 0xb3, 0x9e,
-	0xa3, 0x13, 0xba, 0x15, 0x92, 0xba, 0x5b, 0x8b, 0xcd, 0xde, 0x20, 0x39, 0xa1, 0x57, 0xba, 0x30,
-	0x70, 0xc6, 0x53, 0x68, 0x06, 0xc6, 0x65, 0xb1, 0x0d, 0x59, 0xbe, 0x6d, 0x38, 0x59, 0x2e, 0x0a,
-	0x27, 0xc1, 0x38, 0x8d, 0x4f, 0x85, 0x64, 0x53, 0x14, 0x9f, 0x64, 0x7b, 0x07, 0x43, 0x48, 0xca,
-	0xa2, 0x94, 0x58, 0x61, 0xd8, 0x1f, 0x2e, 0x52, 0xa5, 0xde, 0xa3, 0xc6, 0xeb, 0x91, 0x85, 0x15,
-	0x27, 0x67, 0xe4, 0x40, 0x1f, 0x33, 0xf2, 0x39, 0x18, 0xb9, 0x19, 0x05, 0xbe, 0x0a, 0xd9, 0x2d,
-	0xf5, 0x0c, 0xd9, 0x35, 0xb0, 0xb2, 0x43, 0x76, 0x07, 0xf3, 0x0a, 0xd9, 0x1d, 0xba, 0xcb, 0x90,
-	0xdd, 0x3f, 0x2c, 0x81, 0xba, 0x55, 0xee, 0x2a, 0x89, 0x6f, 0x05, 0xe1, 0xa6, 0xeb, 0x37, 0x58,
-	0xe1, 0x88, 0x2f, 0x59, 0xb2, 0xf6, 0xc4, 0xa2, 0x99, 0x72, 0xb9, 0x9e, 0xd3, 0xcd, 0x60, 0x09,
-	0x62, 0x53, 0xab, 0x06, 0xa1, 0xd4, 0x65, 0xfd, 0x26, 0x08, 0x27, 0x38, 0x42, 0x1f, 0x00, 0x90,
-	0xee, 0xee, 0x75, 0x29, 0x81, 0x17, 0xf2, 0xe1, 0x0f, 0x93, 0x75, 0x6d, 0x52, 0xaf, 0x2a, 0x22,
-	0xd8, 0x20, 0x88, 0x3e, 0xae, 0xd3, 0x51, 0x79, 0x6e, 0xcf, 0xfb, 0x0e, 0x65, 0x6c, 0xfa, 0x49,
-	0x46, 0xc5, 0x30, 0xe4, 0xfa, 0x0d, 0x3a, 0x4f, 0x44, 0x68, 0xe3, 0x5b, 0xb2, 0x0a, 0xfc, 0x2c,
-	0x06, 0x4e, 0x7d, 0xd6, 0xf1, 0x1c, 0xbf, 0x46, 0xc2, 0x05, 0x8e, 0xae, 0x35, 0xa8, 0x68, 0xc0,
-	0xb2, 0xa3, 0xae, 0xab, 0xef, 0x4a, 0xfd, 0x5c, 0x7d, 0x77, 0xe6, 0x1d, 0x70, 0xbc, 0xeb, 0x63,
-	0xee, 0x2b, 0xf7, 0xf4, 0xee, 0xd3, 0x56, 0xed, 0xdf, 0x1e, 0xd4, 0x4a, 0xeb, 0x6a, 0x50, 0xe7,
-	0x37, 0xa9, 0x85, 0xfa, 0x8b, 0x0a, 0x93, 0x39, 0xc7, 0x29, 0xa2, 0xd4, 0x8c, 0xd1, 0x88, 0x4d,
-	0x92, 0x74, 0x8e, 0xb6, 0x9c, 0x90, 0xf8, 0x87, 0x3d, 0x47, 0x57, 0x14, 0x11, 0x6c, 0x10, 0x44,
-	0x1b, 0x89, 0xe4, 0xb3, 0x0b, 0x07, 0x4f, 0x3e, 0x63, 0xe5, 0x16, 0xb3, 0x2e, 0x1c, 0xfa, 0xac,
-	0x05, 0x63, 0x7e, 0x62, 0xe6, 0xe6, 0x13, 0x6f, 0x9e, 0xbd, 0x2a, 0xf8, 0xa5, 0xa4, 0xc9, 0x36,
-	0x9c, 0xa2, 0x9f, 0xa5, 0xd2, 0x4a, 0xfb, 0x54, 0x69, 0xfa, 0x26, 0xc7, 0xc1, 0x5e, 0x37, 0x39,
-	0x22, 0x5f, 0xdd, 0xaf, 0x3b, 0x94, 0xfb, 0xfd, 0xba, 0x90, 0x71, 0xb7, 0xee, 0x0d, 0xa8, 0xd4,
-	0x42, 0xe2, 0xc4, 0x77, 0x79, 0xd5, 0x2a, 0x8b, 0x82, 0x99, 0x93, 0x1d, 0x60, 0xdd, 0x97, 0xfd,
-	0xbf, 0x07, 0xe0, 0x98, 0x1c, 0x11, 0x99, 0xab, 0x42, 0xf5, 0x23, 0xa7, 0xab, 0x6d, 0x65, 0xa5,
-	0x1f, 0x2f, 0x49, 0x00, 0xd6, 0x38, 0xd4, 0x1e, 0x6b, 0x47, 0x64, 0xb9, 0x45, 0xfc, 0x45, 0x77,
-	0x2d, 0x12, 0xc7, 0xd6, 0x6a, 0xa1, 0x5c, 0xd3, 0x20, 0x6c, 0xe2, 0x51, 0xdb, 0xde, 0x31, 0x8c,
-	0x56, 0xc3, 0xb6, 0x97, 0x86, 0xaa, 0x84, 0xa3, 0x5f, 0xca, 0x2c, 0x3a, 0x9f, 0x4f, 0x86, 0x67,
-	0x57, 0x8a, 0xce, 0x3e, 0x2f, 0x0a, 0xff, 0x7b, 0x16, 0x9c, 0xe2, 0xad, 0x72, 0x24, 0xaf, 0xb5,
-	0xea, 0x4e, 0x4c, 0xa2, 0x7c, 0x2e, 0xab, 0xc9, 0xe0, 0x4f, 0xfb, 0xbc, 0xb3, 0xc8, 0xe2, 0x6c,
-	0x6e, 0xd0, 0x67, 0x2c, 0x18, 0xdf, 0x4c, 0x14, 0x07, 0x92, 0xaa, 0xe3, 0xa0, 0x75, 0x3b, 0x12,
-	0x9d, 0xea, 0xa5, 0x96, 0x6c, 0x8f, 0x70, 0x9a, 0xba, 0xfd, 0xdf, 0x2d, 0x30, 0xc5, 0xe8, 0xd1,
-	0xd7, 0x14, 0xda, 0xbf, 0x29, 0x28, 0xad, 0xcb, 0x52, 0x4f, 0xeb, 0xf2, 0x11, 0x28, 0xb6, 0xdd,
-	0xba, 0xd8, 0x5f, 0xe8, 0xc3, 0xf4, 0x85, 0x79, 0x4c, 0xdb, 0xed, 0x7f, 0x5e, 0xd2, 0x6e, 0x10,
-	0x91, 0x40, 0xf9, 0x3d, 0xf1, 0xda, 0xeb, 0xaa, 0xea, 0x26, 0x7f, 0xf3, 0xab, 0x5d, 0x55, 0x37,
-	0x7f, 0x6c, 0xff, 0xf9, 0xb1, 0x7c, 0x80, 0x7a, 0x15, 0xdd, 0x1c, 0xda, 0x23, 0x39, 0xf6, 0x26,
-	0x94, 0xe9, 0x16, 0x8c, 0xf9, 0x33, 0xcb, 0x09, 0xa6, 0xca, 0x97, 0x44, 0xfb, 0x9d, 0x9d, 0xc9,
-	0x1f, 0xdd, 0x3f, 0x5b, 0xf2, 0x69, 0xac, 0xfa, 0x47, 0x11, 0x54, 0xe8, 0x6f, 0x96, 0xc7, 0x2b,
-	0x36, 0x77, 0xd7, 0x94, 0xcc, 0x94, 0x80, 0x5c, 0x92, 0x84, 0x35, 0x1d, 0xe4, 0x43, 0x85, 0x22,
-	0x72, 0xa2, 0x7c, 0x0f, 0xb8, 0xa2, 0xb2, 0x69, 0x25, 0xe0, 0xce, 0xce, 0xe4, 0x0b, 0xfb, 0x27,
-	0xaa, 0x1e, 0xc7, 0x9a, 0x84, 0xfd, 0x7f, 0x06, 0xf4, 0xdc, 0x15, 0xc5, 0x56, 0xbf, 0x27, 0xe6,
-	0xee, 0xb9, 0xd4, 0xdc, 0x3d, 0xdb, 0x35, 0x77, 0xc7, 0xf4, 0x85, 0xfb, 0x89, 0xd9, 0x78, 0xd4,
-	0x86, 0xc0, 0xde, 0xfe, 0x06, 0x66, 0x01, 0xbd, 0xda, 0x76, 0x43, 0x12, 0xad, 0x84, 0x6d, 0xdf,
-	0xf5, 0x1b, 0x6c, 0x3a, 0x96, 0x4d, 0x0b, 0x28, 0x01, 0xc6, 0x69, 0x7c, 0xba, 0xa9, 0xa7, 0xdf,
-	0xfc, 0x86, 0xb3, 0xc5, 0x67, 0x95, 0x51, 0x9f, 0xaf, 0x2a, 0xda, 0xb1, 0xc2, 0x40, 0x1b, 0xf0,
-	0xb0, 0xec, 0x60, 0x9e, 0x78, 0x44, 0xdc, 0x98, 0xbf, 0xee, 0x86, 0x4d, 0x1e, 0x4a, 0xcf, 0x63,
-	0x38, 0xde, 0x2c, 0x7a, 0x78, 0x18, 0xef, 0x82, 0x8b, 0x77, 0xed, 0xc9, 0xfe, 0x0a, 0x0b, 0x22,
-	0x30, 0x4a, 0x15, 0xd0, 0xd9, 0xe7, 0xb9, 0x4d, 0x57, 0x96, 0x11, 0x54, 0xb3, 0x6f, 0x91, 0x36,
-	0x62, 0x0e, 0x43, 0xb7, 0x60, 0x68, 0x8d, 0x5f, 0x6c, 0x9c, 0xcf, 0x25, 0x2a, 0xe2, 0x96, 0x64,
-	0x56, 0x8b, 0x57, 0x5e, 0x99, 0x7c, 0x47, 0xff, 0xc4, 0x92, 0x9a, 0xfd, 0xf5, 0x12, 0x8c, 0xcb,
-	0xb0, 0xac, 0x4b, 0x6e, 0xc4, 0x62, 0x03, 0xcc, 0x02, 0xe5, 0x85, 0x3d, 0x0b, 0x94, 0xbf, 0x17,
-	0xa0, 0x4e, 0x5a, 0x5e, 0xd0, 0x61, 0x86, 0xdf, 0xc0, 0xbe, 0x0d, 0x3f, 0xb5, 0x57, 0x98, 0x57,
-	0xbd, 0x60, 0xa3, 0x47, 0x51, 0x3b, 0x91, 0xd7, 0x3b, 0x4f, 0xd5, 0x4e, 0x34, 0xae, 0x5a, 0x1a,
-	0x3c, 0xda, 0xab, 0x96, 0x5c, 0x18, 0xe7, 0x2c, 0xaa, 0x82, 0x00, 0x77, 0x91, 0xf7, 0xcf, 0x52,
-	0xaa, 0xe6, 0x93, 0xdd, 0xe0, 0x74, 0xbf, 0xe6, 0x3d, 0x4a, 0xe5, 0xa3, 0xbe, 0x47, 0xe9, 0xad,
-	0x50, 0x91, 0xdf, 0x39, 0x9a, 0xa8, 0xe8, 0xa2, 0x2a, 0x72, 0x1a, 0x44, 0x58, 0xc3, 0xbb, 0x6a,
-	0x9b, 0xc0, 0xbd, 0xaa, 0x6d, 0x62, 0x7f, 0xba, 0x40, 0x77, 0x0c, 0x9c, 0x2f, 0x55, 0xa6, 0xeb,
-	0x71, 0x18, 0x74, 0xda, 0xf1, 0x46, 0xd0, 0x75, 0x35, 0xf2, 0x0c, 0x6b, 0xc5, 0x02, 0x8a, 0x16,
-	0x61, 0xa0, 0xae, 0x4b, 0x2f, 0xed, 0xe7, 0x7b, 0x6a, 0xe7, 0xab, 0x13, 0x13, 0xcc, 0x7a, 0x41,
-	0x0f, 0xc3, 0x40, 0xec, 0x34, 0x64, 0x16, 0x28, 0xcb, 0xfc, 0x5f, 0x75, 0x1a, 0x11, 0x66, 0xad,
-	0xfb, 0x29, 0x37, 0xfb, 0x02, 0x8c, 0x46, 0x6e, 0xc3, 0x77, 0xe2, 0x76, 0x48, 0x8c, 0xf3, 0x49,
-	0x1d, 0x32, 0x63, 0x02, 0x71, 0x12, 0xd7, 0xfe, 0x9d, 0x11, 0x38, 0x59, 0x9d, 0x5b, 0x92, 0x17,
-	0x66, 0x1c, 0x5a, 0x22, 0x67, 0x16, 0x8d, 0xa3, 0x4b, 0xe4, 0xec, 0x41, 0xdd, 0x33, 0x12, 0x39,
-	0x3d, 0x23, 0x91, 0x33, 0x99, 0x55, 0x57, 0xcc, 0x23, 0xab, 0x2e, 0x8b, 0x83, 0x7e, 0xb2, 0xea,
-	0x0e, 0x2d, 0xb3, 0x73, 0x57, 0x86, 0xf6, 0x95, 0xd9, 0xa9, 0xd2, 0x5e, 0x73, 0xc9, 0x15, 0xea,
-	0xf1, 0xa9, 0x32, 0xd3, 0x5e, 0x55, 0xca, 0x21, 0xcf, 0x83, 0x13, 0xa2, 0xfe, 0xe5, 0xfc, 0x19,
-	0xe8, 0x23, 0xe5, 0x50, 0xa4, 0xe2, 0x99, 0x69, 0xae, 0x43, 0x79, 0xa4, 0xb9, 0x66, 0xb1, 0xb3,
-	0x67, 0x9a, 0xeb, 0x0b, 0x30, 0x5a, 0xf3, 0x02, 0x9f, 0xac, 0x84, 0x41, 0x1c, 0xd4, 0x02, 0x79,
-	0x39, 0xab, 0xbe, 0x5b, 0xcc, 0x04, 0xe2, 0x24, 0x6e, 0xaf, 0x1c, 0xd9, 0xca, 0x41, 0x73, 0x64,
-	0xe1, 0x1e, 0xe5, 0xc8, 0x1a, 0x59, 0xa0, 0xc3, 0x79, 0x64, 0x81, 0x66, 0x7d, 0x91, 0xbe, 0x6e,
-	0x5f, 0x7d, 0x9d, 0xdf, 0x4d, 0x4c, 0x4d, 0xf0, 0xb9, 0xa0, 0x49, 0x0d, 0xbf, 0x11, 0x36, 0x24,
-	0xaf, 0x1c, 0xc2, 0x84, 0xbd, 0x51, 0xd5, 0x64, 0xd4, 0x7d, 0xc5, 0xba, 0x09, 0x27, 0x19, 0x39,
-	0x48, 0x82, 0xea, 0x17, 0x0a, 0xf0, 0x03, 0x7b, 0xb2, 0x80, 0x6e, 0x01, 0xc4, 0x4e, 0x43, 0x4c,
-	0x54, 0x71, 0x34, 0x73, 0xc0, 0xb8, 0xd6, 0x55, 0xd9, 0x1f, 0x2f, 0x93, 0xa4, 0xfe, 0xb2, 0x43,
-	0x0f, 0xf9, 0x9b, 0x85, 0xb3, 0x06, 0x5e, 0x57, 0x35, 0x59, 0x1c, 0x78, 0x04, 0x33, 0x08, 0x55,
-	0xff, 0x21, 0x69, 0x50, 0x93, 0xb6, 0x98, 0x54, 0xff, 0x98, 0xb5, 0x62, 0x01, 0x45, 0xcf, 0xc3,
-	0xb0, 0xe3, 0x79, 0x3c, 0x91, 0x8b, 0x44, 0xe2, 0xd2, 0x3f, 0x5d, 0xd6, 0x52, 0x83, 0xb0, 0x89,
-	0x67, 0xff, 0x55, 0x01, 0x26, 0xf7, 0x90, 0x29, 0x5d, 0x09, 0xbc, 0xa5, 0xbe, 0x13, 0x78, 0x45,
-	0x72, 0xcb, 0x60, 0x8f, 0xe4, 0x96, 0xe7, 0x61, 0x38, 0x26, 0x4e, 0x53, 0x44, 0xc2, 0x09, 0x9f,
-	0x83, 0x3e, 0x6b, 0xd6, 0x20, 0x6c, 0xe2, 0x51, 0x29, 0x36, 0xe6, 0xd4, 0x6a, 0x24, 0x8a, 0x64,
-	0xf6, 0x8a, 0xf0, 0xdb, 0xe6, 0x96, 0x1a, 0xc3, 0xdc, 0xe1, 0x33, 0x09, 0x12, 0x38, 0x45, 0x32,
-	0x3d, 0xe0, 0x95, 0x3e, 0x07, 0xfc, 0x57, 0x0b, 0xf0, 0xc8, 0xae, 0xda, 0xad, 0xef, 0xc4, 0xa2,
-	0x76, 0x44, 0xc2, 0xf4, 0xc4, 0xb9, 0x16, 0x91, 0x10, 0x33, 0x08, 0x1f, 0xa5, 0x56, 0x4b, 0x45,
-	0x31, 0xe7, 0x9f, 0x65, 0xc7, 0x47, 0x29, 0x41, 0x02, 0xa7, 0x48, 0xde, 0xed, 0xb4, 0xfc, 0xfa,
-	0x00, 0x3c, 0xd6, 0x87, 0x0d, 0x90, 0x63, 0x36, 0x62, 0x32, 0x73, 0xb6, 0x78, 0x8f, 0x32, 0x67,
-	0xef, 0x6e, 0xb8, 0xde, 0x48, 0xb8, 0xed, 0x2b, 0xeb, 0xf1, 0x2b, 0x05, 0x38, 0xd3, 0xdb, 0x60,
-	0x41, 0x3f, 0x0e, 0xe3, 0xa1, 0x0a, 0xb2, 0x33, 0x93, 0x6e, 0x4f, 0x70, 0xcf, 0x4e, 0x02, 0x84,
-	0xd3, 0xb8, 0x68, 0x0a, 0xa0, 0xe5, 0xc4, 0x1b, 0xd1, 0xf9, 0x6d, 0x37, 0x8a, 0x45, 0xf9, 0xb0,
-	0x31, 0x7e, 0x96, 0x28, 0x5b, 0xb1, 0x81, 0x41, 0xc9, 0xb1, 0x7f, 0xf3, 0xc1, 0xd5, 0x20, 0xe6,
-	0x0f, 0xf1, 0xcd, 0xd6, 0x09, 0x79, 0x99, 0x98, 0x01, 0xc2, 0x69, 0x5c, 0x4a, 0x8e, 0x9d, 0x56,
-	0x73, 0x46, 0xf9, 0x2e, 0x8c, 0x91, 0x5b, 0x54, 0xad, 0xd8, 0xc0, 0x48, 0xa7, 0x13, 0x97, 0xf6,
-	0x4e, 0x27, 0xb6, 0xff, 0x59, 0x01, 0x4e, 0xf7, 0x34, 0x78, 0xfb, 0x13, 0x53, 0xf7, 0x5f, 0x0a,
-	0xf0, 0x5d, 0xae, 0xb0, 0xfd, 0xa5, 0x8e, 0xfe, 0x79, 0x8f, 0x99, 0x26, 0x52, 0x47, 0xef, 0xbe,
-	0x22, 0xc6, 0xfd, 0x37, 0x9e, 0x5d, 0xd9, 0xa2, 0x03, 0xfb, 0xc8, 0x16, 0x4d, 0x7d, 0x8c, 0x52,
-	0x9f, 0xda, 0xe1, 0x3f, 0x0f, 0xf4, 0x1c, 0x5e, 0xba, 0x41, 0xee, 0xcb, 0x6f, 0x3e, 0x0f, 0xc7,
-	0x5c, 0x9f, 0x5d, 0x2c, 0x59, 0x6d, 0xaf, 0x89, 0x8a, 0x52, 0xbc, 0x6c, 0xaa, 0xca, 0xfe, 0x58,
-	0x48, 0xc1, 0x71, 0xd7, 0x13, 0xf7, 0x61, 0xf6, 0xee, 0xdd, 0x0d, 0xe9, 0x3e, 0x25, 0xf7, 0x32,
-	0x9c, 0x92, 0x43, 0xb1, 0xe1, 0x84, 0xa4, 0x2e, 0x94, 0x6d, 0x24, 0xf2, 0x7d, 0x4e, 0xf3, 0x9c,
-	0xa1, 0x0c, 0x04, 0x9c, 0xfd, 0x1c, 0xbb, 0xcb, 0x2f, 0x68, 0xb9, 0x35, 0xb1, 0x15, 0xd4, 0x77,
-	0xf9, 0xd1, 0x46, 0xcc, 0x61, 0x5a, 0x5f, 0x54, 0x8e, 0x46, 0x5f, 0xbc, 0x17, 0x2a, 0x6a, 0xbc,
-	0x79, 0x96, 0x80, 0x9a, 0xe4, 0x5d, 0x59, 0x02, 0x6a, 0x86, 0x1b, 0x58, 0x7b, 0xdd, 0x83, 0xfd,
-	0x2c, 0x8c, 0x28, 0xef, 0x57, 0xbf, 0x37, 0x2a, 0xda, 0xff, 0xb7, 0x00, 0xa9, 0x3b, 0x8f, 0xd0,
-	0x36, 0x54, 0xea, 0xf2, 0x26, 0xea, 0x7c, 0xca, 0xf6, 0xaa, 0x8b, 0xad, 0xf5, 0xf1, 0x8f, 0x6a,
-	0xc2, 0x9a, 0x18, 0x7a, 0x3f, 0xaf, 0x90, 0x2b, 0x48, 0x17, 0xf2, 0xc8, 0xe0, 0xae, 0xaa, 0xfe,
-	0xcc, 0x2b, 0xd3, 0x64, 0x1b, 0x36, 0xe8, 0xa1, 0x18, 0x2a, 0x1b, 0xf2, 0x6e, 0xa7, 0x7c, 0xc4,
-	0x9d, 0xba, 0x2a, 0x8a, 0x9b, 0x68, 0xea, 0x2f, 0xd6, 0x84, 0xec, 0x3f, 0x2b, 0xc0, 0xc9, 0xe4,
-	0x07, 0x10, 0xc7, 0x75, 0xbf, 0x66, 0xc1, 0x83, 0x9e, 0x13, 0xc5, 0xd5, 0x36, 0xdb, 0x28, 0xac,
-	0xb7, 0xbd, 0xe5, 0x54, 0x31, 0xe5, 0x83, 0x3a, 0x5b, 0x54, 0xc7, 0xe9, 0xbb, 0xc0, 0x66, 0x1f,
-	0xba, 0xbd, 0x33, 0xf9, 0xe0, 0x62, 0x36, 0x71, 0xdc, 0x8b, 0x2b, 0xf4, 0x59, 0x0b, 0x8e, 0xd5,
-	0xda, 0x61, 0x48, 0xfc, 0x58, 0xb3, 0xca, 0xbf, 0xe2, 0xd5, 0x5c, 0x06, 0x52, 0x33, 0x78, 0x92,
-	0x0a, 0xd4, 0xb9, 0x14, 0x2d, 0xdc, 0x45, 0xdd, 0xfe, 0x79, 0xaa, 0x39, 0x7b, 0xbe, 0xe7, 0xf7,
-	0xd9, 0xe5, 0x65, 0xdf, 0x19, 0x84, 0xd1, 0x44, 0xc5, 0xe8, 0xc4, 0x11, 0x97, 0xb5, 0xe7, 0x11,
-	0x17, 0xcb, 0x50, 0x6b, 0xfb, 0xf2, 0x6a, 0x65, 0x23, 0x43, 0xad, 0xed, 0x13, 0xcc, 0x61, 0x62,
-	0x48, 0x71, 0xdb, 0x17, 0xd1, 0xed, 0xe6, 0x90, 0xe2, 0xb6, 0x8f, 0x05, 0x14, 0x7d, 0xc8, 0x82,
-	0x11, 0xb6, 0xf8, 0xc4, 0x01, 0xa1, 0x50, 0x68, 0x97, 0x73, 0x58, 0xee, 0xb2, 0x3a, 0x3a, 0x8b,
-	0x86, 0x34, 0x5b, 0x70, 0x82, 0x22, 0xfa, 0xa8, 0x05, 0x15, 0x75, 0x1b, 0xa3, 0xb8, 0x27, 0xbd,
-	0x9a, 0x6f, 0x41, 0xee, 0x94, 0xd4, 0x53, 0x95, 0x91, 0xb1, 0x26, 0x8c, 0x22, 0x75, 0x7a, 0x37,
-	0x74, 0x38, 0xa7, 0x77, 0x90, 0x71, 0x72, 0xf7, 0x56, 0xa8, 0x34, 0x1d, 0xdf, 0x5d, 0x27, 0x51,
-	0xcc, 0x0f, 0xd4, 0xe4, 0x3d, 0x01, 0xb2, 0x11, 0x6b, 0x38, 0x35, 0xf6, 0x23, 0xf6, 0x62, 0xb1,
-	0x71, 0x02, 0xc6, 0x8c, 0xfd, 0xaa, 0x6e, 0xc6, 0x26, 0x8e, 0x79, 0x5c, 0x07, 0xf7, 0xf4, 0xb8,
-	0x6e, 0x78, 0x8f, 0xe3, 0xba, 0x2a, 0x9c, 0x72, 0xda, 0x71, 0x70, 0x89, 0x38, 0xde, 0x4c, 0x1c,
-	0x93, 0x66, 0x2b, 0x8e, 0x78, 0x91, 0xf1, 0x11, 0xe6, 0x02, 0x56, 0xf1, 0x5b, 0x55, 0xe2, 0xad,
-	0x77, 0x21, 0xe1, 0xec, 0x67, 0xed, 0x7f, 0x6c, 0xc1, 0xa9, 0xcc, 0xa9, 0x70, 0xff, 0x46, 0xce,
-	0xdb, 0x9f, 0x2f, 0xc1, 0x89, 0x8c, 0x7a, 0xf2, 0xa8, 0x63, 0x2e, 0x12, 0x2b, 0x8f, 0x20, 0xb4,
-	0x64, 0x4c, 0x95, 0xfc, 0x36, 0x19, 0x2b, 0x63, 0x7f, 0x27, 0xf0, 0xfa, 0x14, 0xbc, 0x78, 0xb4,
-	0xa7, 0xe0, 0xc6, 0x5c, 0x1f, 0xb8, 0xa7, 0x73, 0xbd, 0xb4, 0xc7, 0x5c, 0xff, 0xaa, 0x05, 0x13,
-	0xcd, 0x1e, 0x97, 0x18, 0x89, 0xf3, 0xa4, 0xeb, 0x87, 0x73, 0x45, 0xd2, 0xec, 0xc3, 0xb7, 0x77,
-	0x26, 0x7b, 0xde, 0x1d, 0x85, 0x7b, 0x72, 0x65, 0x7f, 0xab, 0x08, 0xcc, 0x5e, 0x63, 0x35, 0x83,
-	0x3b, 0xe8, 0x83, 0xe6, 0xb5, 0x14, 0x56, 0x5e, 0x57, 0x28, 0xf0, 0xce, 0xd5, 0xb5, 0x16, 0x7c,
-	0x04, 0xb3, 0x6e, 0xb9, 0x48, 0x4b, 0xc2, 0x42, 0x1f, 0x92, 0xd0, 0x93, 0xf7, 0x7f, 0x14, 0xf3,
-	0xbf, 0xff, 0xa3, 0x92, 0xbe, 0xfb, 0x63, 0xf7, 0x4f, 0x3c, 0x70, 0x5f, 0x7e, 0xe2, 0xdf, 0xb5,
-	0xb8, 0xe0, 0x49, 0x7d, 0x05, 0x6d, 0x6e, 0x58, 0xbb, 0x98, 0x1b, 0x4f, 0x42, 0x39, 0x12, 0x92,
-	0x59, 0x98, 0x25, 0x3a, 0x00, 0x4a, 0xb4, 0x63, 0x85, 0x41, 0x77, 0x5d, 0x8e, 0xe7, 0x05, 0xb7,
-	0xce, 0x37, 0x5b, 0x71, 0x47, 0x18, 0x28, 0x6a, 0x5b, 0x30, 0xa3, 0x20, 0xd8, 0xc0, 0x42, 0x8f,
-	0xc1, 0x20, 0xaf, 0x74, 0x20, 0x9c, 0x3b, 0xc3, 0x74, 0x1d, 0xf2, 0x32, 0x08, 0x75, 0x2c, 0x40,
-	0xf6, 0x06, 0x18, 0xbb, 0x8a, 0xbb, 0xbf, 0x18, 0xb6, 0x8f, 0x1b, 0xbd, 0xff, 0x6e, 0x41, 0x90,
-	0xe2, 0xbb, 0x84, 0x73, 0xa9, 0x1b, 0xd4, 0xfb, 0x8f, 0x87, 0x7b, 0x3f, 0x40, 0x2d, 0x68, 0xb6,
-	0xe8, 0xbe, 0x79, 0x35, 0xc8, 0x67, 0xb3, 0x35, 0xa7, 0xfa, 0xd3, 0xa3, 0xaa, 0xdb, 0xb0, 0x41,
-	0x2f, 0x21, 0xda, 0x8b, 0x7b, 0x8a, 0xf6, 0x84, 0x94, 0x1b, 0xd8, 0x5d, 0xca, 0xd9, 0x7f, 0x65,
-	0x41, 0xc2, 0xea, 0x43, 0x2d, 0x28, 0x51, 0x76, 0x3b, 0x42, 0x60, 0x2c, 0xe7, 0x67, 0x62, 0x52,
-	0x49, 0x2d, 0x56, 0x21, 0xfb, 0x89, 0x39, 0x21, 0xe4, 0x89, 0xd8, 0xbf, 0x5c, 0x36, 0x3f, 0x26,
-	0xc1, 0x4b, 0x41, 0xb0, 0xc9, 0xc3, 0x67, 0x74, 0x1c, 0xa1, 0x7d, 0x0e, 0x8e, 0x77, 0x31, 0xc5,
-	0x2e, 0x93, 0x0d, 0xe4, 0x0e, 0xde, 0x58, 0x3d, 0xac, 0x3e, 0x03, 0xe6, 0x30, 0xfb, 0x2b, 0x16,
-	0x1c, 0x4b, 0x77, 0x8f, 0x5e, 0xb7, 0xe0, 0x78, 0x94, 0xee, 0xef, 0xb0, 0xc6, 0x4e, 0xc5, 0xef,
-	0x77, 0x81, 0x70, 0x37, 0x13, 0xf6, 0x3f, 0x15, 0xda, 0xe0, 0x86, 0xeb, 0xd7, 0x83, 0x5b, 0xca,
-	0x4e, 0xb2, 0x7a, 0xda, 0x49, 0x54, 0x3c, 0xd4, 0x36, 0x48, 0xbd, 0xed, 0x75, 0x15, 0x56, 0xa8,
-	0x8a, 0x76, 0xac, 0x30, 0x58, 0x1e, 0x79, 0x5b, 0xec, 0x5b, 0x53, 0x93, 0x72, 0x5e, 0xb4, 0x63,
-	0x85, 0x81, 0x9e, 0x83, 0x11, 0xe3, 0x25, 0xe5, 0xbc, 0x64, 0x9b, 0x0e, 0x43, 0x83, 0x47, 0x38,
-	0x81, 0x85, 0xa6, 0x00, 0x94, 0xcd, 0x25, 0x35, 0x36, 0x73, 0xb4, 0x2b, 0xc1, 0x18, 0x61, 0x03,
-	0x83, 0x55, 0x6d, 0xf0, 0xda, 0x11, 0x3b, 0x49, 0x1e, 0xd4, 0x35, 0xf4, 0xe7, 0x44, 0x1b, 0x56,
-	0x50, 0x2a, 0xdc, 0x9a, 0x8e, 0xdf, 0x76, 0x3c, 0x3a, 0x42, 0xc2, 0x75, 0xa6, 0x96, 0xe1, 0x92,
-	0x82, 0x60, 0x03, 0x8b, 0xbe, 0x71, 0xec, 0x36, 0xc9, 0x4b, 0x81, 0x2f, 0xe3, 0xae, 0x75, 0x70,
-	0x81, 0x68, 0xc7, 0x0a, 0x03, 0x9d, 0x83, 0x61, 0xc7, 0xaf, 0x73, 0x03, 0x31, 0x08, 0xc5, 0x19,
-	0xa5, 0xda, 0x7d, 0x5e, 0x8b, 0xc8, 0x8c, 0x86, 0x62, 0x13, 0xd5, 0xfe, 0x4b, 0x0b, 0xc6, 0x75,
-	0xf5, 0x1b, 0xe6, 0x2a, 0x4b, 0xf8, 0x08, 0xad, 0x3d, 0x7d, 0x84, 0xc9, 0xb2, 0x1a, 0x85, 0xbe,
-	0xca, 0x6a, 0x98, 0x15, 0x2f, 0x8a, 0xbb, 0x56, 0xbc, 0xf8, 0x41, 0x18, 0xda, 0x24, 0x1d, 0xa3,
-	0x34, 0x06, 0x93, 0xf2, 0x57, 0x78, 0x13, 0x96, 0x30, 0x64, 0xc3, 0x60, 0xcd, 0x51, 0xa5, 0xeb,
-	0x46, 0xf8, 0xce, 0x6a, 0x6e, 0x86, 0x21, 0x09, 0x88, 0xbd, 0x0c, 0x15, 0x75, 0x3a, 0x2f, 0x5d,
-	0x76, 0x56, 0xb6, 0xcb, 0xae, 0xaf, 0xcc, 0xfb, 0xd9, 0xb5, 0xaf, 0x7d, 0xfb, 0xd1, 0x37, 0xfd,
-	0xf1, 0xb7, 0x1f, 0x7d, 0xd3, 0x9f, 0x7e, 0xfb, 0xd1, 0x37, 0x7d, 0xe8, 0xf6, 0xa3, 0xd6, 0xd7,
-	0x6e, 0x3f, 0x6a, 0xfd, 0xf1, 0xed, 0x47, 0xad, 0x3f, 0xbd, 0xfd, 0xa8, 0xf5, 0xad, 0xdb, 0x8f,
-	0x5a, 0x9f, 0xfd, 0x4f, 0x8f, 0xbe, 0xe9, 0xa5, 0xcc, 0x90, 0x7d, 0xfa, 0xe3, 0xa9, 0x5a, 0x7d,
-	0x7a, 0xeb, 0x59, 0x16, 0x35, 0x4e, 0x17, 0xe6, 0xb4, 0x31, 0x1b, 0xa7, 0xe5, 0xc2, 0xfc, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xb0, 0xa6, 0x0c, 0xbd, 0xf8, 0x00, 0x00,
+	// 12256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x25, 0xd9,
+	0x59, 0x98, 0xfb, 0x3e, 0xa4, 0x7b, 0x8f, 0x34, 0x9a, 0x99, 0x9e, 0x99, 0xdd, 0x3b, 0xb3, 0x0f,
+	0x0d, 0xbd, 0x66, 0x6d, 0x02, 0xab, 0xc1, 0xbb, 0xc6, 0x6c, 0x78, 0x18, 0xf4, 0x98, 0x87, 0x76,
+	0xa4, 0x19, 0xed, 0x27, 0xcd, 0x0c, 0x5e, 0xb3, 0x5e, 0xb7, 0xee, 0x3d, 0x92, 0x7a, 0xd5, 0xb7,
+	0xfb, 0x6e, 0x77, 0x5f, 0xcd, 0x68, 0x31, 0xc6, 0xe6, 0x11, 0x1e, 0x06, 0x43, 0x80, 0x0a, 0x26,
+	0x09, 0x84, 0x57, 0x52, 0xa9, 0x4a, 0x51, 0x38, 0xa1, 0x2a, 0x21, 0x05, 0x14, 0x05, 0x49, 0x28,
+	0x27, 0x24, 0x05, 0x71, 0xb9, 0x08, 0x09, 0x64, 0x62, 0x4f, 0x92, 0x32, 0x95, 0xaa, 0x50, 0x15,
+	0x92, 0x1f, 0xa9, 0x4d, 0x2a, 0x95, 0xfa, 0xce, 0xfb, 0xf4, 0xed, 0x2b, 0x5d, 0x8d, 0x5a, 0x33,
+	0x63, 0x7b, 0x7f, 0x49, 0xf7, 0x7c, 0x5f, 0x9f, 0xef, 0xf4, 0xe9, 0x73, 0xbe, 0xf3, 0x9d, 0xef,
+	0x49, 0x96, 0x36, 0x83, 0x6c, 0xab, 0xbf, 0x3e, 0xd3, 0x8e, 0xbb, 0x17, 0xfc, 0x64, 0x33, 0xee,
+	0x25, 0xf1, 0xeb, 0xec, 0x9f, 0xe7, 0xda, 0x9d, 0x0b, 0x3b, 0x2f, 0x5c, 0xe8, 0x6d, 0x6f, 0x5e,
+	0xf0, 0x7b, 0x41, 0x7a, 0xc1, 0xef, 0xf5, 0xc2, 0xa0, 0xed, 0x67, 0x41, 0x1c, 0x5d, 0xd8, 0x79,
+	0x8f, 0x1f, 0xf6, 0xb6, 0xfc, 0xf7, 0x5c, 0xd8, 0xa4, 0x11, 0x4d, 0xfc, 0x8c, 0x76, 0x66, 0x7a,
+	0x49, 0x9c, 0xc5, 0xee, 0xb7, 0xe8, 0xde, 0x66, 0x64, 0x6f, 0xec, 0x9f, 0xd7, 0xda, 0x9d, 0x99,
+	0x9d, 0x17, 0x66, 0x7a, 0xdb, 0x9b, 0x33, 0xd8, 0xdb, 0x8c, 0xd1, 0xdb, 0x8c, 0xec, 0xed, 0xdc,
+	0x73, 0xc6, 0x58, 0x36, 0xe3, 0xcd, 0xf8, 0x02, 0xeb, 0x74, 0xbd, 0xbf, 0xc1, 0x7e, 0xb1, 0x1f,
+	0xec, 0x3f, 0x4e, 0xec, 0x9c, 0xb7, 0xfd, 0x62, 0x3a, 0x13, 0xc4, 0x38, 0xbc, 0x0b, 0xed, 0x38,
+	0xa1, 0x17, 0x76, 0x06, 0x06, 0x74, 0xee, 0x8a, 0xc6, 0xa1, 0x77, 0x32, 0x1a, 0xa5, 0x41, 0x1c,
+	0xa5, 0xcf, 0xe1, 0x10, 0x68, 0xb2, 0x43, 0x13, 0xf3, 0xf5, 0x0c, 0x84, 0xa2, 0x9e, 0xde, 0xab,
+	0x7b, 0xea, 0xfa, 0xed, 0xad, 0x20, 0xa2, 0xc9, 0xae, 0x7e, 0xbc, 0x4b, 0x33, 0xbf, 0xe8, 0xa9,
+	0x0b, 0xc3, 0x9e, 0x4a, 0xfa, 0x51, 0x16, 0x74, 0xe9, 0xc0, 0x03, 0xef, 0xdb, 0xef, 0x81, 0xb4,
+	0xbd, 0x45, 0xbb, 0xfe, 0xc0, 0x73, 0x2f, 0x0c, 0x7b, 0xae, 0x9f, 0x05, 0xe1, 0x85, 0x20, 0xca,
+	0xd2, 0x2c, 0xc9, 0x3f, 0xe4, 0xfd, 0x6d, 0x87, 0x1c, 0x9b, 0xbd, 0xb5, 0x3a, 0xdb, 0xcf, 0xb6,
+	0xe6, 0xe3, 0x68, 0x23, 0xd8, 0x74, 0xbf, 0x81, 0x4c, 0xb4, 0xc3, 0x7e, 0x9a, 0xd1, 0xe4, 0x9a,
+	0xdf, 0xa5, 0x2d, 0xe7, 0xbc, 0xf3, 0xee, 0xe6, 0xdc, 0xa9, 0xcf, 0xdc, 0x9d, 0x7e, 0xc7, 0xbd,
+	0xbb, 0xd3, 0x13, 0xf3, 0x1a, 0x04, 0x26, 0x9e, 0xfb, 0x35, 0x64, 0x3c, 0x89, 0x43, 0x3a, 0x0b,
+	0xd7, 0x5a, 0x15, 0xf6, 0xc8, 0x71, 0xf1, 0xc8, 0x38, 0xf0, 0x66, 0x90, 0x70, 0x44, 0xed, 0x25,
+	0xf1, 0x46, 0x10, 0xd2, 0x56, 0xd5, 0x46, 0x5d, 0xe1, 0xcd, 0x20, 0xe1, 0xde, 0x1f, 0x57, 0x08,
+	0x99, 0xed, 0xf5, 0x56, 0x92, 0xf8, 0x75, 0xda, 0xce, 0xdc, 0x0f, 0x93, 0x06, 0x4e, 0x73, 0xc7,
+	0xcf, 0x7c, 0x36, 0xb0, 0x89, 0xe7, 0xbf, 0x7e, 0x86, 0xbf, 0xf5, 0x8c, 0xf9, 0xd6, 0x7a, 0x91,
+	0x21, 0xf6, 0xcc, 0xce, 0x7b, 0x66, 0xae, 0xaf, 0xe3, 0xf3, 0xcb, 0x34, 0xf3, 0xe7, 0x5c, 0x41,
+	0x8c, 0xe8, 0x36, 0x50, 0xbd, 0xba, 0x11, 0xa9, 0xa5, 0x3d, 0xda, 0x66, 0xef, 0x30, 0xf1, 0xfc,
+	0xd2, 0xcc, 0x61, 0x56, 0xf3, 0x8c, 0x1e, 0xf9, 0x6a, 0x8f, 0xb6, 0xe7, 0x26, 0x05, 0xe5, 0x1a,
+	0xfe, 0x02, 0x46, 0xc7, 0xdd, 0x21, 0x63, 0x69, 0xe6, 0x67, 0xfd, 0x94, 0x4d, 0xc5, 0xc4, 0xf3,
+	0xd7, 0x4a, 0xa3, 0xc8, 0x7a, 0x9d, 0x9b, 0x12, 0x34, 0xc7, 0xf8, 0x6f, 0x10, 0xd4, 0xbc, 0xff,
+	0xe8, 0x90, 0x29, 0x8d, 0xbc, 0x14, 0xa4, 0x99, 0xfb, 0x9d, 0x03, 0x93, 0x3b, 0x33, 0xda, 0xe4,
+	0xe2, 0xd3, 0x6c, 0x6a, 0x4f, 0x08, 0x62, 0x0d, 0xd9, 0x62, 0x4c, 0x6c, 0x97, 0xd4, 0x83, 0x8c,
+	0x76, 0xd3, 0x56, 0xe5, 0x7c, 0xf5, 0xdd, 0x13, 0xcf, 0x5f, 0x29, 0xeb, 0x3d, 0xe7, 0x8e, 0x09,
+	0xa2, 0xf5, 0x45, 0xec, 0x1e, 0x38, 0x15, 0xef, 0x2f, 0x8f, 0x99, 0xef, 0x87, 0x13, 0xee, 0xbe,
+	0x87, 0x4c, 0xa4, 0x71, 0x3f, 0x69, 0x53, 0xa0, 0xbd, 0x38, 0x6d, 0x39, 0xe7, 0xab, 0xb8, 0xf4,
+	0x70, 0x51, 0xaf, 0xea, 0x66, 0x30, 0x71, 0xdc, 0x4f, 0x3a, 0x64, 0xb2, 0x43, 0xd3, 0x2c, 0x88,
+	0x18, 0x7d, 0x39, 0xf8, 0xb5, 0x43, 0x0f, 0x5e, 0x36, 0x2e, 0xe8, 0xce, 0xe7, 0x4e, 0x8b, 0x17,
+	0x99, 0x34, 0x1a, 0x53, 0xb0, 0xe8, 0xe3, 0xe6, 0xec, 0xd0, 0xb4, 0x9d, 0x04, 0x3d, 0xfc, 0xdd,
+	0xaa, 0xda, 0x9b, 0x73, 0x41, 0x83, 0xc0, 0xc4, 0x73, 0x23, 0x52, 0xc7, 0xcd, 0x97, 0xb6, 0x6a,
+	0x6c, 0xfc, 0x8b, 0x87, 0x1b, 0xbf, 0x98, 0x54, 0xdc, 0xd7, 0x7a, 0xf6, 0xf1, 0x57, 0x0a, 0x9c,
+	0x8c, 0xfb, 0x63, 0x0e, 0x69, 0x09, 0xe6, 0x00, 0x94, 0x4f, 0xe8, 0xad, 0xad, 0x20, 0xa3, 0x61,
+	0x90, 0x66, 0xad, 0x3a, 0x1b, 0xc3, 0x85, 0xd1, 0xd6, 0xd6, 0xe5, 0x24, 0xee, 0xf7, 0xae, 0x06,
+	0x51, 0x67, 0xee, 0xbc, 0xa0, 0xd4, 0x9a, 0x1f, 0xd2, 0x31, 0x0c, 0x25, 0xe9, 0xfe, 0x94, 0x43,
+	0xce, 0x45, 0x7e, 0x97, 0xa6, 0x3d, 0xbf, 0x4d, 0x25, 0x78, 0x2e, 0xf4, 0xdb, 0xdb, 0x6c, 0x44,
+	0x63, 0xf7, 0x37, 0x22, 0x4f, 0x8c, 0xe8, 0xdc, 0xb5, 0xa1, 0x5d, 0xc3, 0x1e, 0x64, 0xdd, 0x5f,
+	0x76, 0xc8, 0xc9, 0x38, 0xe9, 0x6d, 0xf9, 0x11, 0xed, 0x48, 0x68, 0xda, 0x1a, 0x67, 0x5b, 0xef,
+	0x43, 0x87, 0xfb, 0x44, 0xd7, 0xf3, 0xdd, 0x2e, 0xc7, 0x51, 0x90, 0xc5, 0xc9, 0x2a, 0xcd, 0xb2,
+	0x20, 0xda, 0x4c, 0xe7, 0xce, 0xdc, 0xbb, 0x3b, 0x7d, 0x72, 0x00, 0x0b, 0x06, 0xc7, 0xe3, 0x7e,
+	0x17, 0x99, 0x48, 0x77, 0xa3, 0xf6, 0xad, 0x20, 0xea, 0xc4, 0xb7, 0xd3, 0x56, 0xa3, 0x8c, 0xed,
+	0xbb, 0xaa, 0x3a, 0x14, 0x1b, 0x50, 0x13, 0x00, 0x93, 0x5a, 0xf1, 0x87, 0xd3, 0x4b, 0xa9, 0x59,
+	0xf6, 0x87, 0xd3, 0x8b, 0x69, 0x0f, 0xb2, 0xee, 0x0f, 0x3a, 0xe4, 0x58, 0x1a, 0x6c, 0x46, 0x7e,
+	0xd6, 0x4f, 0xe8, 0x55, 0xba, 0x9b, 0xb6, 0x08, 0x1b, 0xc8, 0x4b, 0x87, 0x9c, 0x15, 0xa3, 0xcb,
+	0xb9, 0x33, 0x62, 0x8c, 0xc7, 0xcc, 0xd6, 0x14, 0x6c, 0xba, 0x45, 0x1b, 0x4d, 0x2f, 0xeb, 0x89,
+	0x72, 0x37, 0x9a, 0x5e, 0xd4, 0x43, 0x49, 0xba, 0xdf, 0x4e, 0x4e, 0xf0, 0x26, 0x35, 0xb3, 0x69,
+	0x6b, 0x92, 0x31, 0xda, 0xd3, 0xf7, 0xee, 0x4e, 0x9f, 0x58, 0xcd, 0xc1, 0x60, 0x00, 0xdb, 0x7d,
+	0x83, 0x4c, 0xf7, 0x68, 0xd2, 0x0d, 0xb2, 0xeb, 0x51, 0xb8, 0x2b, 0xd9, 0x77, 0x3b, 0xee, 0xd1,
+	0x8e, 0x18, 0x4e, 0xda, 0x3a, 0x76, 0xde, 0x79, 0x77, 0x63, 0xee, 0x5d, 0x62, 0x98, 0xd3, 0x2b,
+	0x7b, 0xa3, 0xc3, 0x7e, 0xfd, 0xb9, 0xbf, 0xef, 0x90, 0x73, 0x06, 0x97, 0x5d, 0xa5, 0xc9, 0x4e,
+	0xd0, 0xa6, 0xb3, 0xed, 0x76, 0xdc, 0x8f, 0xb2, 0xb4, 0x35, 0xc5, 0xa6, 0x71, 0xfd, 0x28, 0x78,
+	0xbe, 0x4d, 0x4a, 0xaf, 0xcb, 0xa1, 0x28, 0x29, 0xec, 0x31, 0x52, 0xef, 0x5f, 0x56, 0xc8, 0x89,
+	0xbc, 0x04, 0xe0, 0xfe, 0x3d, 0x87, 0x1c, 0x7f, 0xfd, 0x76, 0xb6, 0x16, 0x6f, 0xd3, 0x28, 0x9d,
+	0xdb, 0x45, 0x3e, 0xcd, 0xce, 0xbe, 0x89, 0xe7, 0xdb, 0xe5, 0xca, 0x1a, 0x33, 0x2f, 0xd9, 0x54,
+	0x2e, 0x46, 0x59, 0xb2, 0x3b, 0xf7, 0xb8, 0x78, 0xa7, 0xe3, 0x2f, 0xdd, 0x5a, 0x33, 0xa1, 0x90,
+	0x1f, 0xd4, 0xb9, 0x4f, 0x38, 0xe4, 0x74, 0x51, 0x17, 0xee, 0x09, 0x52, 0xdd, 0xa6, 0xbb, 0x5c,
+	0x12, 0x05, 0xfc, 0xd7, 0x7d, 0x95, 0xd4, 0x77, 0xfc, 0xb0, 0x4f, 0x85, 0x98, 0x76, 0xf9, 0x70,
+	0x2f, 0xa2, 0x46, 0x06, 0xbc, 0xd7, 0x6f, 0xaa, 0xbc, 0xe8, 0x78, 0x7f, 0x58, 0x25, 0x13, 0xc6,
+	0x47, 0x7b, 0x00, 0xa2, 0x67, 0x6c, 0x89, 0x9e, 0xcb, 0xa5, 0xad, 0xb7, 0xa1, 0xb2, 0xe7, 0xed,
+	0x9c, 0xec, 0x79, 0xbd, 0x3c, 0x92, 0x7b, 0x0a, 0x9f, 0x6e, 0x46, 0x9a, 0x71, 0x8f, 0x26, 0x0c,
+	0xb5, 0x55, 0x2b, 0xe3, 0x13, 0x5e, 0x97, 0xdd, 0xcd, 0x1d, 0xbb, 0x77, 0x77, 0xba, 0xa9, 0x7e,
+	0x82, 0x26, 0xe4, 0xfd, 0x3b, 0x87, 0x9c, 0x36, 0xc6, 0x38, 0x1f, 0x47, 0x9d, 0x80, 0x7d, 0xda,
+	0xf3, 0xa4, 0x96, 0xed, 0xf6, 0xe4, 0x55, 0x47, 0xcd, 0xd4, 0xda, 0x6e, 0x8f, 0x02, 0x83, 0xe0,
+	0x8d, 0xa5, 0x4b, 0xd3, 0xd4, 0xdf, 0xa4, 0xf9, 0xcb, 0xcd, 0x32, 0x6f, 0x06, 0x09, 0x77, 0x13,
+	0xe2, 0x86, 0x7e, 0x9a, 0xad, 0x25, 0x7e, 0x94, 0xb2, 0xee, 0xd7, 0x82, 0x2e, 0x15, 0x13, 0xfc,
+	0x57, 0x46, 0x5b, 0x31, 0xf8, 0xc4, 0xdc, 0x63, 0xf7, 0xee, 0x4e, 0xbb, 0x4b, 0x03, 0x3d, 0x41,
+	0x41, 0xef, 0xde, 0x4f, 0x39, 0xe4, 0xb1, 0x62, 0x06, 0xe3, 0x3e, 0x4b, 0xc6, 0xf8, 0x3d, 0x57,
+	0xbc, 0x9d, 0xfe, 0x24, 0xac, 0x15, 0x04, 0xd4, 0xbd, 0x40, 0x9a, 0xea, 0xc0, 0x13, 0xef, 0x78,
+	0x52, 0xa0, 0x36, 0xf5, 0x29, 0xa9, 0x71, 0x70, 0xd2, 0x22, 0x5f, 0xbc, 0x99, 0x31, 0x69, 0x88,
+	0x0b, 0x0c, 0xe2, 0x7d, 0xce, 0x21, 0xef, 0x1c, 0x85, 0xed, 0x1d, 0xdd, 0x18, 0x57, 0xc9, 0x99,
+	0x0e, 0xdd, 0xf0, 0xfb, 0x61, 0x66, 0x53, 0x14, 0x83, 0x7e, 0x4a, 0x3c, 0x7c, 0x66, 0xa1, 0x08,
+	0x09, 0x8a, 0x9f, 0xf5, 0xfe, 0x93, 0x43, 0x8e, 0x1b, 0xaf, 0xf5, 0x00, 0xae, 0x4e, 0x91, 0x7d,
+	0x75, 0x5a, 0x2c, 0x6d, 0x9b, 0x0e, 0xb9, 0x3b, 0xfd, 0x98, 0x43, 0xce, 0x19, 0x58, 0xcb, 0x7e,
+	0xd6, 0xde, 0xba, 0x78, 0xa7, 0x97, 0xd0, 0x34, 0xc5, 0x25, 0xf5, 0x94, 0xc1, 0x8e, 0xe7, 0x26,
+	0x44, 0x0f, 0xd5, 0xab, 0x74, 0x97, 0xf3, 0xe6, 0xaf, 0x23, 0x0d, 0xbe, 0xe7, 0xe2, 0x44, 0x7c,
+	0x24, 0xf5, 0x6e, 0xd7, 0x45, 0x3b, 0x28, 0x0c, 0xd7, 0x23, 0x63, 0x8c, 0xe7, 0x22, 0x0f, 0x42,
+	0x31, 0x81, 0xe0, 0x77, 0xbf, 0xc9, 0x5a, 0x40, 0x40, 0xbc, 0xd4, 0x1a, 0xce, 0x4a, 0x42, 0xd9,
+	0x7a, 0xe8, 0x5c, 0x0a, 0x68, 0xd8, 0x49, 0xf1, 0x5a, 0xe7, 0x47, 0x51, 0x9c, 0x89, 0x1b, 0x9a,
+	0x71, 0xad, 0x9b, 0xd5, 0xcd, 0x60, 0xe2, 0x20, 0xd1, 0xd0, 0x5f, 0xa7, 0x21, 0x9f, 0x51, 0x41,
+	0x74, 0x89, 0xb5, 0x80, 0x80, 0x78, 0xf7, 0x2a, 0x64, 0xca, 0xa0, 0xba, 0x4a, 0x1f, 0x84, 0xf6,
+	0x21, 0xb1, 0x8e, 0x80, 0x95, 0xf2, 0xf8, 0x31, 0x1d, 0xae, 0x81, 0x78, 0x33, 0x77, 0x0a, 0x40,
+	0xa9, 0x54, 0xf7, 0xd6, 0x42, 0x7c, 0xac, 0x4a, 0xa6, 0xed, 0x07, 0x06, 0x0e, 0x11, 0xbc, 0xf2,
+	0x1a, 0x84, 0xf2, 0xfa, 0x28, 0x03, 0x1f, 0x4c, 0xbc, 0x21, 0x7c, 0xb8, 0x72, 0x94, 0x7c, 0xd8,
+	0x3c, 0x26, 0xaa, 0xfb, 0x1c, 0x13, 0xcf, 0xaa, 0x59, 0xaf, 0xe5, 0x78, 0x9e, 0x7d, 0x54, 0x9e,
+	0x27, 0xb5, 0x34, 0xa3, 0xbd, 0x56, 0xdd, 0x66, 0xb3, 0xab, 0x19, 0xed, 0x01, 0x83, 0xb8, 0xdf,
+	0x4a, 0x8e, 0x67, 0x7e, 0xb2, 0x49, 0xb3, 0x84, 0xee, 0x04, 0x4c, 0x77, 0xc9, 0xee, 0xb3, 0xcd,
+	0xb9, 0x53, 0x28, 0x75, 0xad, 0x31, 0x10, 0x48, 0x10, 0xe4, 0x71, 0xbd, 0xff, 0x56, 0x21, 0x8f,
+	0xdb, 0x9f, 0x40, 0x1f, 0x8c, 0xdf, 0x66, 0x1d, 0x8c, 0x5f, 0x6b, 0x1e, 0x8c, 0x6f, 0xdd, 0x9d,
+	0x7e, 0x62, 0xc8, 0x63, 0x5f, 0x32, 0xe7, 0xa6, 0x7b, 0x39, 0xf7, 0x11, 0x2e, 0xd8, 0x1f, 0xe1,
+	0xad, 0xbb, 0xd3, 0x4f, 0x0d, 0x79, 0xc7, 0xdc, 0x57, 0x7a, 0x96, 0x8c, 0x25, 0xd4, 0x4f, 0xe3,
+	0xa8, 0x55, 0xb7, 0xbf, 0x26, 0xb0, 0x56, 0x10, 0x50, 0xef, 0xb3, 0xcd, 0xfc, 0x64, 0x5f, 0xe6,
+	0xfa, 0xd8, 0x38, 0x71, 0x03, 0x52, 0x63, 0xb7, 0x36, 0xce, 0x59, 0xae, 0x1e, 0x6e, 0x17, 0xe2,
+	0x29, 0xa2, 0xba, 0x9e, 0x6b, 0xe0, 0x57, 0xc3, 0x26, 0x60, 0x24, 0xdc, 0x3b, 0xa4, 0xd1, 0x96,
+	0x97, 0xa9, 0x4a, 0x19, 0x6a, 0x47, 0x71, 0x95, 0xd2, 0x14, 0x27, 0x91, 0xdd, 0xab, 0x1b, 0x98,
+	0xa2, 0xe6, 0x52, 0x52, 0xdd, 0x0c, 0x32, 0xf1, 0x59, 0x0f, 0x79, 0x5d, 0xbe, 0x1c, 0x18, 0xaf,
+	0x38, 0x8e, 0x67, 0xd0, 0xe5, 0x20, 0x03, 0xec, 0xdf, 0xfd, 0x01, 0x87, 0x4c, 0xa4, 0xed, 0xee,
+	0x4a, 0x12, 0xef, 0x04, 0x1d, 0x9a, 0xb4, 0x6a, 0x65, 0x70, 0xb6, 0xd5, 0xf9, 0x65, 0xd9, 0xa1,
+	0xa6, 0xcb, 0xd5, 0x17, 0x1a, 0x02, 0x26, 0x5d, 0xbc, 0x7b, 0x3d, 0x2e, 0xde, 0x7d, 0x81, 0xb6,
+	0xd9, 0x8e, 0x93, 0x77, 0xe6, 0x56, 0xbd, 0x0c, 0x99, 0x7b, 0xa1, 0xdf, 0xde, 0xc6, 0xfd, 0xa6,
+	0x07, 0xf4, 0xc4, 0xbd, 0xbb, 0xd3, 0x8f, 0xcf, 0x17, 0xd3, 0x84, 0x61, 0x83, 0x61, 0x13, 0xd6,
+	0xeb, 0x87, 0x21, 0xd0, 0x37, 0xfa, 0x94, 0x69, 0xc4, 0x4a, 0x98, 0xb0, 0x15, 0xdd, 0x61, 0x6e,
+	0xc2, 0x0c, 0x08, 0x98, 0x74, 0xdd, 0x37, 0xc8, 0x58, 0xd7, 0xcf, 0x92, 0xe0, 0x4e, 0x6b, 0xbc,
+	0x8c, 0x5b, 0xd0, 0x32, 0xeb, 0x4b, 0x13, 0x67, 0x07, 0x3d, 0x6f, 0x04, 0x41, 0x08, 0x15, 0xd3,
+	0x5d, 0x9a, 0x6c, 0xd2, 0x56, 0xa3, 0x0c, 0x95, 0xff, 0x32, 0x76, 0xa5, 0x09, 0x36, 0x51, 0xb8,
+	0x62, 0x6d, 0xc0, 0xa9, 0xb8, 0xaf, 0x92, 0x46, 0x4a, 0x43, 0xda, 0x46, 0xf1, 0xa8, 0xc9, 0x28,
+	0xbe, 0x30, 0xa2, 0xa8, 0x88, 0x72, 0xc9, 0xaa, 0x78, 0x94, 0x6f, 0x30, 0xf9, 0x0b, 0x54, 0x97,
+	0x38, 0x81, 0xbd, 0xb0, 0xbf, 0x19, 0x44, 0x2d, 0x52, 0xc6, 0x04, 0xae, 0xb0, 0xbe, 0x72, 0x13,
+	0xc8, 0x1b, 0x41, 0x10, 0xf2, 0xfe, 0xab, 0x43, 0x5c, 0x9b, 0xa9, 0x3d, 0x00, 0x99, 0xf8, 0x0d,
+	0x5b, 0x26, 0x5e, 0x2a, 0x53, 0x68, 0x19, 0x22, 0x16, 0xff, 0x66, 0x93, 0xe4, 0x8e, 0x83, 0x6b,
+	0x34, 0xcd, 0x68, 0xe7, 0x6d, 0x16, 0xfe, 0x36, 0x0b, 0x7f, 0x9b, 0x85, 0xcb, 0x1f, 0xee, 0x7a,
+	0x8e, 0x85, 0xbf, 0xdf, 0xd8, 0xf5, 0xda, 0xbe, 0xfe, 0x9a, 0x32, 0xc0, 0x9b, 0x23, 0x30, 0x10,
+	0x90, 0x13, 0xbc, 0xb4, 0x7a, 0xfd, 0x5a, 0x21, 0xcf, 0x7e, 0xcd, 0xe6, 0xd9, 0x87, 0x25, 0xf1,
+	0x95, 0xc0, 0xa5, 0x7f, 0xdf, 0x21, 0xef, 0xb2, 0xb9, 0x97, 0x5c, 0x39, 0x8b, 0x9b, 0x51, 0x9c,
+	0xd0, 0x85, 0x60, 0x63, 0x83, 0x26, 0x34, 0x42, 0x1d, 0xbc, 0xd4, 0xed, 0x38, 0xc3, 0x74, 0x3b,
+	0xee, 0x7b, 0xc9, 0xe4, 0xeb, 0x69, 0x1c, 0xad, 0xc4, 0x41, 0x24, 0x58, 0x10, 0xde, 0x38, 0x4e,
+	0xa0, 0xf5, 0x12, 0x67, 0x54, 0xb6, 0x83, 0x85, 0xe5, 0xce, 0x93, 0x93, 0xaf, 0xbf, 0xb1, 0xe2,
+	0x67, 0x86, 0x36, 0x41, 0xde, 0xfb, 0x99, 0x3d, 0xea, 0xa5, 0x97, 0x73, 0x40, 0x18, 0xc4, 0xf7,
+	0xfe, 0x56, 0x85, 0x9c, 0xcd, 0xbd, 0x48, 0x1c, 0x86, 0x71, 0x3f, 0xc3, 0x3b, 0x91, 0xfb, 0xf3,
+	0x0e, 0x39, 0xd1, 0xb5, 0x15, 0x16, 0xa9, 0x50, 0x77, 0x7f, 0x47, 0x69, 0x67, 0x44, 0x4e, 0x23,
+	0x32, 0xd7, 0x12, 0x33, 0x74, 0x22, 0x07, 0x48, 0x61, 0x60, 0x2c, 0xee, 0xab, 0xa4, 0xd9, 0xf5,
+	0xef, 0xdc, 0xe8, 0x75, 0xfc, 0x4c, 0x5e, 0x47, 0x87, 0x6b, 0x11, 0xfa, 0x59, 0x10, 0xce, 0x70,
+	0xcf, 0x8d, 0x99, 0xc5, 0x28, 0xbb, 0x9e, 0xac, 0x66, 0x49, 0x10, 0x6d, 0x72, 0x25, 0xe7, 0xb2,
+	0xec, 0x06, 0x74, 0x8f, 0xde, 0xcf, 0x39, 0xe4, 0xa9, 0x21, 0xb3, 0x93, 0xf8, 0x19, 0xdd, 0xdc,
+	0x75, 0x3f, 0x42, 0xea, 0x78, 0x6f, 0x94, 0xb3, 0x72, 0xab, 0xcc, 0x93, 0xd3, 0xf8, 0x12, 0xfa,
+	0x10, 0xc5, 0x5f, 0x29, 0x70, 0xa2, 0xde, 0xcf, 0x37, 0xf3, 0xc2, 0x02, 0xb3, 0xcd, 0x3f, 0x4f,
+	0xc8, 0x66, 0xbc, 0x46, 0xbb, 0xbd, 0xd0, 0xcf, 0xf8, 0xba, 0x6b, 0x68, 0x55, 0xc9, 0x65, 0x05,
+	0x01, 0x03, 0xcb, 0xfd, 0x61, 0x87, 0x90, 0x4d, 0xb9, 0xe6, 0xa5, 0x20, 0x70, 0xa3, 0xcc, 0xd7,
+	0xd1, 0x3b, 0x4a, 0x8f, 0x45, 0x11, 0x04, 0x83, 0xb8, 0xfb, 0xbd, 0x0e, 0x69, 0x64, 0x72, 0xf8,
+	0xfc, 0x68, 0x5c, 0x2b, 0x73, 0x24, 0xf2, 0xa5, 0xb5, 0x4c, 0xa4, 0xa6, 0x44, 0xd1, 0x75, 0xff,
+	0x9a, 0x43, 0x08, 0x1a, 0x4f, 0x57, 0xe2, 0x30, 0x68, 0xef, 0x8a, 0x13, 0xf3, 0x66, 0xa9, 0xea,
+	0x1c, 0xd5, 0xfb, 0xdc, 0x14, 0xce, 0x86, 0xfe, 0x0d, 0x06, 0x65, 0xf7, 0xa3, 0xa4, 0x91, 0x8a,
+	0xe5, 0xd6, 0xaa, 0x97, 0x3f, 0x19, 0x72, 0x29, 0x0b, 0xf6, 0x2a, 0x7e, 0x81, 0xa2, 0xe9, 0xfe,
+	0x8c, 0x43, 0x8e, 0xf7, 0x6c, 0x35, 0xa1, 0x38, 0x0e, 0xcb, 0xe3, 0x01, 0x39, 0x35, 0x24, 0xd7,
+	0xb6, 0xe4, 0x1a, 0x21, 0x3f, 0x0a, 0xe4, 0x80, 0x7a, 0x05, 0x5f, 0xef, 0x71, 0x95, 0xe5, 0xb8,
+	0xe6, 0x80, 0x97, 0xf3, 0x40, 0x18, 0xc4, 0x77, 0x57, 0xc8, 0x69, 0x1c, 0xdd, 0x2e, 0x17, 0x3f,
+	0xe5, 0xf1, 0x92, 0xb2, 0xc3, 0xb0, 0x31, 0xf7, 0xa4, 0x58, 0x21, 0xa7, 0x67, 0x0b, 0x70, 0xa0,
+	0xf0, 0x49, 0xf7, 0x0f, 0x1d, 0xf2, 0x64, 0xc0, 0x8e, 0x01, 0x53, 0x61, 0xaf, 0x4f, 0x04, 0x61,
+	0x68, 0xa7, 0xa5, 0xf2, 0x8a, 0x61, 0xc7, 0xcf, 0xdc, 0x3b, 0xc5, 0x1b, 0x3c, 0xb9, 0xb8, 0xc7,
+	0x90, 0x60, 0xcf, 0x01, 0xbb, 0xdf, 0x48, 0x8e, 0xc9, 0x7d, 0xb1, 0x82, 0x2c, 0x98, 0x1d, 0xb4,
+	0xcd, 0xb9, 0x93, 0x68, 0x51, 0x5f, 0x33, 0x01, 0x60, 0xe3, 0x79, 0xff, 0xaa, 0x4a, 0x4e, 0xe7,
+	0x97, 0x1b, 0xd3, 0xf1, 0x20, 0xbb, 0x69, 0x4b, 0xfd, 0x8f, 0xe4, 0x9e, 0xa5, 0xb2, 0x1b, 0xa5,
+	0x5d, 0xd2, 0xec, 0x46, 0x35, 0xa5, 0x60, 0x10, 0x47, 0xa1, 0xf4, 0xa4, 0x9f, 0xd7, 0x94, 0x0a,
+	0x0e, 0xf8, 0x6a, 0x99, 0x43, 0x1a, 0xb4, 0xe9, 0x9d, 0x15, 0x43, 0x3b, 0x39, 0x00, 0x82, 0xc1,
+	0x21, 0xb9, 0xdf, 0x4d, 0x9a, 0x89, 0xf2, 0x6c, 0xa9, 0x96, 0x71, 0x55, 0x93, 0xcb, 0x46, 0x0c,
+	0x47, 0x19, 0x80, 0xb4, 0x0f, 0x8b, 0xa6, 0xe8, 0xfd, 0x81, 0x6d, 0x18, 0x33, 0x78, 0xc7, 0x08,
+	0x46, 0xbf, 0x4f, 0x3a, 0x64, 0x22, 0x89, 0xc3, 0x30, 0x88, 0x36, 0x91, 0xcf, 0x89, 0xc3, 0xfa,
+	0x83, 0x47, 0x72, 0x5e, 0x0a, 0x86, 0xc6, 0x24, 0x6b, 0xd0, 0x34, 0xc1, 0x1c, 0x00, 0xfa, 0xec,
+	0xb5, 0x86, 0xf1, 0x63, 0x97, 0x92, 0x27, 0x24, 0xb3, 0x51, 0x53, 0x71, 0x3d, 0x5a, 0xa0, 0x21,
+	0x55, 0x6a, 0xf3, 0xc6, 0xdc, 0x33, 0xe2, 0x35, 0x9f, 0x58, 0x19, 0x8e, 0x0a, 0x7b, 0xf5, 0xe3,
+	0xbe, 0x42, 0x4e, 0x18, 0xef, 0x95, 0xaa, 0x89, 0x69, 0xce, 0xcd, 0xa0, 0x00, 0x34, 0x9b, 0x83,
+	0xbd, 0x75, 0x77, 0xfa, 0xb1, 0x7c, 0x9b, 0x38, 0x30, 0x06, 0xfa, 0xf1, 0x7e, 0xa5, 0x92, 0xff,
+	0x5a, 0xea, 0xac, 0xff, 0x94, 0x33, 0xa0, 0x4d, 0xf8, 0x8e, 0xa3, 0x38, 0x5f, 0x99, 0xde, 0x41,
+	0xb9, 0x61, 0x0c, 0xc7, 0x79, 0x88, 0x66, 0x7b, 0xef, 0x5f, 0xd7, 0xc8, 0x1e, 0x23, 0x1b, 0x41,
+	0x78, 0x3f, 0xb0, 0x1d, 0xf5, 0x47, 0x1d, 0x65, 0x30, 0xe3, 0x7b, 0xb8, 0x73, 0x54, 0x73, 0xcf,
+	0xef, 0x4f, 0x29, 0x77, 0x1d, 0x51, 0x5a, 0x74, 0xdb, 0x34, 0xe7, 0xfe, 0x82, 0x63, 0x9b, 0xfc,
+	0xb8, 0x53, 0x63, 0x70, 0x64, 0x63, 0x32, 0xec, 0x88, 0x7c, 0x60, 0xda, 0xfa, 0x34, 0xcc, 0xc2,
+	0x38, 0x43, 0xc8, 0x46, 0x10, 0xf9, 0x61, 0xf0, 0x26, 0xde, 0x8e, 0xea, 0xec, 0x80, 0x67, 0x12,
+	0xd3, 0x25, 0xd5, 0x0a, 0x06, 0xc6, 0xb9, 0xbf, 0x4a, 0x26, 0x8c, 0x37, 0x2f, 0xf0, 0x78, 0x39,
+	0x6d, 0x7a, 0xbc, 0x34, 0x0d, 0x47, 0x95, 0x73, 0xef, 0x27, 0x27, 0xf2, 0x03, 0x3c, 0xc8, 0xf3,
+	0xde, 0xff, 0x1e, 0xcf, 0xdb, 0xe0, 0xd6, 0x68, 0xd2, 0xc5, 0xa1, 0xbd, 0xad, 0xd8, 0x7a, 0x5b,
+	0xb1, 0xf5, 0xb6, 0x62, 0xcb, 0xb4, 0x4d, 0x08, 0xa5, 0xcd, 0xf8, 0x03, 0x52, 0xda, 0x58, 0x6a,
+	0xa8, 0x46, 0xe9, 0x6a, 0x28, 0xef, 0x07, 0x06, 0x34, 0xf7, 0x6b, 0x09, 0xa5, 0x6e, 0x4c, 0xea,
+	0x51, 0xdc, 0xa1, 0x52, 0xc6, 0x7d, 0xa9, 0x1c, 0x81, 0xed, 0x5a, 0xdc, 0x31, 0xdc, 0xc5, 0xf1,
+	0x57, 0x0a, 0x9c, 0x8e, 0xf7, 0xfd, 0x63, 0xc4, 0x12, 0x27, 0xf9, 0x77, 0xc7, 0x88, 0x12, 0xda,
+	0x8b, 0x6f, 0xc0, 0x52, 0xcb, 0xb1, 0x8d, 0xc7, 0xc0, 0x9b, 0x41, 0xc2, 0xf1, 0xcc, 0xeb, 0xf9,
+	0xd9, 0x56, 0xab, 0x62, 0x9f, 0x79, 0xa8, 0x3a, 0x02, 0x06, 0x71, 0xdf, 0x4f, 0xa6, 0x32, 0xcb,
+	0x14, 0x2e, 0x4c, 0xbe, 0x8f, 0x09, 0xdc, 0x29, 0xdb, 0x50, 0x0e, 0x39, 0x6c, 0xf7, 0x0d, 0x52,
+	0xdb, 0xa2, 0x61, 0x57, 0x7c, 0xfa, 0xd5, 0xf2, 0xce, 0x1a, 0xf6, 0xae, 0x57, 0x68, 0xd8, 0xe5,
+	0x9c, 0x10, 0xff, 0x03, 0x46, 0x0a, 0xd7, 0x7d, 0x73, 0xbb, 0x9f, 0x66, 0x71, 0x37, 0x78, 0x53,
+	0x6a, 0x3a, 0xbf, 0xa3, 0x64, 0xc2, 0x57, 0x65, 0xff, 0x5c, 0xa5, 0xa4, 0x7e, 0x82, 0xa6, 0xcc,
+	0xc6, 0xd1, 0x09, 0x12, 0xb6, 0x64, 0x76, 0x5b, 0xe4, 0x48, 0xc6, 0xb1, 0x20, 0xfb, 0xe7, 0xe3,
+	0x50, 0x3f, 0x41, 0x53, 0x76, 0x77, 0xd5, 0xfe, 0x9b, 0x38, 0xef, 0x94, 0x7b, 0xf7, 0x62, 0x63,
+	0xe0, 0x7b, 0xaf, 0x70, 0x1f, 0x3e, 0x43, 0xea, 0xed, 0x2d, 0x3f, 0xc9, 0x5a, 0x93, 0x6c, 0xd1,
+	0xa8, 0x55, 0x3c, 0x8f, 0x8d, 0xc0, 0x61, 0xe8, 0x17, 0x95, 0xd0, 0x8d, 0xd6, 0x31, 0xdb, 0x2f,
+	0x0a, 0xe8, 0x06, 0x60, 0xbb, 0x92, 0xcb, 0xa6, 0x86, 0x3a, 0xcc, 0xfd, 0x62, 0x85, 0x9c, 0x1b,
+	0x18, 0x95, 0x9a, 0x0a, 0xbe, 0x1f, 0xda, 0xfd, 0x24, 0x95, 0x0a, 0x32, 0x63, 0x3f, 0xb0, 0x66,
+	0x90, 0x70, 0xf7, 0xe3, 0x0e, 0x19, 0x47, 0xcd, 0x6b, 0x44, 0xb3, 0x56, 0xa5, 0x6c, 0x35, 0x10,
+	0x1b, 0xd6, 0x4b, 0xbc, 0x77, 0x3d, 0x06, 0xd1, 0x00, 0x92, 0x2e, 0x0e, 0x97, 0xde, 0x69, 0x87,
+	0xfd, 0xce, 0x80, 0x33, 0xcc, 0x45, 0xde, 0x0c, 0x12, 0x8e, 0xa8, 0x41, 0xc4, 0x51, 0x6b, 0x36,
+	0xea, 0x62, 0x24, 0x50, 0x05, 0xdc, 0xfb, 0xf5, 0x06, 0x39, 0x53, 0xb8, 0x7d, 0x50, 0xe4, 0x62,
+	0x42, 0xcd, 0xa5, 0x20, 0xa4, 0xd2, 0x0d, 0x8c, 0x89, 0x5c, 0x37, 0x55, 0x2b, 0x18, 0x18, 0xee,
+	0xf7, 0x10, 0xd2, 0xf3, 0x13, 0xbf, 0x4b, 0x95, 0x02, 0xfb, 0xd0, 0x92, 0x0d, 0x8e, 0x63, 0x45,
+	0xf6, 0xa9, 0x2f, 0xf1, 0xaa, 0x29, 0x05, 0x83, 0x24, 0x3a, 0x36, 0x25, 0x34, 0xa4, 0x7e, 0xca,
+	0xdc, 0xdf, 0xf3, 0xb1, 0x3c, 0xa0, 0x41, 0x60, 0xe2, 0xa1, 0xaf, 0x89, 0xf0, 0x98, 0xcb, 0x79,
+	0x0e, 0xd9, 0x5e, 0x73, 0xee, 0x8f, 0x3b, 0x64, 0x0a, 0x63, 0xe8, 0x34, 0x75, 0x11, 0x79, 0x73,
+	0xfd, 0xf0, 0x2f, 0x79, 0xc9, 0xec, 0x57, 0xf3, 0x50, 0xab, 0x39, 0x85, 0x1c, 0x79, 0xfc, 0xcc,
+	0x3b, 0x34, 0x61, 0xcc, 0x77, 0xcc, 0xfe, 0xcc, 0x37, 0x79, 0x33, 0x48, 0xb8, 0x3b, 0x4b, 0x8e,
+	0xf7, 0xfc, 0x34, 0x9d, 0x4f, 0x68, 0x87, 0x46, 0x59, 0xe0, 0x87, 0x3c, 0x2e, 0xa6, 0xa1, 0xdd,
+	0xc9, 0x57, 0x6c, 0x30, 0xe4, 0xf1, 0xdd, 0x0f, 0x90, 0xc7, 0xb9, 0x86, 0x68, 0x39, 0x48, 0xd3,
+	0x20, 0xda, 0xd4, 0xcb, 0x40, 0x28, 0xca, 0xa6, 0x45, 0x57, 0x8f, 0x2f, 0x16, 0xa3, 0xc1, 0xb0,
+	0xe7, 0xd1, 0xc5, 0x31, 0xdd, 0x0e, 0x7a, 0xf3, 0x49, 0x27, 0x65, 0xd6, 0xa1, 0x86, 0x56, 0xcb,
+	0xae, 0x8a, 0x76, 0x50, 0x18, 0x6e, 0x9b, 0x4c, 0xf2, 0x4f, 0xc2, 0x5d, 0xfe, 0x04, 0x07, 0x7d,
+	0x6e, 0xe8, 0x41, 0x2e, 0xc2, 0x3c, 0x67, 0xc0, 0xbf, 0x7d, 0x51, 0xda, 0xaa, 0xb8, 0x69, 0xe5,
+	0xa6, 0xd1, 0x0d, 0x58, 0x9d, 0xda, 0x77, 0xba, 0x89, 0x11, 0xee, 0x74, 0xdf, 0x40, 0x26, 0xb6,
+	0xfb, 0xeb, 0x54, 0xcc, 0x7c, 0x6b, 0xd2, 0x5e, 0x7d, 0x57, 0x35, 0x08, 0x4c, 0x3c, 0xe6, 0x6d,
+	0xd9, 0x0b, 0xc4, 0x2f, 0x0c, 0xc5, 0xd0, 0xde, 0x96, 0x2b, 0x8b, 0xb2, 0x19, 0x4c, 0x1c, 0x1c,
+	0x1a, 0xce, 0xc5, 0x1a, 0x4d, 0x59, 0x30, 0x05, 0x4e, 0x97, 0x1a, 0xda, 0xaa, 0x04, 0x80, 0xc6,
+	0x41, 0xfd, 0x26, 0xfe, 0x58, 0x65, 0x61, 0xae, 0x37, 0xfd, 0x30, 0xe8, 0x70, 0xd7, 0xbf, 0xe3,
+	0xb6, 0x7e, 0x73, 0xb5, 0x00, 0x07, 0x0a, 0x9f, 0xf4, 0x7e, 0xb6, 0x42, 0x5a, 0x03, 0x5c, 0x43,
+	0x70, 0x2c, 0x37, 0x45, 0x46, 0x95, 0xdd, 0xf4, 0x13, 0x29, 0xf0, 0x1c, 0x32, 0xb8, 0x49, 0xf4,
+	0x7b, 0xd3, 0x4f, 0x4c, 0x96, 0xc7, 0x08, 0x80, 0xa4, 0xe4, 0xbe, 0x4e, 0x6a, 0x59, 0xe8, 0x97,
+	0x14, 0x0d, 0x69, 0x50, 0xd4, 0x8a, 0xac, 0xa5, 0xd9, 0x14, 0x18, 0x0d, 0xf7, 0x49, 0xbc, 0xbd,
+	0xad, 0x4b, 0x4b, 0x9b, 0xb8, 0x70, 0xad, 0xa7, 0xc0, 0x5a, 0xbd, 0x9f, 0x3e, 0x56, 0x70, 0xea,
+	0x28, 0x41, 0x00, 0x2d, 0x33, 0xb8, 0x68, 0x56, 0x12, 0xba, 0x11, 0xdc, 0x11, 0x82, 0x98, 0xe2,
+	0x6c, 0xd7, 0x14, 0x04, 0x0c, 0x2c, 0xf9, 0xcc, 0x6a, 0x7f, 0x03, 0x9f, 0xa9, 0x0c, 0x3e, 0xc3,
+	0x21, 0x60, 0x60, 0xb9, 0xef, 0x25, 0x63, 0x41, 0xd7, 0xdf, 0x54, 0x8e, 0xc0, 0x4f, 0x22, 0x4b,
+	0x5b, 0x64, 0x2d, 0x6f, 0xdd, 0x9d, 0x9e, 0x52, 0x03, 0x62, 0x4d, 0x20, 0x70, 0xdd, 0x5f, 0x71,
+	0xc8, 0x64, 0x3b, 0xee, 0x76, 0xe3, 0x88, 0x5f, 0x9f, 0x85, 0x2e, 0xe0, 0xf5, 0xa3, 0x12, 0x93,
+	0x66, 0xe6, 0x0d, 0x62, 0x5c, 0x19, 0xa0, 0xc2, 0x36, 0x4d, 0x10, 0x58, 0xa3, 0x32, 0x39, 0x5f,
+	0x7d, 0x1f, 0xce, 0xf7, 0x1b, 0x0e, 0x39, 0xc9, 0x9f, 0x35, 0x6e, 0xf5, 0x22, 0x42, 0x31, 0x3e,
+	0xe2, 0xd7, 0x1a, 0x50, 0x74, 0x28, 0x65, 0xef, 0x00, 0x1c, 0x06, 0x07, 0xe9, 0x5e, 0x26, 0x27,
+	0x37, 0xe2, 0xa4, 0x4d, 0xcd, 0x89, 0x10, 0x6c, 0x5b, 0x75, 0x74, 0x29, 0x8f, 0x00, 0x83, 0xcf,
+	0xb8, 0x37, 0xc9, 0x63, 0x46, 0xa3, 0x39, 0x0f, 0x9c, 0x73, 0x3f, 0x2d, 0x7a, 0x7b, 0xec, 0x52,
+	0x21, 0x16, 0x0c, 0x79, 0xda, 0x66, 0x92, 0xcd, 0x11, 0x98, 0xe4, 0x6b, 0xe4, 0x6c, 0x7b, 0x70,
+	0x66, 0x76, 0xd2, 0xfe, 0x7a, 0xca, 0xf9, 0x78, 0x63, 0xee, 0xab, 0x44, 0x07, 0x67, 0xe7, 0x87,
+	0x21, 0xc2, 0xf0, 0x3e, 0xdc, 0x8f, 0x90, 0x46, 0x42, 0xd9, 0x57, 0x49, 0x45, 0xb8, 0xde, 0x21,
+	0xb5, 0x1d, 0x5a, 0x82, 0xe7, 0xdd, 0xea, 0x93, 0x49, 0x34, 0xa4, 0xa0, 0x28, 0xba, 0xb7, 0xc9,
+	0x78, 0x0f, 0x8d, 0x1e, 0x22, 0x48, 0xef, 0xd0, 0xba, 0x79, 0x45, 0x9c, 0x99, 0x52, 0x8c, 0xb0,
+	0x7e, 0x4e, 0x04, 0x24, 0x35, 0x94, 0xd5, 0xda, 0x71, 0xb7, 0x17, 0x47, 0x34, 0xca, 0xe4, 0x21,
+	0x32, 0xc5, 0xed, 0x1d, 0xb2, 0x15, 0x0c, 0x8c, 0x81, 0xb3, 0x5c, 0xa3, 0xb5, 0x4e, 0xee, 0x71,
+	0x96, 0x1b, 0xbd, 0x0d, 0x7b, 0x1e, 0x0f, 0x1b, 0xa6, 0x56, 0xbc, 0x15, 0x64, 0x5b, 0xa8, 0x8a,
+	0x97, 0xd7, 0xed, 0x29, 0xfb, 0xb0, 0x59, 0x2a, 0xc0, 0x81, 0xc2, 0x27, 0xf3, 0x27, 0xeb, 0xf1,
+	0xfb, 0x3b, 0x59, 0x4f, 0x8c, 0x70, 0xb2, 0xae, 0x92, 0x33, 0x6c, 0x04, 0x42, 0x4a, 0x96, 0x4a,
+	0xcb, 0xb4, 0xe5, 0xb2, 0xc1, 0xab, 0xf8, 0x96, 0xa5, 0x22, 0x24, 0x28, 0x7e, 0xf6, 0xdc, 0xb7,
+	0x91, 0x93, 0x03, 0x4c, 0xee, 0x40, 0x0a, 0xc9, 0x05, 0xf2, 0x58, 0x31, 0x3b, 0x39, 0x90, 0x5a,
+	0xf2, 0xd7, 0x73, 0x7e, 0xe9, 0xc6, 0x15, 0x6d, 0x04, 0x15, 0xb7, 0x4f, 0xaa, 0x34, 0xda, 0x11,
+	0xa7, 0xeb, 0xa5, 0xc3, 0xad, 0xea, 0x8b, 0xd1, 0x0e, 0xe7, 0x86, 0x4c, 0x8f, 0x77, 0x31, 0xda,
+	0x01, 0xec, 0xdb, 0xfd, 0x49, 0xc7, 0xba, 0x40, 0x70, 0xc5, 0xf8, 0x87, 0x8e, 0xe4, 0x4e, 0x3a,
+	0xf2, 0x9d, 0xc2, 0xfb, 0x37, 0x15, 0x72, 0x7e, 0xbf, 0x4e, 0x46, 0x98, 0xbe, 0x67, 0xd0, 0x31,
+	0x1e, 0x3d, 0x4d, 0xc4, 0x71, 0x35, 0x81, 0xbb, 0x98, 0xfb, 0x9e, 0xbc, 0x06, 0x02, 0xe4, 0x86,
+	0xa4, 0xda, 0xf5, 0x7b, 0x42, 0x5f, 0xba, 0x78, 0xd8, 0xf8, 0x3d, 0xfc, 0xed, 0x87, 0xcb, 0x7e,
+	0x8f, 0xaf, 0x79, 0xa3, 0x01, 0x90, 0x8c, 0x9b, 0x91, 0xba, 0x9f, 0x24, 0xbe, 0x74, 0x6b, 0xb8,
+	0x5a, 0x0e, 0xbd, 0x59, 0xec, 0x92, 0x5b, 0x85, 0xad, 0x26, 0xe0, 0xc4, 0xbc, 0x9f, 0x69, 0x58,
+	0xc1, 0x5e, 0xcc, 0x57, 0x25, 0x25, 0x63, 0x42, 0x4d, 0xea, 0x94, 0x1d, 0x36, 0xc9, 0xba, 0xe5,
+	0x1a, 0x08, 0xfe, 0x3f, 0x08, 0x52, 0xee, 0x27, 0x1c, 0x96, 0xf9, 0x41, 0x46, 0xd0, 0xb5, 0x2a,
+	0x25, 0xbb, 0x55, 0x98, 0x89, 0x28, 0xcc, 0x7c, 0x12, 0xb2, 0x11, 0x4c, 0xea, 0x22, 0x83, 0x0b,
+	0xbb, 0xcd, 0x0c, 0x66, 0x70, 0xc1, 0x66, 0x90, 0x70, 0xf7, 0x4e, 0x81, 0x4f, 0x4a, 0x09, 0xd9,
+	0x03, 0x46, 0xf0, 0x42, 0xf9, 0x05, 0x87, 0x9c, 0x0c, 0xf2, 0xce, 0x05, 0xad, 0x7a, 0x19, 0x5e,
+	0x4f, 0xc3, 0x7d, 0x17, 0x94, 0xa0, 0x33, 0x00, 0x82, 0xc1, 0xc1, 0xb8, 0x1d, 0x52, 0x0b, 0xa2,
+	0x8d, 0x58, 0x88, 0x77, 0x73, 0x87, 0x1b, 0xd4, 0x62, 0xb4, 0x11, 0xeb, 0xdd, 0x8c, 0xbf, 0x80,
+	0xf5, 0xee, 0x2e, 0x91, 0xd3, 0x32, 0xde, 0xe7, 0x4a, 0x90, 0xa2, 0x2e, 0x69, 0x29, 0xe8, 0x06,
+	0x19, 0x13, 0xcd, 0xaa, 0x73, 0x2d, 0x3c, 0xde, 0xa0, 0x00, 0x0e, 0x85, 0x4f, 0xb9, 0x6f, 0x92,
+	0x71, 0x69, 0xd0, 0x6f, 0x94, 0xa1, 0x4f, 0x18, 0x5c, 0xff, 0x6a, 0x31, 0xf1, 0xdf, 0x29, 0x48,
+	0x82, 0xee, 0x0f, 0x39, 0x64, 0x8a, 0xff, 0x7f, 0x65, 0xb7, 0xc3, 0x43, 0x0c, 0x9b, 0x65, 0x78,
+	0xed, 0xaf, 0x5a, 0x7d, 0xce, 0xb9, 0xa8, 0xcc, 0xb0, 0xdb, 0x20, 0x47, 0xd7, 0xfb, 0x95, 0x49,
+	0x72, 0x72, 0x76, 0x6f, 0x7f, 0x07, 0xe7, 0x41, 0xfb, 0x3b, 0xe0, 0xad, 0x32, 0xd5, 0xae, 0x0a,
+	0x25, 0x6c, 0x33, 0x41, 0x55, 0x9b, 0xa1, 0xd1, 0x29, 0x81, 0xd1, 0x70, 0x13, 0x32, 0xb6, 0x45,
+	0xfd, 0x30, 0xdb, 0x2a, 0xc7, 0x62, 0x76, 0x85, 0xf5, 0x95, 0x8f, 0x17, 0xe4, 0xad, 0x20, 0x28,
+	0xb9, 0x77, 0xc8, 0xf8, 0x16, 0x5f, 0x8b, 0xe2, 0xa2, 0xb7, 0x7c, 0xd8, 0xc9, 0xb5, 0x16, 0xb8,
+	0x5e, 0x79, 0xa2, 0x01, 0x24, 0x39, 0xe6, 0x5b, 0x67, 0x78, 0xff, 0x70, 0x2e, 0x52, 0x5e, 0xa8,
+	0xe4, 0xe8, 0xae, 0x3f, 0x1f, 0x26, 0x93, 0x09, 0x6d, 0xc7, 0x51, 0x3b, 0x08, 0x69, 0x67, 0x56,
+	0x5a, 0xc3, 0x0e, 0x12, 0x21, 0xc7, 0x54, 0x49, 0x60, 0xf4, 0x01, 0x56, 0x8f, 0x6c, 0x93, 0xa9,
+	0xa8, 0x79, 0xfc, 0x20, 0x54, 0x58, 0x3d, 0x96, 0x4a, 0x8a, 0xd1, 0x67, 0x7d, 0xf2, 0x4d, 0x66,
+	0xb7, 0x41, 0x8e, 0xae, 0xfb, 0x0a, 0x21, 0xf1, 0x3a, 0x77, 0xa0, 0x9b, 0xcd, 0x5a, 0x8d, 0x03,
+	0xbf, 0xea, 0x14, 0x8f, 0xb4, 0x95, 0x3d, 0x80, 0xd1, 0x9b, 0x7b, 0x95, 0x10, 0xbe, 0x6d, 0xd0,
+	0x46, 0xd9, 0x6a, 0x5a, 0x21, 0x8e, 0x64, 0x55, 0x41, 0xde, 0xba, 0x3b, 0x3d, 0xa8, 0x70, 0x46,
+	0x00, 0x18, 0x8f, 0xbb, 0xdf, 0x45, 0xc6, 0xd3, 0x7e, 0xb7, 0xeb, 0x2b, 0x03, 0x49, 0x89, 0xb1,
+	0xbb, 0xbc, 0x5f, 0x83, 0x2b, 0xf2, 0x06, 0x90, 0x14, 0xdd, 0xd7, 0x91, 0xbf, 0x0b, 0xf6, 0xc4,
+	0x77, 0x11, 0xfb, 0x5f, 0xa8, 0x01, 0xdf, 0x27, 0xaf, 0x30, 0x50, 0x80, 0x83, 0xfe, 0x39, 0x76,
+	0xfb, 0x52, 0xdc, 0x16, 0x9a, 0xb4, 0xa2, 0x3e, 0xdd, 0x97, 0xc8, 0x84, 0x7e, 0x6d, 0x99, 0xdb,
+	0xe5, 0xdd, 0x3a, 0x89, 0x16, 0x6b, 0x1e, 0x3e, 0x67, 0xe6, 0xc3, 0xee, 0x32, 0x39, 0xd5, 0x8e,
+	0xa3, 0x2c, 0x89, 0xc3, 0x90, 0x27, 0x91, 0xe3, 0x17, 0x73, 0x6e, 0x40, 0x79, 0x42, 0x0c, 0xfb,
+	0xd4, 0xfc, 0x20, 0x0a, 0x14, 0x3d, 0x87, 0x02, 0x79, 0xfe, 0x70, 0x98, 0x2a, 0xc5, 0xb6, 0x6e,
+	0xf5, 0x29, 0x38, 0x94, 0xd2, 0x79, 0xef, 0x73, 0x4c, 0x44, 0xb6, 0x85, 0x55, 0x7c, 0xb1, 0xf7,
+	0x92, 0x49, 0x0c, 0x43, 0x48, 0x22, 0x3f, 0xbc, 0x01, 0x4b, 0xd2, 0x5a, 0xc1, 0x36, 0xe6, 0x45,
+	0xa3, 0x1d, 0x2c, 0x2c, 0x0c, 0x5b, 0x17, 0x2a, 0x32, 0x23, 0x6c, 0x9d, 0xab, 0xc8, 0xa4, 0x42,
+	0xcc, 0xfb, 0x74, 0xd5, 0x12, 0x58, 0x1f, 0x8a, 0x3d, 0x97, 0xe5, 0x47, 0x92, 0x89, 0xa4, 0x18,
+	0xa0, 0x55, 0x29, 0x9d, 0xb2, 0xca, 0x8f, 0x74, 0xdd, 0x24, 0x04, 0x36, 0x5d, 0x77, 0x9b, 0xd4,
+	0xb7, 0xe2, 0x34, 0x93, 0xd7, 0xb3, 0x43, 0xde, 0x04, 0xaf, 0xc4, 0x69, 0xc6, 0xa4, 0x2c, 0xf5,
+	0xda, 0xd8, 0x92, 0x02, 0xa7, 0x81, 0x17, 0xff, 0x74, 0xcb, 0x4f, 0x3a, 0xe9, 0x3c, 0x4b, 0x32,
+	0x51, 0x63, 0xe2, 0x95, 0x12, 0xa6, 0x57, 0x35, 0x08, 0x4c, 0x3c, 0xef, 0x8b, 0x8e, 0x65, 0xd2,
+	0xba, 0xc5, 0x22, 0x06, 0x76, 0x68, 0x84, 0x2c, 0xca, 0xf4, 0x51, 0xfc, 0xc6, 0x5c, 0xfc, 0xf5,
+	0xbb, 0x86, 0xe5, 0x7b, 0xbc, 0x8d, 0x3d, 0xcc, 0xb0, 0x2e, 0x0c, 0x77, 0xc6, 0x8f, 0x39, 0x76,
+	0x20, 0x7d, 0xa5, 0x8c, 0x7b, 0x9b, 0x31, 0xee, 0xfd, 0x63, 0xf2, 0xbd, 0x9f, 0x74, 0xc8, 0xf8,
+	0x9c, 0xdf, 0xde, 0x8e, 0x37, 0x36, 0xd0, 0x86, 0xd2, 0xe9, 0x27, 0x66, 0x4c, 0xbf, 0xd2, 0x54,
+	0x2d, 0x88, 0x76, 0x50, 0x18, 0xb8, 0xf4, 0x37, 0xfc, 0xb6, 0x4c, 0x29, 0x51, 0xe5, 0x4b, 0xff,
+	0x12, 0x6b, 0x01, 0x01, 0xc1, 0xe9, 0xef, 0xfa, 0x77, 0xe4, 0xc3, 0x79, 0x7b, 0xda, 0xb2, 0x06,
+	0x81, 0x89, 0xe7, 0xfd, 0x73, 0x87, 0xb4, 0xe6, 0xfc, 0x34, 0x68, 0x63, 0x0e, 0xcc, 0xb9, 0x20,
+	0x5b, 0xef, 0xb7, 0xb7, 0x69, 0xc6, 0x53, 0x8f, 0xe0, 0x28, 0xfb, 0x29, 0x4d, 0x8c, 0xeb, 0xb2,
+	0x1a, 0xe5, 0x0d, 0xd1, 0x0e, 0x0a, 0xc3, 0x7d, 0x93, 0x4c, 0xa0, 0x15, 0xea, 0x76, 0x9c, 0x74,
+	0x80, 0x6e, 0x94, 0x93, 0x9c, 0x68, 0x95, 0xb6, 0x13, 0x9a, 0x01, 0xdd, 0x10, 0xde, 0x29, 0xba,
+	0x7f, 0x30, 0x89, 0x79, 0x3f, 0xec, 0x90, 0xd3, 0x73, 0xd4, 0x4f, 0x68, 0xc2, 0x72, 0x19, 0xa9,
+	0x17, 0x71, 0xdf, 0x20, 0x8d, 0x0c, 0x5b, 0x70, 0x44, 0x4e, 0xb9, 0x23, 0x62, 0x7e, 0x25, 0x6b,
+	0xa2, 0x73, 0x50, 0x64, 0xbc, 0x4f, 0x3a, 0xe4, 0x6c, 0xd1, 0x58, 0xe6, 0xc3, 0xb8, 0xdf, 0x79,
+	0x18, 0x03, 0xfa, 0x9b, 0x0e, 0x99, 0x64, 0xb6, 0xfa, 0x05, 0x9a, 0xf9, 0x41, 0x38, 0x90, 0x47,
+	0xd1, 0x19, 0x31, 0x8f, 0xe2, 0x79, 0x52, 0xdb, 0x8a, 0xbb, 0x34, 0xef, 0x67, 0x72, 0x25, 0x46,
+	0xcd, 0x09, 0x42, 0x50, 0x8b, 0xd7, 0xf5, 0x83, 0x28, 0xf3, 0x71, 0x3b, 0x4a, 0x5b, 0xc6, 0x71,
+	0xbe, 0x00, 0x55, 0x33, 0x98, 0x38, 0xde, 0xef, 0x36, 0xc9, 0xb8, 0x70, 0x8a, 0x1a, 0x39, 0x15,
+	0x8e, 0x54, 0xe1, 0x54, 0x86, 0xaa, 0x70, 0x52, 0x32, 0xd6, 0x66, 0x09, 0x5d, 0x5b, 0xd5, 0x32,
+	0x14, 0x26, 0x62, 0x80, 0x3c, 0x47, 0xac, 0x1e, 0x16, 0xff, 0x0d, 0x82, 0x94, 0xfb, 0x13, 0x0e,
+	0x39, 0xde, 0x8e, 0xa3, 0x88, 0xb6, 0xb5, 0xec, 0x58, 0x2b, 0xc3, 0x59, 0x6a, 0xde, 0xee, 0x54,
+	0x9b, 0x81, 0x73, 0x00, 0xc8, 0x93, 0x77, 0xbf, 0x99, 0x1c, 0xe3, 0x73, 0x76, 0xd3, 0x32, 0xc0,
+	0xe8, 0xf4, 0x7a, 0x26, 0x10, 0x6c, 0x5c, 0xd4, 0x53, 0x47, 0x3a, 0x91, 0xdd, 0x98, 0xd6, 0x53,
+	0x1b, 0x29, 0xec, 0x0c, 0x0c, 0x4c, 0x62, 0x91, 0xd0, 0x8d, 0x84, 0xa6, 0x5b, 0xc2, 0x69, 0x8c,
+	0xc9, 0xad, 0xe3, 0xf7, 0x97, 0xc4, 0x02, 0x06, 0x7a, 0x82, 0x82, 0xde, 0xdd, 0x6d, 0xa1, 0x43,
+	0x68, 0x94, 0xc1, 0xcf, 0xc5, 0x67, 0x1e, 0xaa, 0x4a, 0x98, 0x26, 0x75, 0x76, 0x74, 0x31, 0x79,
+	0xb9, 0xca, 0x03, 0x27, 0xd9, 0xc1, 0x06, 0xbc, 0xdd, 0x5d, 0x20, 0x27, 0x72, 0xc9, 0x01, 0x53,
+	0x61, 0x28, 0x51, 0x41, 0x72, 0xb9, 0xb4, 0x82, 0x29, 0x0c, 0x3c, 0x61, 0xea, 0x97, 0x26, 0xf6,
+	0xd1, 0x2f, 0xed, 0x2a, 0xd7, 0x64, 0x6e, 0xc2, 0x78, 0xb9, 0x94, 0x09, 0x18, 0xc9, 0x0f, 0xf9,
+	0xc7, 0x72, 0x7e, 0xc8, 0xc7, 0xce, 0x57, 0x0f, 0xef, 0x69, 0x23, 0x07, 0x70, 0x70, 0xa7, 0xe3,
+	0x87, 0xe9, 0x44, 0xfc, 0xbf, 0x1c, 0x22, 0xbf, 0xeb, 0xbc, 0xdf, 0xde, 0xa2, 0xb8, 0x64, 0xd0,
+	0xe7, 0x4e, 0xa9, 0x26, 0xb8, 0x48, 0xe4, 0xb0, 0x55, 0xa3, 0x64, 0x67, 0xb0, 0xa0, 0x90, 0xc3,
+	0x46, 0x73, 0x1d, 0xce, 0x13, 0x7f, 0x94, 0x9f, 0xfb, 0x4a, 0xfd, 0x31, 0xbb, 0xb2, 0x28, 0x9e,
+	0xd2, 0x38, 0x6e, 0x4c, 0x4e, 0x86, 0x7e, 0x9a, 0xb1, 0x11, 0xa0, 0xa6, 0xe2, 0x3e, 0x53, 0xc8,
+	0xb0, 0x48, 0xac, 0xa5, 0x7c, 0x47, 0x30, 0xd8, 0xb7, 0xf7, 0x6f, 0xeb, 0xe4, 0x98, 0xc5, 0x19,
+	0x0f, 0x28, 0x30, 0x7c, 0x1d, 0x69, 0xc8, 0x33, 0x3c, 0x9f, 0x2b, 0x4b, 0x1d, 0xf4, 0x0a, 0x03,
+	0x0f, 0xad, 0x75, 0x7d, 0xaa, 0xe6, 0x05, 0x1c, 0xe3, 0xc0, 0x05, 0x13, 0x8f, 0x31, 0xe5, 0x2c,
+	0x4c, 0xe7, 0xc3, 0x80, 0x46, 0x19, 0x1f, 0x66, 0x39, 0x4c, 0x79, 0x6d, 0x69, 0xd5, 0xec, 0x54,
+	0x33, 0xe5, 0x1c, 0x00, 0xf2, 0xe4, 0xdd, 0xef, 0x77, 0xc8, 0x31, 0xff, 0x76, 0xaa, 0xb3, 0x8e,
+	0xb7, 0xea, 0x65, 0x1c, 0x52, 0x56, 0x22, 0x73, 0xae, 0xd5, 0xb7, 0x9a, 0xc0, 0x26, 0x8a, 0x51,
+	0x25, 0x2e, 0xbd, 0x43, 0xdb, 0xd2, 0x27, 0x5a, 0x8c, 0x65, 0xac, 0x8c, 0x1b, 0xfc, 0xc5, 0x81,
+	0x7e, 0x39, 0x57, 0x1f, 0x6c, 0x87, 0x82, 0x31, 0xb8, 0x2f, 0x11, 0xb7, 0x13, 0xa4, 0xfe, 0x7a,
+	0x88, 0x66, 0x6c, 0x19, 0x3d, 0x2c, 0x8c, 0xe9, 0xe7, 0xc4, 0x3c, 0xbb, 0x0b, 0x03, 0x18, 0x50,
+	0xf0, 0x14, 0x5b, 0x65, 0x49, 0x7c, 0x67, 0xf7, 0x46, 0x12, 0xb6, 0x1a, 0xb9, 0x55, 0x26, 0xda,
+	0x41, 0x61, 0x78, 0x7f, 0x5e, 0x55, 0x5b, 0x59, 0x07, 0x00, 0xf8, 0x86, 0x23, 0xb2, 0x73, 0xff,
+	0x8e, 0xc8, 0x8a, 0x6e, 0x41, 0x4c, 0xbc, 0x15, 0x42, 0x5b, 0x79, 0x48, 0x21, 0xb4, 0xdf, 0xeb,
+	0x58, 0xf9, 0xe8, 0x26, 0x9e, 0x7f, 0xa5, 0xdc, 0xe0, 0x83, 0x19, 0xee, 0xc2, 0x95, 0x3b, 0x57,
+	0x72, 0x9e, 0x7b, 0x5f, 0x47, 0x1a, 0x1b, 0xa1, 0xcf, 0xb2, 0xa8, 0xb4, 0x6a, 0xb6, 0x7b, 0xd9,
+	0x25, 0xd1, 0x0e, 0x0a, 0x03, 0xb9, 0xbe, 0xd1, 0xe9, 0x81, 0xb8, 0xf6, 0x7f, 0xa8, 0x92, 0x09,
+	0xe3, 0xc4, 0x2f, 0x14, 0xdf, 0x9c, 0x47, 0x4c, 0x7c, 0xab, 0x1c, 0x40, 0x7c, 0xfb, 0x1e, 0xd2,
+	0x6c, 0xcb, 0xd3, 0xa8, 0x9c, 0xfc, 0xfa, 0xf9, 0x33, 0x4e, 0x1f, 0x48, 0xaa, 0x09, 0x34, 0x4d,
+	0xf4, 0x88, 0x31, 0xba, 0xb1, 0xf4, 0x02, 0x45, 0x71, 0x94, 0xe2, 0x44, 0x1b, 0x7c, 0x26, 0xef,
+	0x1c, 0x50, 0xdf, 0xdf, 0x39, 0x00, 0xd3, 0x9d, 0xca, 0x8f, 0xfb, 0x00, 0xf2, 0xf1, 0xbc, 0x6e,
+	0xe7, 0xe3, 0xb9, 0x58, 0xca, 0x34, 0x0f, 0x49, 0xc4, 0x73, 0x8d, 0x8c, 0xa3, 0x83, 0x81, 0x1f,
+	0x75, 0xdc, 0xaf, 0x26, 0xe3, 0x6d, 0xfe, 0xaf, 0xd0, 0xa1, 0x31, 0x4b, 0xb5, 0x80, 0x82, 0x84,
+	0xa1, 0x07, 0x9c, 0x9f, 0x6c, 0x4a, 0xbd, 0x19, 0xf3, 0x80, 0x9b, 0x4d, 0x36, 0x53, 0x60, 0xad,
+	0xde, 0x3f, 0xaa, 0x11, 0xe6, 0x78, 0xe2, 0x27, 0xb4, 0xb3, 0x16, 0xb3, 0xb4, 0xb8, 0x47, 0x6a,
+	0xdf, 0xd5, 0x97, 0xba, 0x47, 0xd9, 0xc6, 0x6b, 0xd8, 0xf9, 0xaa, 0x0f, 0xda, 0xce, 0x57, 0x6c,
+	0xba, 0xad, 0x3d, 0x42, 0xa6, 0x5b, 0xef, 0x47, 0x1d, 0xe2, 0x2a, 0x37, 0x22, 0xed, 0x5b, 0x71,
+	0x81, 0x34, 0x95, 0xdf, 0x92, 0x10, 0x00, 0x35, 0x8b, 0x90, 0x00, 0xd0, 0x38, 0x23, 0xdc, 0xe4,
+	0x9f, 0x91, 0xfc, 0xbb, 0x6a, 0x07, 0x1f, 0x30, 0xae, 0x2f, 0xd8, 0xb9, 0xf7, 0x7b, 0x15, 0xf2,
+	0x18, 0x17, 0x1d, 0x96, 0xfd, 0xc8, 0xdf, 0xa4, 0x5d, 0x1c, 0xd5, 0xa8, 0xde, 0x32, 0x6d, 0xbc,
+	0x42, 0x06, 0x32, 0x54, 0xe0, 0xb0, 0x7b, 0x97, 0xef, 0x39, 0xbe, 0xcb, 0x16, 0xa3, 0x20, 0x03,
+	0xd6, 0xb9, 0x9b, 0x92, 0x86, 0x2c, 0x3e, 0xd3, 0xaa, 0x96, 0x49, 0x48, 0xb1, 0x25, 0x71, 0xca,
+	0x52, 0x50, 0x84, 0xf0, 0x28, 0x0d, 0xe3, 0xf6, 0x36, 0xd0, 0x5e, 0x9c, 0x3f, 0x4a, 0x97, 0x44,
+	0x3b, 0x28, 0x0c, 0xaf, 0x4b, 0x8e, 0xcb, 0x39, 0xec, 0x61, 0x3e, 0x5b, 0xba, 0x81, 0xe7, 0x4f,
+	0x5b, 0x36, 0x19, 0xf5, 0x70, 0xd4, 0xf9, 0x33, 0x6f, 0x02, 0xc1, 0xc6, 0x95, 0x99, 0x72, 0x2b,
+	0xc5, 0x99, 0x72, 0xbd, 0xdf, 0x73, 0x48, 0xfe, 0x00, 0x34, 0xf2, 0x82, 0x3a, 0x7b, 0xe6, 0x05,
+	0x3d, 0x40, 0x66, 0xcd, 0xef, 0x24, 0x13, 0x7e, 0x86, 0x12, 0x0e, 0xd7, 0x46, 0x54, 0xef, 0xcf,
+	0x8a, 0xb6, 0x1c, 0x77, 0x82, 0x8d, 0x00, 0x7b, 0x00, 0xb3, 0x3b, 0xef, 0x53, 0x0e, 0x69, 0x2e,
+	0x24, 0xbb, 0x07, 0x8f, 0xd9, 0x1a, 0x8c, 0xc8, 0xaa, 0x1c, 0x28, 0x22, 0x4b, 0xc6, 0x7c, 0x55,
+	0x87, 0xc5, 0x7c, 0x79, 0x7f, 0x59, 0x23, 0x27, 0x07, 0x82, 0x10, 0xdd, 0x17, 0xc9, 0xa4, 0xfa,
+	0x4a, 0x52, 0x05, 0xd9, 0x34, 0xbd, 0x78, 0x35, 0x0c, 0x2c, 0xcc, 0x11, 0xb6, 0xea, 0x22, 0x39,
+	0x95, 0xa0, 0x6a, 0xa6, 0x4f, 0x67, 0x37, 0x32, 0x9a, 0xac, 0x52, 0x34, 0xdc, 0xf2, 0xc4, 0xba,
+	0xd5, 0xb9, 0xc7, 0xd1, 0x9a, 0x05, 0x83, 0x60, 0x28, 0x7a, 0xc6, 0xed, 0x91, 0x63, 0xa1, 0x29,
+	0x3b, 0xb7, 0x6a, 0xf7, 0x2f, 0x76, 0xab, 0xd5, 0x6a, 0x35, 0x83, 0x4d, 0xc0, 0x16, 0xc0, 0xeb,
+	0x0f, 0x49, 0x00, 0xff, 0x3e, 0x2d, 0x80, 0x73, 0xa7, 0x98, 0x0f, 0x96, 0x1c, 0x84, 0x3a, 0x8a,
+	0x04, 0x7e, 0x18, 0x99, 0xfa, 0x65, 0xd2, 0x90, 0x0e, 0x83, 0x23, 0x39, 0xda, 0x99, 0xfd, 0x0c,
+	0xe1, 0xed, 0xcf, 0x92, 0x77, 0x5e, 0x4c, 0x12, 0x63, 0x32, 0xaf, 0xc5, 0xd9, 0x6c, 0x18, 0xc6,
+	0xb7, 0x51, 0x5c, 0xb9, 0x91, 0x52, 0xa1, 0x13, 0xf3, 0xde, 0xaa, 0x90, 0x82, 0xeb, 0x25, 0xee,
+	0x49, 0x2d, 0x23, 0x59, 0x7b, 0xf2, 0x60, 0x72, 0x92, 0x7b, 0x87, 0x3b, 0x55, 0x72, 0x69, 0xe0,
+	0x03, 0x65, 0x5f, 0x8f, 0xb5, 0x9f, 0xa5, 0xe2, 0x94, 0xca, 0xd7, 0xf2, 0x79, 0x42, 0xb4, 0x68,
+	0x2b, 0xe2, 0x9e, 0x94, 0xa3, 0x84, 0x96, 0x80, 0xc1, 0xc0, 0x42, 0x6d, 0x49, 0x10, 0xa5, 0x99,
+	0x1f, 0x86, 0x57, 0x82, 0x28, 0x13, 0x6a, 0x5f, 0x25, 0xf6, 0x2c, 0x6a, 0x10, 0x98, 0x78, 0xe7,
+	0xde, 0x67, 0x7c, 0xbf, 0x83, 0x7c, 0xf7, 0x2d, 0x72, 0xf6, 0x72, 0x90, 0xa9, 0x68, 0x3d, 0xb5,
+	0xde, 0x50, 0x72, 0x55, 0xbc, 0xca, 0x19, 0x1a, 0x9f, 0x6a, 0x44, 0xcb, 0x55, 0xec, 0xe0, 0xbe,
+	0x7c, 0xb4, 0x9c, 0xf7, 0x22, 0x39, 0x7d, 0x39, 0xc8, 0x30, 0x12, 0xe9, 0x80, 0x44, 0xbc, 0xdf,
+	0x19, 0x23, 0x93, 0x66, 0x64, 0xfa, 0x41, 0xd8, 0x35, 0x66, 0x43, 0x91, 0xb1, 0x98, 0x81, 0xb2,
+	0xe8, 0xde, 0x3a, 0x74, 0x98, 0x7c, 0xf1, 0x8c, 0x19, 0xf2, 0xa9, 0xa6, 0x09, 0xe6, 0x00, 0xdc,
+	0xdb, 0xa4, 0xbe, 0xc1, 0xa2, 0xb9, 0xaa, 0x65, 0xf8, 0xe2, 0x14, 0xcd, 0xa8, 0xde, 0x8e, 0x3c,
+	0x1e, 0x8c, 0xd3, 0x43, 0x99, 0x22, 0xb1, 0x83, 0x88, 0x0d, 0x1f, 0x7b, 0xde, 0x0e, 0x0a, 0x63,
+	0xd8, 0x91, 0x50, 0xbf, 0x8f, 0x23, 0xc1, 0x62, 0xd0, 0x63, 0x0f, 0x89, 0x41, 0xb3, 0xc8, 0xbc,
+	0x6c, 0x8b, 0x49, 0xbc, 0x22, 0x28, 0x68, 0x9c, 0x4d, 0x82, 0x11, 0x99, 0x67, 0x81, 0x21, 0x8f,
+	0xef, 0x7e, 0x54, 0xb1, 0xf8, 0x46, 0x19, 0x1a, 0x73, 0x73, 0x45, 0x1f, 0x35, 0x77, 0xff, 0xd1,
+	0x0a, 0x99, 0xba, 0x1c, 0xf5, 0x57, 0x2e, 0xaf, 0xf4, 0xd7, 0xc3, 0xa0, 0x7d, 0x95, 0xee, 0x22,
+	0x0b, 0xdf, 0xa6, 0xbb, 0x8b, 0x0b, 0x62, 0x07, 0xa9, 0x35, 0x73, 0x15, 0x1b, 0x81, 0xc3, 0x90,
+	0x19, 0x6d, 0x04, 0xd1, 0x26, 0x4d, 0x7a, 0x49, 0x20, 0x94, 0xd9, 0x06, 0x33, 0xba, 0xa4, 0x41,
+	0x60, 0xe2, 0x61, 0xdf, 0xf1, 0xed, 0x88, 0x26, 0x79, 0xd1, 0xff, 0x3a, 0x36, 0x02, 0x87, 0x21,
+	0x52, 0x96, 0xf4, 0x85, 0xae, 0xc8, 0x40, 0x5a, 0xc3, 0x46, 0xe0, 0x30, 0xdc, 0xe9, 0x69, 0x7f,
+	0x9d, 0xb9, 0x3a, 0xe5, 0x22, 0x90, 0x56, 0x79, 0x33, 0x48, 0x38, 0xa2, 0x6e, 0xd3, 0xdd, 0x05,
+	0x3f, 0xf3, 0xf3, 0x61, 0x9a, 0x57, 0x79, 0x33, 0x48, 0x38, 0x4b, 0xfd, 0x6b, 0x4f, 0xc7, 0x97,
+	0x5c, 0xea, 0x5f, 0x7b, 0xf8, 0x43, 0x34, 0x0e, 0x7f, 0xa3, 0x42, 0x26, 0x4d, 0x07, 0x45, 0x77,
+	0x33, 0x27, 0xa6, 0x5f, 0x1f, 0xc8, 0x1c, 0xff, 0xad, 0x45, 0x55, 0x55, 0x37, 0x83, 0x2c, 0xee,
+	0xa5, 0xcf, 0xd1, 0x68, 0x33, 0x88, 0x28, 0xf3, 0xd5, 0xe0, 0x8e, 0x8d, 0x96, 0xf7, 0xe3, 0x7c,
+	0xdc, 0xa1, 0xf7, 0x23, 0xe7, 0x3f, 0x8c, 0xca, 0x33, 0xb7, 0xc8, 0xc9, 0x81, 0x78, 0xe0, 0x11,
+	0xc4, 0x9e, 0x7d, 0xf3, 0x35, 0x78, 0x40, 0x26, 0xb0, 0x63, 0x99, 0xf2, 0x6e, 0x9e, 0x9c, 0xe4,
+	0x9b, 0x17, 0x29, 0xb1, 0xf0, 0x4e, 0x15, 0xe3, 0xcd, 0xac, 0x35, 0x37, 0xf3, 0x40, 0x18, 0xc4,
+	0xc7, 0xba, 0x26, 0xc7, 0xac, 0x10, 0xed, 0x92, 0x04, 0x34, 0xb6, 0xbb, 0x63, 0xe6, 0xa3, 0xcb,
+	0x62, 0x26, 0xaa, 0xec, 0x00, 0xd7, 0xbb, 0x5b, 0x83, 0xc0, 0xc4, 0xf3, 0x7e, 0xb2, 0x42, 0x1a,
+	0xd2, 0xa5, 0x68, 0x84, 0xa1, 0x7c, 0xc2, 0x21, 0xc7, 0x94, 0x85, 0x0c, 0x9f, 0x11, 0x1b, 0xe0,
+	0xda, 0xe1, 0x9d, 0x9a, 0x94, 0x52, 0x04, 0x55, 0x9a, 0xea, 0xb6, 0x00, 0x26, 0x31, 0xb0, 0x69,
+	0xbb, 0x37, 0xd1, 0xaf, 0x3f, 0xcd, 0x68, 0xd7, 0x50, 0xae, 0x7a, 0xc6, 0x2a, 0x9b, 0x69, 0xc7,
+	0x09, 0xc5, 0x35, 0x85, 0x8e, 0x58, 0xab, 0x0a, 0x53, 0x8b, 0x6d, 0xba, 0x0d, 0x8c, 0x9e, 0xbc,
+	0x5f, 0xab, 0x90, 0x13, 0xf9, 0x21, 0xb9, 0x1f, 0x44, 0xa7, 0x57, 0x5d, 0x2a, 0x2e, 0xe7, 0x10,
+	0x35, 0x09, 0x06, 0xec, 0xad, 0xbb, 0xd3, 0xd3, 0x83, 0x55, 0x81, 0x67, 0x4c, 0x14, 0xb0, 0x3a,
+	0xe3, 0x66, 0x4a, 0x61, 0x4f, 0x9f, 0xdb, 0x9d, 0xed, 0xf5, 0x84, 0xad, 0xd1, 0x30, 0x53, 0x9a,
+	0x50, 0xc8, 0x61, 0x63, 0x04, 0x99, 0xd1, 0x72, 0x8d, 0x06, 0x9b, 0x5b, 0xeb, 0x71, 0x22, 0x6f,
+	0x7d, 0x4f, 0x6a, 0xf7, 0xcb, 0x41, 0x1c, 0x28, 0x7c, 0x12, 0x25, 0x8c, 0xb6, 0xdf, 0xf3, 0xdb,
+	0x41, 0xb6, 0x2b, 0xb4, 0xc5, 0x8a, 0x1f, 0xce, 0x8b, 0x76, 0x50, 0x18, 0xde, 0x2f, 0xd5, 0xc8,
+	0x09, 0xee, 0x6f, 0x48, 0x95, 0x3b, 0xad, 0xfb, 0x41, 0xd2, 0x4c, 0x33, 0x3f, 0xe1, 0x57, 0x7e,
+	0xe7, 0xc0, 0x3c, 0x40, 0x07, 0x68, 0xcb, 0x4e, 0x40, 0xf7, 0x87, 0x6e, 0xb9, 0x1b, 0x41, 0x14,
+	0xa4, 0x5b, 0xac, 0xf7, 0xca, 0xfd, 0x29, 0x14, 0x2e, 0xa9, 0x1e, 0xc0, 0xe8, 0xcd, 0xfd, 0x16,
+	0x52, 0xef, 0x6d, 0xf9, 0xa9, 0xd4, 0x76, 0x3d, 0x2b, 0x37, 0xdc, 0x0a, 0x36, 0xa2, 0x63, 0x69,
+	0xfe, 0x55, 0x19, 0x00, 0xf8, 0x43, 0x26, 0xbb, 0xac, 0xed, 0x5f, 0x81, 0xa5, 0x93, 0xec, 0xae,
+	0x5e, 0x99, 0xcd, 0xd7, 0xec, 0x58, 0x60, 0xad, 0x20, 0xa0, 0xb8, 0xb9, 0xb7, 0x38, 0xc9, 0x0e,
+	0x22, 0x8f, 0xd9, 0x47, 0xf7, 0x15, 0x0d, 0x02, 0x13, 0x0f, 0x73, 0xa6, 0xe5, 0xbd, 0x51, 0xc7,
+	0x8f, 0x20, 0x54, 0x61, 0x54, 0x3f, 0xd4, 0x8b, 0xa4, 0xc9, 0xff, 0xa7, 0x6b, 0x31, 0xaa, 0x40,
+	0xb8, 0x32, 0x65, 0x2e, 0xf1, 0xa3, 0xf6, 0x56, 0x5e, 0x05, 0xb2, 0x66, 0xc0, 0xc0, 0xc2, 0xf4,
+	0x96, 0x49, 0x6d, 0x44, 0x6e, 0x35, 0xd2, 0xcd, 0xf6, 0x65, 0xd2, 0xc0, 0xee, 0xe4, 0xf5, 0xa5,
+	0x8c, 0x2e, 0x63, 0xd2, 0x90, 0xf5, 0xfc, 0x5c, 0x8f, 0x54, 0x03, 0x5f, 0x7a, 0x1d, 0xa8, 0x2d,
+	0xb4, 0x98, 0xa6, 0x7d, 0xb6, 0xec, 0x10, 0xe8, 0x3e, 0x43, 0xaa, 0xf4, 0x4e, 0x2f, 0xef, 0x5e,
+	0x70, 0xf1, 0x4e, 0x2f, 0x48, 0x68, 0x8a, 0x48, 0xf4, 0x4e, 0xcf, 0x3d, 0x47, 0x2a, 0x41, 0x47,
+	0xac, 0x48, 0x22, 0x70, 0x2a, 0x8b, 0x0b, 0x50, 0x09, 0x3a, 0xde, 0x1d, 0xd2, 0x94, 0x04, 0x99,
+	0xbf, 0x29, 0x97, 0x4d, 0x9c, 0x32, 0xfc, 0x4d, 0x65, 0xbf, 0x43, 0xa4, 0x92, 0x3e, 0x21, 0x3a,
+	0xf2, 0xbf, 0xac, 0xb3, 0xec, 0x3c, 0xa9, 0xb5, 0x63, 0x91, 0xb3, 0xa5, 0xa1, 0xbb, 0x61, 0x42,
+	0x09, 0x83, 0x78, 0xb7, 0xc8, 0xd4, 0xd5, 0x28, 0xbe, 0xcd, 0xea, 0xfc, 0xb0, 0xb4, 0xb6, 0xd8,
+	0xf1, 0x06, 0xfe, 0x93, 0x17, 0x81, 0x19, 0x14, 0x38, 0x4c, 0x25, 0xdc, 0xac, 0x0c, 0x4b, 0xb8,
+	0xe9, 0x7d, 0xcc, 0x21, 0x93, 0x2a, 0x84, 0xf8, 0xf2, 0xce, 0x36, 0xf6, 0xbb, 0x99, 0xc4, 0xfd,
+	0x5e, 0xbe, 0x5f, 0x56, 0xab, 0x14, 0x38, 0xcc, 0x8c, 0xad, 0xaf, 0xec, 0x13, 0x5b, 0x7f, 0x9e,
+	0xd4, 0xb6, 0x83, 0xa8, 0x93, 0x57, 0x19, 0x62, 0xd5, 0x53, 0x60, 0x10, 0x1c, 0xc2, 0x09, 0x35,
+	0x04, 0x29, 0x7c, 0xbc, 0x48, 0x26, 0xd7, 0xfb, 0x41, 0xd8, 0x11, 0xbf, 0xf3, 0xdb, 0x65, 0xce,
+	0x80, 0x81, 0x85, 0x89, 0x7a, 0x8b, 0xf5, 0x20, 0xf2, 0x93, 0xdd, 0x15, 0x2d, 0xed, 0xa8, 0x03,
+	0x70, 0x4e, 0x41, 0xc0, 0xc0, 0xf2, 0x7e, 0xbc, 0x4a, 0xa6, 0xec, 0x40, 0xea, 0x11, 0xd4, 0x07,
+	0xcf, 0x90, 0x3a, 0x8b, 0xad, 0xce, 0x7f, 0x5a, 0xf6, 0x3c, 0x70, 0x18, 0xba, 0x04, 0xf2, 0xcd,
+	0x5c, 0x4e, 0xbd, 0x47, 0x35, 0x48, 0xa5, 0x67, 0x64, 0x5e, 0xb9, 0x42, 0x6d, 0x2b, 0x48, 0xa1,
+	0xab, 0xc7, 0x78, 0xdc, 0x33, 0x13, 0x35, 0x7e, 0xa0, 0xcc, 0x20, 0x73, 0x11, 0xc9, 0x29, 0x6e,
+	0x7c, 0xea, 0xd3, 0xcb, 0xcf, 0x21, 0x49, 0x9f, 0xfb, 0x26, 0x32, 0x69, 0x62, 0xee, 0x77, 0xe9,
+	0x6b, 0x98, 0x97, 0xbe, 0x4f, 0x98, 0x8b, 0x42, 0x84, 0xd1, 0x8f, 0xb0, 0xdd, 0x6e, 0x90, 0x7a,
+	0x5b, 0xb9, 0x2e, 0xdd, 0x57, 0x96, 0x77, 0x95, 0x66, 0x0a, 0xbb, 0x01, 0xde, 0x1b, 0xda, 0x75,
+	0xa7, 0x8c, 0xd1, 0xa4, 0x8b, 0x1d, 0x37, 0x21, 0xd5, 0xcd, 0x9d, 0x6d, 0x71, 0xcc, 0xbf, 0x54,
+	0xd2, 0xf4, 0x5e, 0xde, 0xd9, 0xd6, 0x6b, 0xdc, 0x6c, 0x05, 0x24, 0x36, 0x82, 0x32, 0xdc, 0xca,
+	0xb6, 0x50, 0xdd, 0x3f, 0xdb, 0x82, 0xf7, 0xa9, 0x0a, 0x39, 0x39, 0xb0, 0xa8, 0xdc, 0x37, 0x49,
+	0x3d, 0xc1, 0xb7, 0x6c, 0x39, 0x65, 0x1c, 0x9f, 0xf6, 0xcc, 0xe9, 0xe3, 0xd3, 0x6e, 0x07, 0x4e,
+	0x12, 0xbd, 0x70, 0xb4, 0x83, 0x9d, 0xd2, 0xc4, 0xf3, 0x57, 0x56, 0x5e, 0x38, 0xb3, 0x03, 0x18,
+	0x50, 0xf0, 0x14, 0x5a, 0x92, 0x6c, 0x85, 0x7e, 0xd5, 0xb6, 0x24, 0xed, 0xa5, 0x9b, 0xf7, 0x7e,
+	0xab, 0x42, 0x8e, 0x59, 0x79, 0x33, 0xdd, 0x90, 0x34, 0x68, 0xc8, 0xcc, 0x7c, 0xf2, 0xb0, 0x39,
+	0x6c, 0x15, 0x0c, 0x75, 0x40, 0x5e, 0x14, 0xfd, 0x82, 0xa2, 0xf0, 0x68, 0x38, 0xe7, 0xbc, 0x48,
+	0x26, 0xe5, 0x80, 0x3e, 0xe0, 0x77, 0x43, 0x31, 0x81, 0x6a, 0x8d, 0x5e, 0x34, 0x60, 0x60, 0x61,
+	0x7a, 0xff, 0xac, 0x4a, 0x5a, 0xdc, 0x2e, 0xda, 0x51, 0x2b, 0x6f, 0x59, 0xea, 0x13, 0x7e, 0x44,
+	0x67, 0xb7, 0x75, 0xca, 0x28, 0xf5, 0x3c, 0x8c, 0xd0, 0x48, 0x3e, 0xa5, 0x3f, 0x9f, 0xf3, 0x29,
+	0xe5, 0x57, 0xbc, 0xcd, 0x23, 0x1a, 0xd1, 0x97, 0x96, 0x93, 0xe9, 0xdf, 0xaf, 0x90, 0xe3, 0xb9,
+	0x8a, 0x5e, 0x98, 0xe5, 0xcc, 0x2c, 0x02, 0xe1, 0x94, 0x61, 0x33, 0xda, 0xb3, 0xc8, 0xd3, 0xc1,
+	0x4a, 0x41, 0x3c, 0xa4, 0xad, 0xe2, 0x7d, 0xae, 0x42, 0xa6, 0xec, 0x52, 0x64, 0x8f, 0xe0, 0x4c,
+	0x7d, 0x2d, 0x69, 0xb2, 0x6a, 0x3b, 0xac, 0x82, 0x3e, 0x37, 0x39, 0xf1, 0xc2, 0x26, 0xb2, 0x11,
+	0x34, 0xfc, 0x91, 0xa8, 0xb0, 0xe1, 0xfd, 0x03, 0x87, 0x9c, 0xe1, 0x6f, 0x99, 0x5f, 0x87, 0x7f,
+	0xbd, 0x68, 0x76, 0x5f, 0x2d, 0x77, 0x80, 0xb9, 0xac, 0xcc, 0xfb, 0xcd, 0x2f, 0x2b, 0x78, 0x2d,
+	0x46, 0x6b, 0x2f, 0x85, 0x47, 0x70, 0xb0, 0x07, 0x5a, 0x0c, 0xde, 0xe7, 0xaa, 0x44, 0xd7, 0xf8,
+	0xc6, 0xec, 0xd4, 0x2c, 0xea, 0xbd, 0x94, 0xec, 0xd4, 0xe8, 0xdb, 0xad, 0xba, 0xe6, 0x26, 0x50,
+	0x23, 0xe8, 0xfd, 0x07, 0x1d, 0xb4, 0x2a, 0x06, 0x59, 0xe0, 0x33, 0x95, 0x4d, 0x39, 0x85, 0x7a,
+	0x15, 0xb9, 0x45, 0xde, 0x73, 0x9c, 0x98, 0x76, 0x4a, 0x45, 0x0c, 0x4c, 0xca, 0xee, 0x87, 0x45,
+	0xd8, 0x47, 0xb5, 0xb4, 0xd4, 0x11, 0x8d, 0x5c, 0xac, 0x47, 0x0f, 0x05, 0xaf, 0x2c, 0x29, 0x29,
+	0xe3, 0x0a, 0x60, 0x57, 0xaa, 0xd0, 0x81, 0x12, 0x6d, 0x59, 0x33, 0x70, 0x42, 0x5e, 0x4a, 0xdc,
+	0xc1, 0xb9, 0x38, 0xa0, 0x4b, 0x3d, 0x06, 0x0d, 0xf4, 0xb3, 0xb8, 0x8b, 0xd3, 0x24, 0x4c, 0xa9,
+	0x3a, 0x68, 0x40, 0x02, 0x40, 0xe3, 0x78, 0x3f, 0x5e, 0x27, 0xb9, 0x30, 0x74, 0xf7, 0x8e, 0x59,
+	0x9f, 0xde, 0x29, 0xb7, 0x3e, 0xbd, 0x1a, 0x4c, 0x51, 0x8d, 0x7a, 0x77, 0x53, 0x6a, 0xbf, 0xb8,
+	0x8c, 0xf9, 0x72, 0x5e, 0xfb, 0xf5, 0xed, 0xa3, 0x59, 0x15, 0x70, 0xad, 0x5e, 0xe0, 0x59, 0xc7,
+	0x66, 0xf6, 0x55, 0x94, 0xed, 0x57, 0xaa, 0xf8, 0xe3, 0xa2, 0xac, 0x10, 0xd0, 0xb4, 0x1f, 0x66,
+	0x62, 0x35, 0xbc, 0x5c, 0xe2, 0x2e, 0xe3, 0x1d, 0xeb, 0x5c, 0x2e, 0xfc, 0x37, 0x18, 0x44, 0x6d,
+	0x75, 0xe6, 0xd8, 0x91, 0xaa, 0x33, 0xc7, 0x4b, 0x55, 0x67, 0x3e, 0x4f, 0x08, 0x5b, 0xdb, 0xdc,
+	0xf5, 0xb7, 0xc1, 0xb4, 0x4c, 0x8a, 0x15, 0x82, 0x82, 0x80, 0x81, 0xe5, 0x7d, 0x3d, 0xb1, 0x93,
+	0x11, 0x61, 0xd4, 0x15, 0xcf, 0x7d, 0xc4, 0x2d, 0x1e, 0x2c, 0xea, 0xca, 0x4a, 0x53, 0xf4, 0x1b,
+	0x0e, 0x31, 0x33, 0x26, 0xb9, 0x6f, 0xf0, 0xd4, 0x4c, 0x4e, 0x19, 0x96, 0x71, 0xa3, 0xdf, 0x99,
+	0x65, 0xbf, 0x97, 0x73, 0xd1, 0x90, 0xf9, 0x99, 0xd0, 0x6f, 0x42, 0x42, 0x0f, 0x24, 0xd4, 0x7d,
+	0x94, 0x9c, 0x92, 0x11, 0xdc, 0x52, 0x47, 0x2f, 0xac, 0xaa, 0xfb, 0xab, 0x7e, 0xa4, 0x3e, 0xa7,
+	0x32, 0x4c, 0x9f, 0xa3, 0x6e, 0xa9, 0xd5, 0xa1, 0x49, 0x97, 0x7f, 0xd3, 0x21, 0xe7, 0xf3, 0x03,
+	0x48, 0x97, 0xe3, 0x28, 0xc0, 0x58, 0x7f, 0x9a, 0x65, 0x41, 0xb4, 0xc9, 0x32, 0x68, 0xde, 0xf6,
+	0x13, 0x59, 0x45, 0x85, 0x31, 0xca, 0x5b, 0x7e, 0x12, 0x01, 0x6b, 0xc5, 0x10, 0x34, 0xee, 0x1f,
+	0x2a, 0xa4, 0xf5, 0x43, 0xee, 0x8d, 0x82, 0xe9, 0xd0, 0xd7, 0x05, 0xee, 0x9b, 0x0a, 0x82, 0xa0,
+	0xf7, 0x79, 0x87, 0xb8, 0xd7, 0x77, 0x68, 0x92, 0x04, 0x1d, 0xc3, 0xa3, 0x95, 0x95, 0xe7, 0x33,
+	0xca, 0xf0, 0x99, 0xf9, 0x05, 0x72, 0xe5, 0xf9, 0x8c, 0x5f, 0xc5, 0xe5, 0xf9, 0x2a, 0x07, 0x2b,
+	0xcf, 0xe7, 0x5e, 0x27, 0x67, 0xba, 0xfc, 0xba, 0xc1, 0x4b, 0x5e, 0xf1, 0xbb, 0x87, 0x0a, 0x85,
+	0x3d, 0x8b, 0xf9, 0xe8, 0x96, 0x8b, 0x10, 0xa0, 0xf8, 0x39, 0xef, 0x7d, 0xc4, 0xe5, 0x8e, 0xac,
+	0xf3, 0x45, 0xbe, 0x78, 0x43, 0xd5, 0x2f, 0xde, 0xcf, 0xd5, 0xc9, 0xf1, 0x5c, 0x8e, 0x7d, 0xbc,
+	0xea, 0x0d, 0x3a, 0xff, 0x1d, 0xfa, 0xfc, 0x1e, 0x1c, 0xde, 0x48, 0xee, 0x84, 0x11, 0xa9, 0x07,
+	0x51, 0xaf, 0x9f, 0x95, 0x13, 0x89, 0xcf, 0x07, 0xb1, 0x88, 0x1d, 0x1a, 0xea, 0x62, 0xfc, 0x09,
+	0x9c, 0x4c, 0x99, 0xce, 0x89, 0x96, 0x30, 0x5e, 0x7b, 0x48, 0xea, 0x80, 0x8f, 0x6b, 0x57, 0xc1,
+	0x7a, 0x19, 0x8a, 0xc5, 0xdc, 0x62, 0x39, 0x6a, 0x57, 0x92, 0x4f, 0x57, 0xc8, 0x84, 0xf1, 0xd1,
+	0xdc, 0x5f, 0xb4, 0xf3, 0x09, 0x3a, 0xe5, 0xbd, 0x12, 0xeb, 0x7f, 0x46, 0x67, 0x0c, 0xe4, 0xaf,
+	0xf4, 0xec, 0x60, 0x2a, 0xc1, 0xb7, 0xee, 0x4e, 0x9f, 0xc8, 0x25, 0x0b, 0xb4, 0xd2, 0x0b, 0x9e,
+	0xfb, 0x6e, 0x72, 0x3c, 0xd7, 0x4d, 0xc1, 0x2b, 0xaf, 0x99, 0xaf, 0x7c, 0x68, 0xb5, 0x94, 0x39,
+	0x65, 0xbf, 0x8a, 0x53, 0x26, 0x02, 0x80, 0xe3, 0x90, 0x8e, 0xa0, 0x83, 0xcd, 0xc5, 0xf9, 0x57,
+	0x46, 0x8c, 0xf3, 0x7f, 0x37, 0x69, 0xf4, 0xe2, 0x30, 0x68, 0x07, 0x2a, 0x1d, 0x31, 0xcb, 0x2c,
+	0xb0, 0x22, 0xda, 0x40, 0x41, 0xdd, 0xdb, 0xa4, 0xf9, 0xfa, 0xed, 0x8c, 0x5b, 0x7f, 0x5a, 0xb5,
+	0x52, 0x8d, 0x3e, 0x4a, 0x68, 0x91, 0x2d, 0x29, 0x68, 0x5a, 0x98, 0x11, 0x83, 0x1d, 0x82, 0x32,
+	0x18, 0x88, 0xe9, 0xde, 0xd9, 0xe9, 0x98, 0x82, 0x80, 0x78, 0x5f, 0x24, 0xe4, 0x74, 0x51, 0xa1,
+	0x13, 0xf7, 0x23, 0x64, 0x8c, 0x8f, 0xb1, 0x9c, 0x5a, 0x5a, 0x45, 0x34, 0x2e, 0xb3, 0x0e, 0xc5,
+	0xb0, 0xd8, 0xff, 0x20, 0x68, 0x0a, 0xea, 0xa1, 0xbf, 0xde, 0xaa, 0x1c, 0x21, 0xf5, 0x25, 0x5f,
+	0x53, 0x5f, 0xf2, 0x39, 0xf5, 0xd0, 0x5f, 0x77, 0xef, 0x90, 0xfa, 0x66, 0x90, 0x51, 0x5f, 0x28,
+	0x11, 0x6e, 0x1d, 0x09, 0x71, 0xea, 0x73, 0x29, 0x8d, 0xfd, 0x0b, 0x9c, 0x20, 0x46, 0xb5, 0x1c,
+	0x5f, 0xb7, 0x13, 0x8c, 0x08, 0xe6, 0xe9, 0x97, 0x3f, 0x88, 0x5c, 0x26, 0x13, 0x5e, 0x9f, 0x32,
+	0xd7, 0x08, 0xf9, 0xe1, 0xa0, 0xfb, 0xf5, 0xf8, 0x46, 0x10, 0x1a, 0xd5, 0x02, 0x8e, 0xe0, 0xe3,
+	0x5c, 0x62, 0x04, 0xf4, 0x8d, 0x83, 0xff, 0x4e, 0x41, 0x52, 0x1e, 0x76, 0x52, 0x8d, 0x1d, 0xf6,
+	0xa4, 0x1a, 0x7f, 0x48, 0x27, 0xd5, 0x0f, 0x39, 0xa4, 0xa9, 0x66, 0x5a, 0x24, 0x6a, 0xf8, 0xe0,
+	0x11, 0x7e, 0x72, 0xae, 0x39, 0x51, 0x3f, 0x41, 0x13, 0xc7, 0x10, 0xcf, 0x09, 0xff, 0xcd, 0x7e,
+	0x42, 0x3b, 0x74, 0x27, 0xee, 0xa5, 0x22, 0x7d, 0xe2, 0xab, 0xe5, 0x0f, 0x66, 0x16, 0x89, 0x2c,
+	0xd0, 0x9d, 0xeb, 0xbd, 0x54, 0x04, 0x2a, 0xea, 0x06, 0x30, 0x87, 0x80, 0xa9, 0xf5, 0xe4, 0x39,
+	0x4e, 0xca, 0x48, 0xa2, 0x5b, 0x34, 0x9a, 0xa3, 0x3e, 0xcc, 0xef, 0x56, 0xc8, 0xf4, 0x3e, 0xb3,
+	0x80, 0xe6, 0x8b, 0x38, 0xd9, 0xf4, 0xa3, 0xe0, 0x4d, 0x33, 0xeb, 0x91, 0x92, 0x14, 0xaf, 0x1b,
+	0x30, 0xb0, 0x30, 0xcd, 0x74, 0x18, 0x95, 0x7d, 0xd2, 0x61, 0x9c, 0x27, 0xb5, 0x84, 0xf6, 0xe2,
+	0xfc, 0x85, 0x87, 0x05, 0x3a, 0x31, 0x08, 0x06, 0x25, 0xf9, 0xbd, 0x40, 0xb8, 0xc7, 0xa8, 0x7b,
+	0xdc, 0xec, 0xca, 0x22, 0x60, 0xbb, 0x95, 0x9d, 0xa7, 0xfe, 0x40, 0xb2, 0xf3, 0xe0, 0x51, 0x26,
+	0xec, 0x2f, 0x63, 0xfa, 0x28, 0xb3, 0xed, 0x22, 0xde, 0xa7, 0xaa, 0xe4, 0xa9, 0x3d, 0xd7, 0xbc,
+	0xf6, 0x95, 0x75, 0xf6, 0xf0, 0x95, 0x95, 0xd3, 0x53, 0xd9, 0x6f, 0x7a, 0xaa, 0x43, 0xa6, 0xe7,
+	0xfb, 0x70, 0x2b, 0xcb, 0x6c, 0x51, 0xe5, 0x94, 0x58, 0x1e, 0x96, 0x7c, 0x4a, 0xec, 0x62, 0x09,
+	0x05, 0x4d, 0x17, 0xef, 0x31, 0x56, 0x2a, 0x88, 0x7a, 0x19, 0x47, 0xd9, 0xd0, 0x8c, 0x4d, 0x7c,
+	0xff, 0x0e, 0xcb, 0x2f, 0xe1, 0xfd, 0x76, 0x8d, 0x3c, 0x33, 0xc2, 0x09, 0x64, 0xae, 0x62, 0x67,
+	0xc4, 0x55, 0xfc, 0x25, 0xfe, 0x99, 0x7e, 0xa0, 0xf0, 0x33, 0x41, 0xf9, 0x9f, 0x69, 0xef, 0x2f,
+	0x84, 0x1a, 0xd4, 0x20, 0x4a, 0x69, 0xbb, 0x9f, 0xf0, 0xb8, 0x01, 0x23, 0x0a, 0x72, 0x51, 0xb4,
+	0x83, 0xc2, 0xc0, 0x7b, 0x69, 0xdb, 0xc7, 0xed, 0x3f, 0x5e, 0x52, 0xe8, 0xbf, 0x19, 0x50, 0xc9,
+	0xc5, 0xa2, 0xf9, 0x59, 0xe4, 0x00, 0x9c, 0x8c, 0xf7, 0xd3, 0x0e, 0x39, 0x37, 0x5c, 0x4c, 0xc0,
+	0xd0, 0xf7, 0x75, 0xe6, 0x7c, 0xc6, 0x8a, 0xeb, 0xcb, 0xa5, 0xc3, 0xde, 0x57, 0x37, 0x83, 0x89,
+	0x83, 0x8a, 0x0c, 0xd3, 0x6b, 0x6d, 0xd9, 0xf0, 0x8c, 0x61, 0x8a, 0x8c, 0xb5, 0x3c, 0x10, 0x06,
+	0xf1, 0xbd, 0x2f, 0x54, 0x8b, 0x87, 0xc5, 0xc5, 0xc9, 0x83, 0xac, 0x66, 0xb1, 0x56, 0x2b, 0x23,
+	0x70, 0xdc, 0xea, 0x83, 0xe6, 0xb8, 0xb5, 0x61, 0x1c, 0x17, 0x33, 0x39, 0x19, 0xd5, 0x0f, 0x79,
+	0x32, 0x08, 0xee, 0x29, 0xa9, 0x32, 0x39, 0xad, 0xe4, 0xe0, 0x30, 0xf0, 0xc4, 0x23, 0xbe, 0xf4,
+	0x7e, 0xa9, 0x42, 0xce, 0x0e, 0x95, 0xe0, 0x1f, 0xd0, 0x89, 0x62, 0x7e, 0xfe, 0xda, 0x83, 0xf9,
+	0xfc, 0xe6, 0x47, 0xa9, 0xef, 0xf7, 0x51, 0xbc, 0x3f, 0xae, 0x0c, 0xdd, 0x08, 0x78, 0x9b, 0xfb,
+	0xb2, 0x9d, 0xa5, 0x6f, 0x26, 0xc7, 0xfc, 0x5e, 0x8f, 0xe3, 0x31, 0xaf, 0xf3, 0x5c, 0xe6, 0xb8,
+	0x59, 0x13, 0x08, 0x36, 0xee, 0x48, 0x32, 0xcd, 0x9f, 0x39, 0xa4, 0x09, 0x74, 0x83, 0x73, 0x23,
+	0xcc, 0xdd, 0xcd, 0xa6, 0xc8, 0x29, 0x23, 0x77, 0x37, 0x4e, 0x6c, 0x1a, 0xb0, 0x9c, 0xd6, 0x45,
+	0x93, 0x7d, 0xd8, 0xd8, 0x6b, 0x55, 0x0f, 0xb1, 0x3a, 0xbc, 0x1e, 0xa2, 0xf7, 0xdf, 0x1b, 0xf8,
+	0x7a, 0xbd, 0x18, 0x8b, 0xb2, 0xa5, 0xf8, 0x7d, 0xfb, 0x49, 0xd8, 0x72, 0xec, 0xef, 0x8b, 0x21,
+	0x86, 0xd8, 0x6e, 0x19, 0xf9, 0x2a, 0x07, 0xca, 0x9b, 0x55, 0xdd, 0x37, 0x6f, 0x16, 0xe6, 0x90,
+	0x49, 0xb7, 0x56, 0x92, 0x60, 0xc7, 0xcf, 0x50, 0x9b, 0xde, 0xaa, 0xd9, 0x1f, 0x72, 0x75, 0xf5,
+	0x8a, 0x06, 0x82, 0x8d, 0x8b, 0x29, 0x5c, 0x74, 0xf6, 0x2a, 0x9a, 0x64, 0x2c, 0x2e, 0x8a, 0xaf,
+	0x04, 0x95, 0x30, 0x42, 0xe7, 0xbb, 0x12, 0x08, 0x30, 0xf8, 0x0c, 0xf2, 0x53, 0xab, 0x11, 0x07,
+	0x32, 0x66, 0xf3, 0x53, 0xab, 0x1f, 0x1c, 0xcb, 0xc0, 0x13, 0x98, 0x33, 0x99, 0x2f, 0x8c, 0xd9,
+	0x5e, 0xcf, 0x78, 0xa3, 0x71, 0x3b, 0x67, 0xf2, 0xe5, 0x41, 0x14, 0x28, 0x7a, 0x0e, 0xf5, 0x63,
+	0xaa, 0x79, 0x71, 0x41, 0xd8, 0xa7, 0x94, 0x7e, 0x4c, 0x75, 0xb3, 0xd8, 0x01, 0x13, 0x0f, 0xeb,
+	0xf1, 0xe8, 0x9f, 0x3c, 0x78, 0x96, 0x1b, 0x6d, 0x17, 0x44, 0x62, 0x40, 0x55, 0x8f, 0xe7, 0x72,
+	0x21, 0x5a, 0x07, 0x86, 0x3d, 0xef, 0xae, 0x93, 0x73, 0x0a, 0x74, 0x31, 0xca, 0x58, 0x24, 0x5c,
+	0x4a, 0xe7, 0xfc, 0x94, 0x62, 0xfa, 0x2a, 0xc2, 0xde, 0x53, 0x15, 0x68, 0xbf, 0x1c, 0x64, 0x57,
+	0x8a, 0x30, 0x61, 0x09, 0xf6, 0xe8, 0x05, 0x6d, 0xc4, 0x34, 0xf2, 0xd7, 0x43, 0x7a, 0x7d, 0x7e,
+	0xb1, 0x35, 0x61, 0xdb, 0x88, 0x2f, 0x4a, 0x00, 0x68, 0x1c, 0xe5, 0xbb, 0x3c, 0x39, 0xcc, 0x77,
+	0x19, 0x83, 0x40, 0x36, 0xdb, 0x3d, 0x94, 0x08, 0x83, 0x36, 0x9d, 0x6d, 0x33, 0x57, 0x4d, 0xfc,
+	0x30, 0x3c, 0x99, 0xb5, 0x0a, 0x02, 0xb9, 0x3c, 0xbf, 0x32, 0x80, 0x03, 0x85, 0x4f, 0x32, 0x97,
+	0x5e, 0xcc, 0xc9, 0xd5, 0x3a, 0x95, 0x73, 0xe9, 0xc5, 0x46, 0xe0, 0x30, 0x74, 0x50, 0x64, 0x11,
+	0x45, 0x57, 0xb2, 0xac, 0xa7, 0x44, 0xd0, 0xd6, 0x69, 0x3b, 0x4d, 0xd8, 0xa5, 0x01, 0x0c, 0x28,
+	0x78, 0x0a, 0x25, 0x9a, 0x28, 0x66, 0xbd, 0xb7, 0x1e, 0xb7, 0x25, 0x9a, 0x6b, 0xbc, 0x19, 0x24,
+	0xdc, 0xfd, 0x4e, 0xd2, 0xea, 0xa7, 0x94, 0x5d, 0x6e, 0x6f, 0xc5, 0xc9, 0x76, 0x18, 0xfb, 0x9d,
+	0x45, 0x56, 0x78, 0x31, 0xdb, 0x6d, 0xb5, 0x18, 0xf1, 0xf3, 0xe2, 0xd9, 0xd6, 0x8d, 0x21, 0x78,
+	0x30, 0xb4, 0x87, 0x7c, 0x9e, 0xbb, 0xb3, 0xa3, 0xe5, 0xb9, 0xf3, 0xfe, 0xd4, 0x21, 0xc7, 0x14,
+	0xbf, 0x79, 0x00, 0x71, 0x88, 0xa1, 0x1d, 0x87, 0x78, 0xf9, 0xf0, 0x1c, 0x9b, 0x8d, 0x7c, 0x88,
+	0xb3, 0xff, 0xbf, 0x98, 0x24, 0x44, 0x73, 0x75, 0x75, 0xa0, 0x3a, 0x43, 0x0f, 0xd4, 0x47, 0x96,
+	0xa3, 0x16, 0x65, 0x19, 0xab, 0x3f, 0xdc, 0x2c, 0x63, 0xab, 0xe4, 0x8c, 0x14, 0x77, 0xb8, 0x15,
+	0x15, 0x23, 0xd0, 0x24, 0x83, 0x36, 0x0a, 0x69, 0x2d, 0x16, 0x21, 0x41, 0xf1, 0xb3, 0x96, 0x94,
+	0x35, 0xbe, 0xaf, 0xe8, 0xab, 0x78, 0xd2, 0xd2, 0x86, 0x2c, 0x73, 0x97, 0xe3, 0x49, 0x4b, 0x97,
+	0x56, 0x41, 0xe3, 0x14, 0x1f, 0x4c, 0xcd, 0x92, 0x0e, 0x26, 0x72, 0xe0, 0x83, 0x49, 0xb2, 0xc8,
+	0x89, 0xa1, 0x2c, 0x52, 0x5a, 0x6b, 0x26, 0x87, 0x5a, 0x6b, 0xde, 0x4f, 0xa6, 0x82, 0x68, 0x8b,
+	0x26, 0x41, 0x46, 0x3b, 0x6c, 0x2f, 0x30, 0xf6, 0xd9, 0xd0, 0x62, 0xc9, 0xa2, 0x05, 0x85, 0x1c,
+	0xb6, 0xcd, 0xd7, 0xa7, 0x46, 0xe0, 0xeb, 0x43, 0x4e, 0xd3, 0xe3, 0xe5, 0x9c, 0xa6, 0x27, 0x0e,
+	0x7f, 0x9a, 0x9e, 0x3c, 0xd2, 0xd3, 0xd4, 0x2d, 0xe5, 0x34, 0x1d, 0xe9, 0xa0, 0x32, 0xae, 0xcb,
+	0xa7, 0xf7, 0xb9, 0x2e, 0x0f, 0x3b, 0x4a, 0xcf, 0xdc, 0xf7, 0x51, 0x5a, 0x7c, 0x4a, 0x3e, 0xf6,
+	0x15, 0x79, 0x4a, 0xfe, 0x50, 0x85, 0x9c, 0xd1, 0xe7, 0x08, 0xee, 0xde, 0x60, 0x03, 0x39, 0x29,
+	0xab, 0xf4, 0xca, 0x2d, 0xb2, 0x46, 0x88, 0xad, 0x8e, 0xd6, 0x55, 0x10, 0x30, 0xb0, 0x58, 0xa4,
+	0x2a, 0x4d, 0x58, 0x99, 0x81, 0xfc, 0x21, 0x33, 0x2f, 0xda, 0x41, 0x61, 0xe0, 0x90, 0xf1, 0x7f,
+	0x91, 0x71, 0x20, 0x9f, 0xc0, 0x76, 0x5e, 0x83, 0xc0, 0xc4, 0x43, 0x6b, 0x6c, 0x5b, 0x32, 0x38,
+	0x3c, 0x68, 0x26, 0xf9, 0x95, 0x4d, 0xf1, 0x34, 0x05, 0x95, 0xc3, 0x61, 0x21, 0xc9, 0xf5, 0xc1,
+	0xe1, 0x60, 0x3b, 0x28, 0x0c, 0xef, 0x7f, 0x3a, 0xe4, 0x6c, 0xe1, 0x54, 0x3c, 0x00, 0xe1, 0xe1,
+	0x8e, 0x2d, 0x3c, 0xac, 0x96, 0x75, 0xdd, 0x33, 0xde, 0x62, 0x88, 0x20, 0xf1, 0xef, 0x1d, 0x32,
+	0xa5, 0xf1, 0x1f, 0xc0, 0xab, 0x06, 0xf6, 0xab, 0x96, 0x77, 0xb3, 0x6d, 0x0e, 0xbc, 0xdb, 0xdd,
+	0x31, 0xa2, 0x92, 0x4a, 0xcf, 0xb6, 0x65, 0xca, 0xfe, 0x7d, 0x7c, 0x04, 0x76, 0xc9, 0x18, 0x73,
+	0x71, 0x48, 0xcb, 0x71, 0xdf, 0xb2, 0xe9, 0x33, 0x77, 0x09, 0x6d, 0x71, 0x62, 0x3f, 0x53, 0x10,
+	0x04, 0x59, 0x11, 0x0c, 0x9e, 0xaf, 0xb7, 0x23, 0x02, 0x2e, 0x75, 0x11, 0x0c, 0xd1, 0x0e, 0x0a,
+	0x03, 0x8f, 0xb7, 0xa0, 0x1d, 0x47, 0xf3, 0xa1, 0x9f, 0xca, 0xe2, 0xef, 0xea, 0x78, 0x5b, 0x94,
+	0x00, 0xd0, 0x38, 0xcc, 0xfb, 0x21, 0x48, 0x7b, 0xa1, 0xbf, 0x6b, 0xe8, 0x2f, 0x8c, 0xcc, 0x3a,
+	0x0a, 0x04, 0x26, 0x1e, 0x32, 0x82, 0x0e, 0xed, 0x25, 0xb4, 0xcd, 0x7c, 0x68, 0xb9, 0x08, 0xa4,
+	0x18, 0xc1, 0x82, 0x82, 0x80, 0x81, 0xc5, 0xf2, 0x15, 0x8b, 0x5f, 0x41, 0x1c, 0x09, 0x1f, 0x52,
+	0x71, 0x2d, 0xd5, 0xf9, 0x8a, 0x07, 0x30, 0xa0, 0xe0, 0x29, 0x19, 0x50, 0x1f, 0x24, 0x98, 0x08,
+	0x3c, 0xda, 0x08, 0x92, 0x2e, 0x03, 0x0b, 0xa9, 0xc8, 0x0a, 0xa8, 0xcf, 0xe3, 0x40, 0xe1, 0x93,
+	0xee, 0x6f, 0x39, 0xe4, 0x4c, 0x18, 0xb7, 0xfd, 0x30, 0x78, 0x93, 0x76, 0x8c, 0xf7, 0x46, 0xfb,
+	0x67, 0x09, 0xf1, 0x35, 0xf6, 0x27, 0x9f, 0x59, 0x2a, 0xa2, 0xc4, 0x4d, 0x8f, 0xba, 0x24, 0x6b,
+	0x11, 0x0e, 0x14, 0x0f, 0x92, 0xa9, 0x6b, 0x82, 0x2e, 0x65, 0x45, 0x66, 0xb9, 0x29, 0x9c, 0xd8,
+	0x19, 0x0a, 0xd6, 0x2c, 0x28, 0xe4, 0xb0, 0xcf, 0x5d, 0x21, 0xe7, 0x86, 0x8f, 0xe9, 0x40, 0x76,
+	0xce, 0x4f, 0xd4, 0x48, 0xcb, 0x7e, 0xdb, 0x05, 0xba, 0xc1, 0xdc, 0xd2, 0x47, 0xda, 0x6a, 0xe8,
+	0x9c, 0xcd, 0x9e, 0x5a, 0xea, 0xfb, 0xad, 0x8a, 0xbd, 0x82, 0x67, 0x25, 0x00, 0x34, 0x0e, 0xda,
+	0x4c, 0x7b, 0x09, 0x55, 0xe5, 0xcf, 0xf2, 0x21, 0x5f, 0x2b, 0x06, 0x0c, 0x2c, 0x4c, 0xbc, 0xa2,
+	0xf4, 0xe2, 0x34, 0xd3, 0x8f, 0xe6, 0xae, 0x28, 0x2b, 0x26, 0x10, 0x6c, 0xdc, 0xa1, 0x2b, 0xb0,
+	0x7e, 0xdf, 0x2b, 0x30, 0xb7, 0x15, 0xc7, 0x46, 0xdc, 0x8a, 0x58, 0x6f, 0x21, 0xa3, 0x3d, 0x2c,
+	0xa3, 0xad, 0x3c, 0x7f, 0x57, 0xb1, 0x01, 0x78, 0xbb, 0x9e, 0xd1, 0xf9, 0x1b, 0x17, 0x5b, 0x8d,
+	0xa2, 0x19, 0x9d, 0xbf, 0x71, 0x11, 0x34, 0x0e, 0x1e, 0xa6, 0x72, 0x80, 0xad, 0xa6, 0x76, 0x6d,
+	0x92, 0xaf, 0x02, 0x0a, 0x8a, 0x9f, 0xf3, 0xf6, 0x16, 0x8d, 0x5a, 0xc4, 0xfe, 0x9c, 0xb7, 0xb6,
+	0x28, 0x3a, 0xbe, 0x6e, 0xd1, 0xc8, 0xfb, 0x91, 0x1a, 0x39, 0x55, 0xc0, 0xee, 0x4a, 0x0c, 0x45,
+	0xcf, 0xb4, 0x9c, 0x50, 0x74, 0xa5, 0xf8, 0x1a, 0x32, 0xde, 0xa1, 0x1b, 0xbe, 0x74, 0x4b, 0x37,
+	0x84, 0xb1, 0x05, 0xde, 0x0c, 0x12, 0xce, 0xb3, 0x76, 0xb1, 0x37, 0xeb, 0xe4, 0x75, 0xde, 0xe2,
+	0xdd, 0x3b, 0xea, 0xdd, 0x3b, 0xac, 0xb4, 0x03, 0xea, 0x3a, 0xd7, 0x43, 0x8a, 0xef, 0x2b, 0x5c,
+	0xce, 0x5f, 0x29, 0xfd, 0x64, 0xd0, 0x75, 0xff, 0x98, 0x45, 0xeb, 0xa6, 0x26, 0x09, 0x26, 0x7d,
+	0xb6, 0x7c, 0xf8, 0x8b, 0x5c, 0x4a, 0xe2, 0x6e, 0x6b, 0x3c, 0xb7, 0x7c, 0x34, 0x08, 0x4c, 0x3c,
+	0xb4, 0x9d, 0xa5, 0xfd, 0xcd, 0x4d, 0x9a, 0xca, 0xea, 0xe9, 0x2a, 0x6d, 0xf4, 0xaa, 0x6e, 0x06,
+	0x13, 0xc7, 0xfd, 0x46, 0x72, 0xcc, 0xe7, 0xb9, 0x05, 0x6f, 0x72, 0x9f, 0x0c, 0xbe, 0x48, 0x78,
+	0x32, 0x7d, 0x13, 0x00, 0x36, 0x9e, 0xb7, 0x4d, 0x9e, 0xdc, 0xeb, 0x05, 0x79, 0x88, 0x7a, 0xe2,
+	0x77, 0xf3, 0x76, 0x02, 0x86, 0x06, 0x1c, 0x86, 0x49, 0x39, 0xe8, 0x1b, 0x7d, 0x3f, 0x4c, 0xc5,
+	0xc2, 0x50, 0x07, 0xe7, 0x45, 0xd6, 0x0a, 0x02, 0x8a, 0xf1, 0xb0, 0xc7, 0x6d, 0x6a, 0x29, 0x0b,
+	0xd6, 0xe5, 0x2c, 0x29, 0x48, 0xdb, 0xf1, 0x0e, 0x4d, 0x76, 0x91, 0xcb, 0x38, 0xb9, 0x60, 0xdd,
+	0x01, 0x0c, 0x28, 0x78, 0x8a, 0x7d, 0xff, 0x8e, 0xe2, 0x6c, 0x52, 0x32, 0xb8, 0x59, 0xe6, 0xf7,
+	0xd7, 0x8c, 0xd3, 0xfa, 0x90, 0x92, 0x24, 0x98, 0xf4, 0xf1, 0xa2, 0xca, 0xa2, 0x9f, 0x30, 0xd7,
+	0x40, 0x16, 0x44, 0xe2, 0x95, 0x85, 0xcc, 0xa0, 0x2e, 0xaa, 0xcb, 0x83, 0x28, 0x50, 0xf4, 0x9c,
+	0xf7, 0xf9, 0x1a, 0x51, 0x49, 0x73, 0x98, 0xc3, 0x78, 0x49, 0xee, 0xf6, 0x07, 0x0d, 0xf9, 0x56,
+	0x9c, 0xa2, 0xb6, 0x97, 0x07, 0x27, 0x37, 0x3e, 0x98, 0x16, 0x48, 0x35, 0x61, 0x6b, 0x1a, 0x04,
+	0x26, 0x1e, 0x8e, 0x24, 0x0c, 0x76, 0x28, 0x7f, 0x68, 0xcc, 0x1e, 0xc9, 0x92, 0x04, 0x80, 0xc6,
+	0xc1, 0x91, 0x74, 0x82, 0x8d, 0x8d, 0xd6, 0xb8, 0x3d, 0x12, 0x9c, 0x1d, 0x60, 0x10, 0x5e, 0xfc,
+	0x29, 0xde, 0x16, 0x62, 0x88, 0x51, 0xfc, 0x29, 0xde, 0x06, 0x06, 0xc1, 0xaf, 0x14, 0xc5, 0x49,
+	0x97, 0x1f, 0xb4, 0x8a, 0x8a, 0x50, 0xca, 0xa8, 0xaf, 0x74, 0x6d, 0x10, 0x05, 0x8a, 0x9e, 0xc3,
+	0x05, 0xdd, 0x4b, 0x68, 0x27, 0x68, 0x67, 0x66, 0x6f, 0xc4, 0x5e, 0xd0, 0x2b, 0x03, 0x18, 0x50,
+	0xf0, 0x14, 0xa6, 0xed, 0x93, 0x49, 0x8f, 0x64, 0x1a, 0xcd, 0x09, 0x3b, 0x6d, 0x1f, 0xd8, 0x60,
+	0xc8, 0xe3, 0x23, 0x07, 0xed, 0x8a, 0x24, 0xc0, 0xad, 0x49, 0x9b, 0x83, 0xca, 0xe4, 0xc0, 0xa0,
+	0x30, 0xbc, 0x8f, 0x57, 0xf1, 0x72, 0x35, 0x24, 0xd7, 0xf6, 0x03, 0x0b, 0xef, 0xb0, 0x57, 0x64,
+	0x6d, 0x84, 0x15, 0x89, 0xa1, 0x13, 0x69, 0x1c, 0xa9, 0xd0, 0x89, 0xfa, 0xd0, 0xd0, 0x09, 0x03,
+	0xab, 0x38, 0x74, 0x62, 0xac, 0xac, 0xd0, 0x89, 0xf1, 0xfb, 0x0c, 0x9d, 0xf8, 0x83, 0x3a, 0x51,
+	0xd5, 0x3d, 0xaf, 0xd1, 0xec, 0x76, 0x9c, 0x6c, 0x07, 0xd1, 0x26, 0x4b, 0xe0, 0xf3, 0x0b, 0x8e,
+	0xcc, 0x01, 0xb4, 0x64, 0x86, 0xbe, 0x6f, 0x94, 0x54, 0xa1, 0xd1, 0x22, 0x36, 0xb3, 0x66, 0x10,
+	0xe2, 0x72, 0x70, 0x2e, 0xd7, 0x10, 0x07, 0x81, 0x35, 0x22, 0xf7, 0xbb, 0x09, 0x91, 0x66, 0xc7,
+	0x0d, 0xc9, 0x81, 0x17, 0xcb, 0x19, 0x1f, 0x9a, 0x7d, 0xd5, 0x8d, 0x66, 0x4d, 0x11, 0x01, 0x83,
+	0x20, 0x3a, 0x6d, 0x4a, 0x13, 0x2e, 0x8f, 0xb1, 0xfc, 0xf0, 0x91, 0xcc, 0xcd, 0x28, 0x49, 0x01,
+	0x80, 0x8c, 0x07, 0xd1, 0x26, 0xae, 0x13, 0xe1, 0x62, 0xfe, 0xae, 0xa2, 0x44, 0x6b, 0x4b, 0xb1,
+	0xdf, 0x99, 0xf3, 0x43, 0x3f, 0x6a, 0x63, 0x39, 0x0f, 0x86, 0xae, 0xe5, 0x21, 0xd1, 0x00, 0xb2,
+	0xa3, 0x81, 0x12, 0xa4, 0xf5, 0x51, 0x4a, 0x90, 0x9e, 0xfb, 0x36, 0x72, 0x72, 0xe0, 0x63, 0x1e,
+	0x28, 0x07, 0xc0, 0xfd, 0xa7, 0x0f, 0xf0, 0x7e, 0x7b, 0x4c, 0x1f, 0x5a, 0x98, 0x54, 0x8e, 0x55,
+	0xb4, 0x4c, 0xf4, 0x17, 0x15, 0xaa, 0x8b, 0x12, 0x97, 0x88, 0x3a, 0x66, 0x8c, 0x46, 0x30, 0x49,
+	0xe2, 0x1a, 0xed, 0xf9, 0x09, 0x8d, 0x8e, 0x7a, 0x8d, 0xae, 0x28, 0x22, 0x60, 0x10, 0x74, 0xb7,
+	0xac, 0x20, 0xe0, 0x4b, 0x87, 0x0f, 0x02, 0x66, 0x69, 0x6f, 0x8b, 0x0a, 0xbf, 0xfd, 0x84, 0x43,
+	0xa6, 0x22, 0x6b, 0xe5, 0x96, 0x13, 0xf7, 0x53, 0xbc, 0x2b, 0x78, 0x71, 0x68, 0xbb, 0x0d, 0x72,
+	0xf4, 0x8b, 0x8e, 0xb4, 0xfa, 0x01, 0x8f, 0x34, 0x5d, 0x51, 0x77, 0x6c, 0x58, 0x45, 0x5d, 0x37,
+	0x52, 0x75, 0xce, 0xc7, 0x4b, 0xaf, 0x73, 0x4e, 0x0a, 0x6a, 0x9c, 0xdf, 0x22, 0xcd, 0x76, 0x42,
+	0xfd, 0xec, 0x3e, 0x4b, 0x5e, 0x33, 0x6f, 0xc4, 0x79, 0xd9, 0x01, 0xe8, 0xbe, 0xbc, 0xff, 0x53,
+	0x23, 0x27, 0xe4, 0x8c, 0xc8, 0x98, 0x41, 0x3c, 0x1f, 0x39, 0x5d, 0x2d, 0x2b, 0xab, 0xf3, 0xf1,
+	0x8a, 0x04, 0x80, 0xc6, 0x41, 0x79, 0xac, 0x9f, 0x62, 0xf6, 0xbd, 0x68, 0x29, 0x58, 0x4f, 0xc5,
+	0x55, 0x4a, 0x6d, 0x94, 0x1b, 0x1a, 0x04, 0x26, 0x1e, 0xde, 0xd4, 0x7c, 0x43, 0x68, 0x35, 0x6e,
+	0x6a, 0x52, 0x50, 0x95, 0x70, 0xf7, 0x67, 0x0b, 0x8b, 0x7f, 0x94, 0x13, 0x69, 0x3f, 0x10, 0x2a,
+	0x79, 0xb0, 0xaa, 0x1f, 0xee, 0xdf, 0x75, 0xc8, 0x19, 0xde, 0x2a, 0x67, 0xf2, 0x46, 0xaf, 0xe3,
+	0x67, 0x34, 0x6d, 0x8d, 0x1d, 0xd1, 0xf8, 0xb4, 0xed, 0xb1, 0x88, 0x2c, 0x14, 0x8f, 0x06, 0x93,
+	0x7d, 0x1c, 0xdf, 0xb6, 0x92, 0xb4, 0xc9, 0xa3, 0xe3, 0xb0, 0xf9, 0x93, 0xac, 0x4e, 0xf5, 0x56,
+	0xb3, 0xdb, 0x53, 0xc8, 0x53, 0xf7, 0xfe, 0x87, 0x43, 0x4c, 0x36, 0xfa, 0xe0, 0x73, 0xbb, 0x1d,
+	0x5c, 0x14, 0x94, 0xd2, 0x65, 0x7d, 0xa8, 0x74, 0x89, 0x4e, 0x4d, 0x41, 0xa7, 0x35, 0x96, 0x73,
+	0x6a, 0x5a, 0x5c, 0x00, 0x6c, 0xf7, 0xfe, 0x69, 0x5d, 0xab, 0xa3, 0x45, 0x20, 0xfb, 0x97, 0xc5,
+	0x6b, 0x6f, 0xa8, 0xec, 0xc7, 0xfc, 0xcd, 0xaf, 0x0d, 0x64, 0x3f, 0xfe, 0x96, 0x83, 0xe7, 0x29,
+	0xe0, 0x13, 0x34, 0x2c, 0xf9, 0xf1, 0xf8, 0x3e, 0x49, 0x0a, 0x5e, 0x27, 0x0d, 0xbc, 0x82, 0x31,
+	0xbb, 0x52, 0xc3, 0x1a, 0x54, 0xe3, 0x8a, 0x68, 0x7f, 0xeb, 0xee, 0xf4, 0x37, 0x1d, 0x7c, 0x58,
+	0xf2, 0x69, 0x50, 0xfd, 0xbb, 0x29, 0x69, 0xe2, 0xff, 0x2c, 0x9f, 0x82, 0xb8, 0xdc, 0xdd, 0x50,
+	0x3c, 0x53, 0x02, 0x4a, 0x49, 0xd6, 0xa0, 0xe9, 0xb8, 0x11, 0x69, 0x22, 0x22, 0x27, 0xca, 0xef,
+	0x80, 0x2b, 0x92, 0xe8, 0xaa, 0x04, 0xbc, 0x75, 0x77, 0xfa, 0x9b, 0x0f, 0x4e, 0x54, 0x3d, 0x0e,
+	0x9a, 0x84, 0xf7, 0x7f, 0x6b, 0x7a, 0xed, 0xf2, 0xcf, 0xfa, 0xe5, 0xb1, 0x76, 0x5f, 0xcc, 0xad,
+	0xdd, 0xf3, 0x03, 0x6b, 0x77, 0x0a, 0xe7, 0xa3, 0x20, 0x15, 0xf7, 0x83, 0x16, 0x04, 0xf6, 0xd7,
+	0x37, 0x30, 0x09, 0x88, 0x6b, 0x6b, 0x57, 0x92, 0x7e, 0x84, 0xb9, 0xa7, 0x9b, 0x0c, 0xd9, 0x90,
+	0x80, 0x2c, 0x30, 0xe4, 0xf1, 0xf1, 0x52, 0x8f, 0xdf, 0xfc, 0x96, 0xbf, 0x43, 0x85, 0x51, 0x41,
+	0xd7, 0x68, 0x14, 0xed, 0xa0, 0x30, 0xdc, 0x2d, 0xf2, 0xa4, 0xec, 0x60, 0x81, 0x86, 0x94, 0xe9,
+	0x94, 0x4d, 0xfd, 0x38, 0xf7, 0xa5, 0x7b, 0xa7, 0xe8, 0xe1, 0x49, 0xd8, 0x03, 0x17, 0xf6, 0xec,
+	0xc9, 0xfb, 0x55, 0xe6, 0xcc, 0x65, 0xa4, 0x8c, 0xc1, 0xd5, 0x17, 0x06, 0xdd, 0x40, 0xa6, 0x73,
+	0x55, 0xab, 0x6f, 0x09, 0x1b, 0x81, 0xc3, 0xdc, 0xdb, 0x64, 0x7c, 0x9d, 0x17, 0x98, 0x2f, 0xa7,
+	0x98, 0x95, 0xa8, 0x56, 0xcf, 0x72, 0xa2, 0xcb, 0xd2, 0xf5, 0x6f, 0xe9, 0x7f, 0x41, 0x52, 0xf3,
+	0x3e, 0x5b, 0x47, 0x85, 0x24, 0x77, 0x8f, 0xbd, 0x12, 0xa4, 0xcc, 0x47, 0xcb, 0x2c, 0x14, 0x51,
+	0xd9, 0xb7, 0x50, 0xc4, 0x87, 0x98, 0xd5, 0x2d, 0x8c, 0x77, 0x99, 0xe0, 0x57, 0x3b, 0xb0, 0xe0,
+	0x67, 0x5a, 0xe8, 0x44, 0x2f, 0x60, 0xf4, 0x28, 0x72, 0xd8, 0xf2, 0xba, 0x13, 0xb9, 0x1c, 0xb6,
+	0x46, 0xc9, 0xbb, 0xb1, 0x07, 0x5b, 0xf2, 0x2e, 0x20, 0xc7, 0xf9, 0x10, 0x55, 0x62, 0x96, 0xfb,
+	0xc8, 0xbf, 0xc2, 0x42, 0x5b, 0x17, 0xec, 0x6e, 0x20, 0xdf, 0xaf, 0x59, 0xcf, 0xae, 0xf1, 0xa0,
+	0xeb, 0xd9, 0x7d, 0x2d, 0x69, 0xca, 0xef, 0x2c, 0x15, 0xea, 0x4c, 0x4e, 0x97, 0xcb, 0x20, 0x05,
+	0x0d, 0x1f, 0xc8, 0x31, 0x45, 0x1e, 0x56, 0x8e, 0x29, 0xef, 0x93, 0x15, 0xbc, 0x31, 0xf0, 0x71,
+	0xa9, 0x74, 0x89, 0xcf, 0x92, 0x31, 0xbf, 0x9f, 0x6d, 0xc5, 0x03, 0x25, 0xea, 0x67, 0x59, 0x2b,
+	0x08, 0xa8, 0xbb, 0x44, 0x6a, 0x1d, 0x9d, 0x02, 0xef, 0x20, 0xdf, 0x53, 0x2b, 0x5f, 0xfd, 0x8c,
+	0x02, 0xeb, 0x05, 0x33, 0xb0, 0x64, 0xfe, 0xa6, 0x8c, 0xc6, 0x67, 0x19, 0x58, 0xd6, 0x7c, 0xac,
+	0x4c, 0x84, 0xad, 0x07, 0x49, 0xfb, 0x8d, 0xae, 0x8b, 0xc1, 0x66, 0xe4, 0x67, 0xe8, 0xaf, 0xa7,
+	0xfd, 0x44, 0xb4, 0xeb, 0xa2, 0x09, 0x04, 0x1b, 0xd7, 0xfb, 0x9d, 0x49, 0x72, 0x7a, 0x75, 0x7e,
+	0x59, 0x16, 0x2e, 0x3a, 0xb2, 0x80, 0xfa, 0x22, 0x1a, 0x0f, 0x2e, 0xa0, 0x7e, 0x08, 0xf5, 0xd0,
+	0x08, 0xa8, 0x0f, 0x8d, 0x80, 0x7a, 0x3b, 0xba, 0xb9, 0x5a, 0x46, 0x74, 0x73, 0xd1, 0x08, 0x46,
+	0x89, 0x6e, 0x3e, 0xb2, 0x08, 0xfb, 0x3d, 0x07, 0x74, 0xa0, 0x08, 0x7b, 0x95, 0x7e, 0xa0, 0x94,
+	0x98, 0xcd, 0x21, 0x9f, 0xaa, 0x30, 0xfd, 0x80, 0x0a, 0xfd, 0xe6, 0xf1, 0xc8, 0xad, 0xb1, 0x32,
+	0x42, 0xbf, 0x8b, 0x06, 0x30, 0x42, 0xe8, 0x37, 0xff, 0x61, 0xa5, 0x1b, 0x18, 0x2f, 0x23, 0xdd,
+	0x40, 0xd1, 0x70, 0xf6, 0x4d, 0x37, 0x80, 0x35, 0x1e, 0xc3, 0x38, 0xc2, 0x3a, 0x6a, 0x59, 0xdc,
+	0x8e, 0x65, 0x91, 0x6c, 0x5d, 0xe3, 0xd1, 0x04, 0x82, 0x8d, 0x3b, 0x2c, 0x57, 0x41, 0xf3, 0xb0,
+	0xb9, 0x0a, 0xc8, 0x43, 0xca, 0x55, 0x60, 0x44, 0xe3, 0x4f, 0x94, 0x11, 0x8d, 0x5f, 0xf4, 0x45,
+	0x46, 0xaa, 0x82, 0xfd, 0x29, 0x5e, 0x23, 0x1e, 0x45, 0x70, 0xac, 0x53, 0x17, 0x64, 0xcc, 0xe8,
+	0x34, 0xf1, 0xfc, 0x6b, 0x47, 0xb0, 0x60, 0x6f, 0xad, 0x6a, 0x32, 0xaa, 0x6e, 0xbc, 0x6e, 0x02,
+	0x7b, 0x20, 0x87, 0x49, 0x14, 0xf0, 0x73, 0x15, 0xf2, 0x55, 0xfb, 0x0e, 0xc1, 0xbd, 0x8d, 0xa6,
+	0x8f, 0x4d, 0xb1, 0x50, 0x5b, 0x4e, 0x19, 0xf1, 0x05, 0x6b, 0xb2, 0x3f, 0x9e, 0xae, 0x4e, 0xfd,
+	0x64, 0x46, 0x0f, 0xf9, 0x3f, 0x0b, 0x2b, 0x88, 0xc3, 0x81, 0xac, 0xde, 0x10, 0x87, 0x14, 0x18,
+	0x04, 0x8f, 0xff, 0x84, 0x6e, 0x6a, 0x5f, 0x1c, 0xf5, 0xf9, 0x80, 0xb5, 0x82, 0x80, 0xa2, 0x9e,
+	0xd0, 0x0f, 0x43, 0x1e, 0x50, 0x4b, 0x53, 0x51, 0x7c, 0x55, 0xa7, 0x17, 0xd6, 0x20, 0x30, 0xf1,
+	0xbc, 0xbf, 0xa8, 0x90, 0xe9, 0x7d, 0x78, 0xca, 0x40, 0x22, 0x85, 0xfa, 0xc8, 0x89, 0x14, 0x44,
+	0x90, 0xe1, 0xd8, 0x90, 0x20, 0x43, 0xb4, 0x35, 0x53, 0x2c, 0x53, 0xc6, 0x1d, 0x95, 0x73, 0x5e,
+	0x16, 0x6b, 0x1a, 0x04, 0x26, 0x1e, 0x72, 0xb1, 0x29, 0xbf, 0xdd, 0xa6, 0x69, 0x2a, 0xa3, 0x08,
+	0x85, 0xde, 0xb6, 0xb4, 0x10, 0x45, 0xa6, 0x0e, 0x9f, 0xb5, 0x48, 0x40, 0x8e, 0x64, 0x7e, 0xc2,
+	0x9b, 0x23, 0x4e, 0xf8, 0x2f, 0x57, 0xc8, 0x53, 0x7b, 0x9e, 0x6e, 0x23, 0x07, 0x78, 0x62, 0x2c,
+	0x49, 0x7e, 0xe1, 0x60, 0xa4, 0x09, 0x30, 0x08, 0x9f, 0xa5, 0x5e, 0x4f, 0x45, 0x93, 0x94, 0x1f,
+	0xed, 0xcc, 0x67, 0xc9, 0x22, 0x01, 0x39, 0x92, 0xf7, 0xbb, 0x2c, 0x3f, 0x5b, 0x23, 0xcf, 0x8c,
+	0x20, 0x03, 0x94, 0x18, 0x15, 0x6e, 0x67, 0x30, 0xa8, 0x3e, 0xa4, 0x0c, 0x06, 0xf7, 0x37, 0x5d,
+	0x6f, 0x27, 0x3e, 0x18, 0x29, 0xfa, 0xfc, 0x57, 0x2b, 0xe4, 0xdc, 0x70, 0x81, 0xc5, 0xfd, 0x56,
+	0xd4, 0xee, 0x48, 0x67, 0x67, 0x33, 0xf9, 0xc1, 0x29, 0xae, 0xd9, 0xb1, 0x40, 0x90, 0xc7, 0x75,
+	0x67, 0xd0, 0x34, 0x99, 0x6d, 0xa5, 0x17, 0xef, 0x04, 0x69, 0x26, 0xd2, 0x38, 0x4e, 0x71, 0x5b,
+	0xa2, 0x6c, 0x05, 0x03, 0x03, 0xc9, 0xb1, 0x5f, 0x0b, 0xf1, 0xb5, 0x38, 0xe3, 0x0f, 0xf1, 0xcb,
+	0xd6, 0x29, 0x59, 0xd4, 0xd1, 0x00, 0x41, 0x1e, 0x17, 0xc9, 0x31, 0x6b, 0x35, 0x1f, 0x28, 0xbf,
+	0x85, 0x31, 0x72, 0x4b, 0xaa, 0x15, 0x0c, 0x8c, 0x7c, 0x5a, 0x87, 0xfa, 0xfe, 0x69, 0x1d, 0xbc,
+	0x7f, 0x52, 0x21, 0x67, 0x87, 0x0a, 0xbc, 0xa3, 0xb1, 0xa9, 0x47, 0x2f, 0x15, 0xc3, 0x7d, 0xee,
+	0xb0, 0x83, 0x85, 0xf0, 0xff, 0xd9, 0x90, 0x95, 0x26, 0x42, 0xf8, 0xef, 0x3f, 0x33, 0xd1, 0xa3,
+	0x37, 0x9f, 0x03, 0x51, 0xfb, 0xb5, 0x03, 0x44, 0xed, 0xe7, 0x3e, 0x46, 0x7d, 0xc4, 0xd3, 0xe1,
+	0xbf, 0xd4, 0x86, 0x4e, 0x2f, 0x5e, 0x90, 0x47, 0xd2, 0x9b, 0x2f, 0x90, 0x13, 0x41, 0xc4, 0x0a,
+	0xfc, 0xae, 0xf6, 0xd7, 0x45, 0x66, 0x3f, 0x9e, 0xbe, 0x5a, 0x45, 0xe1, 0x2d, 0xe6, 0xe0, 0x30,
+	0xf0, 0xc4, 0x23, 0x98, 0x45, 0xe1, 0xfe, 0xa6, 0xf4, 0x80, 0x9c, 0xfb, 0x3a, 0x39, 0x23, 0xa7,
+	0x62, 0xcb, 0x4f, 0x68, 0x47, 0x1c, 0xb6, 0xa9, 0x88, 0xbb, 0x3c, 0xcb, 0x63, 0x37, 0x0b, 0x10,
+	0xa0, 0xf8, 0x39, 0xfc, 0x64, 0x59, 0xdc, 0x0b, 0xda, 0xad, 0x86, 0xfd, 0xc9, 0xd6, 0xb0, 0x11,
+	0x38, 0x4c, 0x9f, 0x17, 0xcd, 0x07, 0x73, 0x5e, 0x7c, 0x88, 0x34, 0xd5, 0x7c, 0xf3, 0x68, 0x2d,
+	0xb5, 0xc8, 0x07, 0xa2, 0xb5, 0xd4, 0x0a, 0x37, 0xb0, 0xdc, 0xa7, 0xf8, 0x45, 0x25, 0xb7, 0x5b,
+	0x91, 0x1e, 0xb6, 0x7b, 0x2f, 0x90, 0x49, 0xa5, 0xfd, 0x1a, 0xb5, 0xb2, 0xad, 0xf7, 0xff, 0x2a,
+	0x24, 0x57, 0x7b, 0x0e, 0xd3, 0xa7, 0x63, 0xed, 0x3c, 0xd6, 0x58, 0x4e, 0xfa, 0xf4, 0x05, 0xd9,
+	0x9d, 0x36, 0xff, 0xa8, 0x26, 0xd0, 0xc4, 0xdc, 0x8f, 0xf0, 0x4c, 0xe5, 0x82, 0x74, 0xa5, 0x8c,
+	0x4c, 0x1a, 0xab, 0xaa, 0x3f, 0xb3, 0x74, 0xa5, 0x6c, 0x03, 0x83, 0x9e, 0x9b, 0x91, 0xe6, 0x96,
+	0xac, 0xb1, 0x57, 0x0e, 0xbb, 0x53, 0x25, 0xfb, 0xb8, 0x88, 0xa6, 0x7e, 0x82, 0x26, 0xe4, 0xfd,
+	0x69, 0x85, 0x9c, 0xb6, 0x3f, 0x80, 0x30, 0xd7, 0xfd, 0x9a, 0x43, 0x1e, 0x0f, 0xfd, 0x34, 0x5b,
+	0xed, 0xb3, 0x8b, 0xc2, 0x46, 0x3f, 0xbc, 0x9e, 0x4b, 0x6a, 0x7f, 0x58, 0x65, 0x8b, 0xea, 0x38,
+	0x5f, 0x93, 0x71, 0xee, 0x09, 0x8c, 0x56, 0x5d, 0x2a, 0x26, 0x0e, 0xc3, 0x46, 0x85, 0x1a, 0xaa,
+	0x13, 0xed, 0x7e, 0x92, 0xd0, 0x28, 0xd3, 0x43, 0xe5, 0x5f, 0xf1, 0x5a, 0x29, 0x13, 0xa9, 0x07,
+	0x78, 0x1a, 0x19, 0xea, 0x7c, 0x8e, 0x16, 0x0c, 0x50, 0xf7, 0x7e, 0x04, 0x4f, 0xce, 0xa1, 0xef,
+	0xf9, 0x15, 0x56, 0x44, 0xf2, 0x8b, 0x63, 0xe4, 0x98, 0x95, 0xb9, 0xdf, 0x32, 0x71, 0x39, 0xfb,
+	0x9a, 0xb8, 0x58, 0xa4, 0x70, 0x3f, 0x92, 0x25, 0xee, 0x8d, 0x48, 0xe1, 0x7e, 0x84, 0x95, 0x09,
+	0xf0, 0x8f, 0x98, 0x52, 0xe8, 0x47, 0xc2, 0xbb, 0xdd, 0x9c, 0x52, 0xe8, 0x47, 0x20, 0xa0, 0xe8,
+	0xfd, 0x37, 0xc9, 0x36, 0x9f, 0x30, 0x10, 0xb6, 0x6a, 0x65, 0x58, 0x65, 0x57, 0x8d, 0x1e, 0xb9,
+	0x37, 0xa4, 0xd9, 0x02, 0x16, 0x45, 0xac, 0x6d, 0xd7, 0x54, 0x55, 0x71, 0x5b, 0x63, 0x65, 0x44,
+	0x72, 0xe6, 0x0b, 0x23, 0xe4, 0xb8, 0x9e, 0x6c, 0x61, 0x06, 0x23, 0xf1, 0x2f, 0xd6, 0xf5, 0xe3,
+	0xff, 0x8a, 0xc5, 0x51, 0xba, 0x61, 0x8b, 0x14, 0x58, 0xee, 0xb0, 0x5e, 0x8b, 0x1f, 0x05, 0x1b,
+	0x34, 0xcd, 0x64, 0x60, 0x09, 0xaf, 0xd7, 0x22, 0x1b, 0x41, 0xc3, 0x59, 0x1c, 0x0a, 0x7b, 0xb1,
+	0xcc, 0xb0, 0x80, 0xf1, 0x38, 0x14, 0xdd, 0x0c, 0x26, 0x8e, 0x69, 0xae, 0x23, 0x0f, 0xd5, 0x5c,
+	0x37, 0xb1, 0x8f, 0xb9, 0x6e, 0x95, 0x9c, 0xf1, 0xfb, 0x59, 0x8c, 0xc6, 0xfb, 0xd9, 0x0c, 0xd5,
+	0xa8, 0x59, 0xca, 0x8b, 0x3d, 0x4c, 0x32, 0x15, 0xb0, 0xf2, 0xdf, 0x5a, 0xa5, 0xe1, 0xc6, 0x00,
+	0x12, 0x14, 0x3f, 0xeb, 0xfd, 0x43, 0x87, 0x9c, 0x29, 0x5c, 0x0a, 0x8f, 0xae, 0xe7, 0xbc, 0xf7,
+	0x53, 0x75, 0x72, 0xaa, 0xa0, 0xae, 0x87, 0xbb, 0x6b, 0x6e, 0x12, 0xa7, 0x0c, 0x27, 0x34, 0xdb,
+	0xa7, 0x4a, 0x7e, 0x9b, 0x82, 0x9d, 0x71, 0x30, 0x0b, 0xbc, 0xb6, 0x82, 0x57, 0x1f, 0xac, 0x15,
+	0xdc, 0x58, 0xeb, 0xb5, 0x87, 0xba, 0xd6, 0xeb, 0xfb, 0xac, 0xf5, 0x4f, 0x3b, 0xa4, 0xd5, 0x1d,
+	0x52, 0x4c, 0xae, 0x35, 0x56, 0x86, 0x8e, 0x6a, 0x58, 0xa9, 0xba, 0xb9, 0x27, 0x31, 0x4d, 0xc2,
+	0x30, 0x28, 0x0c, 0x1d, 0x95, 0xf7, 0xf9, 0x2a, 0x61, 0xf2, 0x1a, 0xcb, 0xdd, 0xbe, 0xeb, 0x7e,
+	0xd4, 0x2c, 0x0f, 0xe4, 0x94, 0x55, 0xca, 0x86, 0x77, 0xae, 0xca, 0x0b, 0xf1, 0x19, 0x2c, 0xaa,
+	0x36, 0x94, 0xe7, 0x84, 0x95, 0x11, 0x38, 0x61, 0x28, 0xeb, 0x30, 0x55, 0xcb, 0xaf, 0xc3, 0xd4,
+	0xcc, 0xd7, 0x60, 0xda, 0xfb, 0x13, 0xd7, 0x1e, 0xc9, 0x4f, 0xfc, 0xbb, 0x0e, 0x39, 0x55, 0xf0,
+	0x15, 0xb4, 0xb8, 0xe1, 0xec, 0x21, 0x6e, 0xa0, 0x03, 0x94, 0xe0, 0xcc, 0x42, 0x2c, 0xd1, 0x0e,
+	0x50, 0xa2, 0x1d, 0x14, 0x06, 0xde, 0xba, 0x58, 0xd4, 0xe3, 0xc5, 0x6e, 0x2f, 0xdb, 0x15, 0x02,
+	0x8a, 0xba, 0x16, 0xcc, 0x2a, 0x08, 0x18, 0x58, 0xee, 0x33, 0x64, 0x8c, 0x67, 0x9c, 0x11, 0xca,
+	0x9d, 0x09, 0xdc, 0x87, 0x3c, 0x1d, 0x4d, 0x07, 0x04, 0xc8, 0xdb, 0x22, 0xc6, 0xad, 0xe2, 0xfe,
+	0x0b, 0x74, 0xab, 0x52, 0xc1, 0x95, 0x61, 0xa5, 0x82, 0xbd, 0xbf, 0x53, 0x11, 0xa4, 0xf8, 0x2d,
+	0x41, 0xfb, 0xc3, 0x39, 0x07, 0xf4, 0x87, 0xfb, 0x08, 0x21, 0xed, 0xb8, 0xdb, 0xc3, 0x7b, 0xf3,
+	0x5a, 0x5c, 0xce, 0x65, 0x6b, 0x5e, 0xf5, 0xa7, 0x67, 0x55, 0xb7, 0x81, 0x41, 0xcf, 0x62, 0xed,
+	0xd5, 0x7d, 0x59, 0xbb, 0xc5, 0xe5, 0x6a, 0x7b, 0x73, 0x39, 0xef, 0x2f, 0x1c, 0x62, 0x49, 0x7d,
+	0x58, 0x09, 0x0d, 0x87, 0xbb, 0x2b, 0x18, 0xc6, 0xf5, 0xf2, 0x44, 0x4c, 0xe4, 0xd4, 0x62, 0x17,
+	0xb2, 0x7f, 0x81, 0x13, 0x72, 0x43, 0xe1, 0xfb, 0x57, 0xca, 0xe5, 0xc7, 0x24, 0x88, 0xde, 0x83,
+	0xdc, 0x7d, 0x46, 0xfb, 0x11, 0x7a, 0x2f, 0x92, 0x93, 0x03, 0x83, 0x62, 0x45, 0xbd, 0xe3, 0xa4,
+	0x3d, 0xb0, 0x7b, 0x58, 0x9e, 0x1c, 0xe0, 0x30, 0x74, 0xd3, 0x3b, 0x91, 0xef, 0x1e, 0x2d, 0xb7,
+	0x27, 0xd3, 0x7c, 0x7f, 0x47, 0x35, 0x77, 0xca, 0x7f, 0x7f, 0x00, 0x04, 0x83, 0x83, 0xf0, 0xfe,
+	0xb1, 0x38, 0x0d, 0x6e, 0x05, 0x51, 0x27, 0xbe, 0xad, 0xe4, 0x24, 0x67, 0xa8, 0x9c, 0x84, 0xec,
+	0xa1, 0xbd, 0x45, 0x3b, 0xfd, 0x70, 0x20, 0xc1, 0xcd, 0xaa, 0x68, 0x07, 0x85, 0x81, 0xd8, 0x9d,
+	0xbe, 0xb8, 0xb7, 0xe6, 0x16, 0xe5, 0x82, 0x68, 0x07, 0x85, 0x81, 0x21, 0x58, 0xc6, 0x4b, 0xca,
+	0x75, 0xc9, 0x2e, 0x1d, 0xc6, 0x09, 0x9e, 0x82, 0x85, 0x85, 0x8a, 0x76, 0x25, 0x73, 0xc9, 0x13,
+	0x9b, 0x29, 0xda, 0x15, 0x63, 0x4c, 0xc1, 0xc0, 0x60, 0xd9, 0x73, 0xc2, 0x7e, 0xca, 0x2c, 0xc9,
+	0x63, 0x3a, 0xe0, 0x7f, 0x5e, 0xb4, 0x81, 0x82, 0x22, 0x73, 0xeb, 0xfa, 0x51, 0xdf, 0x0f, 0x71,
+	0x86, 0x84, 0xea, 0x4c, 0x6d, 0xc3, 0x65, 0x05, 0x01, 0x03, 0x0b, 0xdf, 0x38, 0x0b, 0xba, 0xf4,
+	0x95, 0x38, 0x92, 0x7e, 0xd7, 0xda, 0xb9, 0x40, 0xb4, 0x83, 0xc2, 0x70, 0x5f, 0xc4, 0xe2, 0xb6,
+	0x1d, 0x2e, 0x20, 0xc6, 0x89, 0xb0, 0x51, 0xaa, 0xdb, 0x27, 0x26, 0x41, 0xd2, 0x50, 0x30, 0x51,
+	0xbd, 0x3f, 0x77, 0xc8, 0x71, 0x9d, 0x85, 0x8c, 0xa9, 0xca, 0x2c, 0x1d, 0xa1, 0xb3, 0xaf, 0x8e,
+	0xd0, 0x4e, 0x6f, 0x54, 0x19, 0x29, 0xbd, 0x91, 0x99, 0x79, 0xa8, 0xba, 0x67, 0xe6, 0xa1, 0xaf,
+	0x26, 0xe3, 0xdb, 0x74, 0xd7, 0x48, 0x51, 0xc4, 0xb8, 0xfc, 0x55, 0xde, 0x04, 0x12, 0x86, 0x01,
+	0x47, 0x6d, 0x5f, 0xa5, 0x10, 0x9d, 0xe4, 0x37, 0xab, 0xf9, 0x59, 0x86, 0x24, 0x20, 0xde, 0x75,
+	0xd2, 0x54, 0xd6, 0x79, 0xa9, 0xb2, 0x73, 0x8a, 0x55, 0x76, 0x23, 0xe5, 0x51, 0x98, 0x5b, 0xff,
+	0xcc, 0x17, 0x9e, 0x7e, 0xc7, 0x1f, 0x7d, 0xe1, 0xe9, 0x77, 0xfc, 0xc9, 0x17, 0x9e, 0x7e, 0xc7,
+	0xc7, 0xee, 0x3d, 0xed, 0x7c, 0xe6, 0xde, 0xd3, 0xce, 0x1f, 0xdd, 0x7b, 0xda, 0xf9, 0x93, 0x7b,
+	0x4f, 0x3b, 0x9f, 0xbf, 0xf7, 0xb4, 0xf3, 0x13, 0xff, 0xf9, 0xe9, 0x77, 0xbc, 0x52, 0xe8, 0xb2,
+	0x8f, 0xff, 0x3c, 0xd7, 0xee, 0x5c, 0xd8, 0x79, 0x81, 0x79, 0x8d, 0xe3, 0xc6, 0xbc, 0x60, 0xac,
+	0xc6, 0x0b, 0x72, 0x63, 0xfe, 0xff, 0x01, 0x00, 0x35, 0x7e, 0x35, 0xa6, 0x45, 0xfe, 0x00, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -12934,6 +12980,54 @@ func (m *ResourceAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x50
+	if len(m.LocalizedDisplayNames) > 0 {
+		keysForLocalizedDisplayNames := make([]string, 0, len(m.LocalizedDisplayNames))
+		for k := range m.LocalizedDisplayNames {
+			keysForLocalizedDisplayNames = append(keysForLocalizedDisplayNames, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLocalizedDisplayNames)
+		for iNdEx := len(keysForLocalizedDisplayNames) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.LocalizedDisplayNames[string(keysForLocalizedDisplayNames[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLocalizedDisplayNames[iNdEx])
+			copy(dAtA[i:], keysForLocalizedDisplayNames[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLocalizedDisplayNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i--
+	if m.RequiresConfirmation {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.DeprecationMessage)
+	copy(dAtA[i:], m.DeprecationMessage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeprecationMessage)))
+	i--
+	dAtA[i] = 0x3a
+	i--
+	if m.Deprecated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
 	i -= len(m.DisplayName)
 	copy(dAtA[i:], m.DisplayName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
@@ -12994,6 +13088,57 @@ func (m *ResourceActionDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Requires) > 0 {
+		for iNdEx := len(m.Requires) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Requires[iNdEx])
+			copy(dAtA[i:], m.Requires[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Requires[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.ActionCUE)
+	copy(dAtA[i:], m.ActionCUE)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionCUE)))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Steps[iNdEx])
+			copy(dAtA[i:], m.Steps[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Steps[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.RequiresConfirmation {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Postcondition)
+	copy(dAtA[i:], m.Postcondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Postcondition)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Precondition)
+	copy(dAtA[i:], m.Precondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Precondition)))
+	i--
+	dAtA[i] = 0x1a
 	i -= len(m.ActionLua)
 	copy(dAtA[i:], m.ActionLua)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActionLua)))
@@ -13027,6 +13172,49 @@ func (m *ResourceActionParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Suggestions) > 0 {
+		for iNdEx := len(m.Suggestions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Suggestions[iNdEx])
+			copy(dAtA[i:], m.Suggestions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Suggestions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i -= len(m.DefaultFrom)
+	copy(dAtA[i:], m.DefaultFrom)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DefaultFrom)))
+	i--
+	dAtA[i] = 0x3a
+	if m.VisibleWhen != nil {
+		{
+			size, err := m.VisibleWhen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i--
+	if m.Required {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
 	i -= len(m.Default)
 	copy(dAtA[i:], m.Default)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Default)))
@@ -13050,6 +13238,39 @@ func (m *ResourceActionParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceActionParamCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionParamCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceActionParamCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Equals)
+	copy(dAtA[i:], m.Equals)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Equals)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Param)
+	copy(dAtA[i:], m.Param)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Param)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceActions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18374,6 +18595,19 @@ func (m *ResourceAction) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.DisplayName)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.DeprecationMessage)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.LocalizedDisplayNames) > 0 {
+		for k, v := range m.LocalizedDisplayNames {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
 	return n
 }
 
@@ -18387,6 +18621,29 @@ func (m *ResourceActionDefinition) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.ActionLua)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Precondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Postcondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Steps) > 0 {
+		for _, s := range m.Steps {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ActionCUE)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Requires) > 0 {
+		for _, s := range m.Requires {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18404,6 +18661,38 @@ func (m *ResourceActionParam) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Default)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.VisibleWhen != nil {
+		l = m.VisibleWhen.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.DefaultFrom)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Suggestions) > 0 {
+		for _, s := range m.Suggestions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceActionParamCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Param)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Equals)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -21440,12 +21729,27 @@ func (this *ResourceAction) String() string {
 		repeatedStringForParams += strings.Replace(strings.Replace(f.String(), "ResourceActionParam", "ResourceActionParam", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForParams += "}"
+	keysForLocalizedDisplayNames := make([]string, 0, len(this.LocalizedDisplayNames))
+	for k := range this.LocalizedDisplayNames {
+		keysForLocalizedDisplayNames = append(keysForLocalizedDisplayNames, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLocalizedDisplayNames)
+	mapStringForLocalizedDisplayNames := "map[string]string{"
+	for _, k := range keysForLocalizedDisplayNames {
+		mapStringForLocalizedDisplayNames += fmt.Sprintf("%v: %v,", k, this.LocalizedDisplayNames[k])
+	}
+	mapStringForLocalizedDisplayNames += "}"
 	s := strings.Join([]string{`&ResourceAction{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Params:` + repeatedStringForParams + `,`,
 		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
 		`IconClass:` + fmt.Sprintf("%v", this.IconClass) + `,`,
 		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Deprecated:` + fmt.Sprintf("%v", this.Deprecated) + `,`,
+		`DeprecationMessage:` + fmt.Sprintf("%v", this.DeprecationMessage) + `,`,
+		`RequiresConfirmation:` + fmt.Sprintf("%v", this.RequiresConfirmation) + `,`,
+		`LocalizedDisplayNames:` + mapStringForLocalizedDisplayNames + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21457,6 +21761,14 @@ func (this *ResourceActionDefinition) String() string {
 	s := strings.Join([]string{`&ResourceActionDefinition{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`ActionLua:` + fmt.Sprintf("%v", this.ActionLua) + `,`,
+		`Precondition:` + fmt.Sprintf("%v", this.Precondition) + `,`,
+		`Postcondition:` + fmt.Sprintf("%v", this.Postcondition) + `,`,
+		`RequiresConfirmation:` + fmt.Sprintf("%v", this.RequiresConfirmation) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Steps:` + fmt.Sprintf("%v", this.Steps) + `,`,
+		`ActionCUE:` + fmt.Sprintf("%v", this.ActionCUE) + `,`,
+		`Requires:` + fmt.Sprintf("%v", this.Requires) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21470,6 +21782,22 @@ func (this *ResourceActionParam) String() string {
 		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Default:` + fmt.Sprintf("%v", this.Default) + `,`,
+		`Required:` + fmt.Sprintf("%v", this.Required) + `,`,
+		`VisibleWhen:` + strings.Replace(this.VisibleWhen.String(), "ResourceActionParamCondition", "ResourceActionParamCondition", 1) + `,`,
+		`DefaultFrom:` + fmt.Sprintf("%v", this.DefaultFrom) + `,`,
+		`Suggestions:` + fmt.Sprintf("%v", this.Suggestions) + `,`,
+		`AllowedValues:` + fmt.Sprintf("%v", this.AllowedValues) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResourceActionParamCondition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceActionParamCondition{`,
+		`Param:` + fmt.Sprintf("%v", this.Param) + `,`,
+		`Equals:` + fmt.Sprintf("%v", this.Equals) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -46204,6 +46532,224 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			}
 			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecationMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecationMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresConfirmation = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalizedDisplayNames", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocalizedDisplayNames == nil {
+				m.LocalizedDisplayNames = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LocalizedDisplayNames[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46318,6 +46864,250 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			}
 			m.ActionLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Postcondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Postcondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresConfirmation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiresConfirmation = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionCUE", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionCUE = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requires", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requires = append(m.Requires, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46496,6 +47286,272 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			}
 			m.Default = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleWhen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibleWhen == nil {
+				m.VisibleWhen = &ResourceActionParamCondition{}
+			}
+			if err := m.VisibleWhen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suggestions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suggestions = append(m.Suggestions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionParamCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionParamCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionParamCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Param = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equals = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
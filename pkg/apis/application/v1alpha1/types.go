@@ -2427,6 +2427,33 @@ type ResourceActionDefinition struct {
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// ActionLua contains the Lua script that defines the behavior of the action.
 	ActionLua string `json:"action.lua" yaml:"action.lua" protobuf:"bytes,2,opt,name=actionLua"`
+	// Precondition contains an optional Lua script evaluated against the resource before the action is executed. It
+	// returns whether the action can be executed and, optionally, a message explaining why it cannot.
+	Precondition string `json:"precondition,omitempty" yaml:"precondition,omitempty" protobuf:"bytes,3,opt,name=precondition"`
+	// Postcondition contains an optional Lua script evaluated against every resource produced by the action. It returns
+	// whether the produced resource is valid and, optionally, a message explaining why it is not.
+	Postcondition string `json:"postcondition,omitempty" yaml:"postcondition,omitempty" protobuf:"bytes,4,opt,name=postcondition"`
+	// RequiresConfirmation indicates whether the action is destructive and must be confirmed with a confirmation token
+	// before it is executed.
+	RequiresConfirmation bool `json:"requiresConfirmation,omitempty" yaml:"requiresConfirmation,omitempty" protobuf:"varint,5,opt,name=requiresConfirmation"`
+	// DisplayName is an optional human-readable label for the action. Actions are always looked up by Name, so the
+	// label can be changed without breaking automation that runs the action.
+	DisplayName string `json:"displayName,omitempty" yaml:"displayName,omitempty" protobuf:"bytes,6,opt,name=displayName"`
+	// Steps are the names of the actions run in order by a composite action, instead of its own Lua script. Every step
+	// runs against the resource as patched by the previous steps, and receives the resources impacted by the previous
+	// steps in the previousResources table.
+	Steps []string `json:"steps,omitempty" yaml:"steps,omitempty" protobuf:"bytes,7,rep,name=steps"`
+	// ActionCUE contains a CUE transform implementing the action instead of ActionLua. The transform is evaluated with
+	// the resource in obj and the parameters in actionParams; its patch field is merged into the resource, and the
+	// resources of its create field are created.
+	ActionCUE string `json:"action.cue,omitempty" yaml:"action.cue,omitempty" protobuf:"bytes,8,opt,name=actionCUE"`
+	// Requires are the names of the optional script libraries used by the action, such as re or yaml. Loading the
+	// action fails if one of them is not enabled.
+	Requires []string `json:"requires,omitempty" yaml:"requires,omitempty" protobuf:"bytes,9,rep,name=requires"`
+	// When contains an optional Lua script evaluated against the resource during discovery. It returns whether the
+	// action is offered, and the action is disabled if it returns false. Unlike Precondition, it is not evaluated when
+	// the action is executed.
+	When string `json:"when,omitempty" yaml:"when,omitempty" protobuf:"bytes,10,opt,name=when"`
 }
 
 // ResourceAction represents an individual action that can be performed on a resource.
@@ -2442,6 +2469,34 @@ type ResourceAction struct {
 	IconClass string `json:"iconClass,omitempty" protobuf:"bytes,4,opt,name=iconClass"`
 	// DisplayName provides a user-friendly name for the action.
 	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,5,opt,name=displayName"`
+	// Deprecated indicates whether the action is deprecated and may be removed in the future.
+	Deprecated bool `json:"deprecated,omitempty" protobuf:"varint,6,opt,name=deprecated"`
+	// DeprecationMessage explains why the action is deprecated and what should be used instead.
+	DeprecationMessage string `json:"deprecationMessage,omitempty" protobuf:"bytes,7,opt,name=deprecationMessage"`
+	// RequiresConfirmation indicates whether the action is destructive and clients should ask for a confirmation
+	// before running it.
+	RequiresConfirmation bool `json:"requiresConfirmation,omitempty" protobuf:"varint,8,opt,name=requiresConfirmation"`
+	// LocalizedDisplayNames maps locales, e.g. "de" or "pt-BR", to translations of the display name.
+	LocalizedDisplayNames map[string]string `json:"localizedDisplayNames,omitempty" protobuf:"bytes,9,rep,name=localizedDisplayNames"`
+	// TimeoutSeconds is the maximum duration of the action, for actions needing more time than the default timeout of
+	// the scripts. It is capped by the maximum allowed by the server.
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,10,opt,name=timeoutSeconds"`
+}
+
+// LocalizedDisplayName returns the display name of the action for the given locale. It falls back to the translation
+// for the language of the locale, e.g. "pt" for "pt-BR", and then to DisplayName.
+func (a *ResourceAction) LocalizedDisplayName(locale string) string {
+	if locale != "" {
+		if displayName, ok := a.LocalizedDisplayNames[locale]; ok {
+			return displayName
+		}
+		if language, _, ok := strings.Cut(locale, "-"); ok {
+			if displayName, ok := a.LocalizedDisplayNames[language]; ok {
+				return displayName
+			}
+		}
+	}
+	return a.DisplayName
 }
 
 // ResourceActionParam represents a parameter for a resource action.
@@ -2455,6 +2510,27 @@ type ResourceActionParam struct {
 	Type string `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
 	// Default is the default value of the parameter, if any.
 	Default string `json:"default,omitempty" protobuf:"bytes,4,opt,name=default"`
+	// Required indicates whether a value must be passed for the parameter, unless it has a default value or is hidden.
+	Required bool `json:"required,omitempty" protobuf:"varint,5,opt,name=required"`
+	// VisibleWhen optionally is the condition on another parameter for this parameter to be shown. Hidden parameters
+	// are not passed to the action.
+	VisibleWhen *ResourceActionParamCondition `json:"visibleWhen,omitempty" protobuf:"bytes,6,opt,name=visibleWhen"`
+	// DefaultFrom optionally is a JSONPath expression into the resource, e.g. {.spec.replicas}, which discovery
+	// resolves to the default value of the parameter. Default is kept if the expression matches no field.
+	DefaultFrom string `json:"defaultFrom,omitempty" protobuf:"bytes,7,opt,name=defaultFrom"`
+	// Suggestions are values clients can propose for the parameter, e.g. computed from the state of the resource.
+	// Other values can be passed, unlike AllowedValues.
+	Suggestions []string `json:"suggestions,omitempty" protobuf:"bytes,8,rep,name=suggestions"`
+	// AllowedValues optionally are the only values which can be passed for the parameter.
+	AllowedValues []string `json:"allowedValues,omitempty" protobuf:"bytes,9,rep,name=allowedValues"`
+}
+
+// ResourceActionParamCondition is a condition on the value of a parameter of a resource action.
+type ResourceActionParamCondition struct {
+	// Param is the name of the parameter.
+	Param string `json:"param" protobuf:"bytes,1,opt,name=param"`
+	// Equals is the value the parameter must have for the condition to hold.
+	Equals string `json:"equals" protobuf:"bytes,2,opt,name=equals"`
 }
 
 // TODO: refactor to use rbac.ActionGet, rbac.ActionCreate, without import cycle
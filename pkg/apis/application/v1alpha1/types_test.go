@@ -4507,3 +4507,31 @@ func TestCluster_ParseProxyUrl(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceAction_LocalizedDisplayName(t *testing.T) {
+	action := ResourceAction{
+		Name:        "restart",
+		DisplayName: "Restart Workload",
+		LocalizedDisplayNames: map[string]string{
+			"de":    "Workload neu starten",
+			"pt":    "Reiniciar carga de trabalho",
+			"pt-BR": "Reiniciar workload",
+		},
+	}
+	testData := []struct {
+		locale   string
+		expected string
+	}{
+		{locale: "", expected: "Restart Workload"},
+		{locale: "de", expected: "Workload neu starten"},
+		{locale: "de-AT", expected: "Workload neu starten"},
+		{locale: "pt-BR", expected: "Reiniciar workload"},
+		{locale: "pt-PT", expected: "Reiniciar carga de trabalho"},
+		{locale: "fr", expected: "Restart Workload"},
+	}
+	for _, data := range testData {
+		t.Run(data.locale, func(t *testing.T) {
+			assert.Equal(t, data.expected, action.LocalizedDisplayName(data.locale))
+		})
+	}
+}
@@ -3533,7 +3533,16 @@ func (in *ResourceAction) DeepCopyInto(out *ResourceAction) {
 	if in.Params != nil {
 		in, out := &in.Params, &out.Params
 		*out = make([]ResourceActionParam, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LocalizedDisplayNames != nil {
+		in, out := &in.LocalizedDisplayNames, &out.LocalizedDisplayNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 	return
 }
@@ -3551,6 +3560,16 @@ func (in *ResourceAction) DeepCopy() *ResourceAction {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceActionDefinition) DeepCopyInto(out *ResourceActionDefinition) {
 	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Requires != nil {
+		in, out := &in.Requires, &out.Requires
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3567,6 +3586,21 @@ func (in *ResourceActionDefinition) DeepCopy() *ResourceActionDefinition {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceActionParam) DeepCopyInto(out *ResourceActionParam) {
 	*out = *in
+	if in.VisibleWhen != nil {
+		in, out := &in.VisibleWhen, &out.VisibleWhen
+		*out = new(ResourceActionParamCondition)
+		**out = **in
+	}
+	if in.Suggestions != nil {
+		in, out := &in.Suggestions, &out.Suggestions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3580,13 +3614,31 @@ func (in *ResourceActionParam) DeepCopy() *ResourceActionParam {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceActionParamCondition) DeepCopyInto(out *ResourceActionParamCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceActionParamCondition.
+func (in *ResourceActionParamCondition) DeepCopy() *ResourceActionParamCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceActionParamCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceActions) DeepCopyInto(out *ResourceActions) {
 	*out = *in
 	if in.Definitions != nil {
 		in, out := &in.Definitions, &out.Definitions
 		*out = make([]ResourceActionDefinition, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -25,6 +25,7 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -69,6 +70,12 @@ type AppResourceTreeFn func(ctx context.Context, app *v1alpha1.Application) (*v1
 const (
 	backgroundPropagationPolicy string = "background"
 	foregroundPropagationPolicy string = "foreground"
+	// maxActionDiscoveryCacheEntries is the number of distinct resource shapes whose discovered actions are cached
+	maxActionDiscoveryCacheEntries = 1000
+	// maxActionScriptCacheEntries is the number of distinct compiled resource action scripts which are cached
+	maxActionScriptCacheEntries = 1000
+	// maxResourceActionTimeout caps the timeouts declared by resource actions
+	maxResourceActionTimeout = 30 * time.Second
 )
 
 var (
@@ -95,6 +102,8 @@ type Server struct {
 	projInformer           cache.SharedIndexInformer
 	enabledNamespaces      []string
 	syncWithReplaceAllowed bool
+	actionDiscoveryCache   *lua.DiscoveryCache
+	actionScriptCache      *lua.ScriptCache
 }
 
 // NewServer returns a new instance of the Application service
@@ -142,6 +151,8 @@ func NewServer(
 		projInformer:           projInformer,
 		enabledNamespaces:      enabledNamespaces,
 		syncWithReplaceAllowed: syncWithReplaceAllowed,
+		actionDiscoveryCache:   lua.NewDiscoveryCache(maxActionDiscoveryCacheEntries),
+		actionScriptCache:      lua.NewScriptCache(maxActionScriptCacheEntries),
 	}
 	return s, s.getAppResources
 }
@@ -2362,18 +2373,38 @@ func (s *Server) ListResourceActions(ctx context.Context, q *application.Applica
 		return nil, fmt.Errorf("error getting resource overrides: %w", err)
 	}
 
-	availableActions, err := s.getAvailableActions(resourceOverrides, obj)
+	availableActions, err := s.getAvailableActions(ctx, resourceOverrides, obj)
 	if err != nil {
 		return nil, fmt.Errorf("error getting available actions: %w", err)
 	}
+	locale := getRequestLocale(ctx)
 	actionsPtr := []*v1alpha1.ResourceAction{}
 	for i := range availableActions {
+		availableActions[i].DisplayName = availableActions[i].LocalizedDisplayName(locale)
 		actionsPtr = append(actionsPtr, &availableActions[i])
 	}
 
 	return &application.ResourceActionsListResponse{Actions: actionsPtr}, nil
 }
 
+// getRequestLocale returns the preferred locale of the Accept-Language header forwarded by the gRPC gateway, or an
+// empty string if there is none.
+func getRequestLocale(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, acceptLanguage := range md.Get("grpcgateway-accept-language") {
+		locale, _, _ := strings.Cut(acceptLanguage, ",")
+		locale, _, _ = strings.Cut(locale, ";")
+		locale = strings.TrimSpace(locale)
+		if locale != "" && locale != "*" {
+			return locale
+		}
+	}
+	return ""
+}
+
 func (s *Server) getUnstructuredLiveResourceOrApp(ctx context.Context, rbacRequest string, q *application.ApplicationResourceRequest) (obj *unstructured.Unstructured, res *v1alpha1.ResourceNode, app *v1alpha1.Application, config *rest.Config, err error) {
 	if q.GetKind() == applicationType.ApplicationKind && q.GetGroup() == applicationType.Group && q.GetName() == q.GetResourceName() {
 		app, _, err = s.getApplicationEnforceRBACInformer(ctx, rbacRequest, q.GetProject(), q.GetAppNamespace(), q.GetName())
@@ -2401,9 +2432,11 @@ func (s *Server) getUnstructuredLiveResourceOrApp(ctx context.Context, rbacReque
 	return
 }
 
-func (s *Server) getAvailableActions(resourceOverrides map[string]v1alpha1.ResourceOverride, obj *unstructured.Unstructured) ([]v1alpha1.ResourceAction, error) {
+func (s *Server) getAvailableActions(ctx context.Context, resourceOverrides map[string]v1alpha1.ResourceOverride, obj *unstructured.Unstructured) ([]v1alpha1.ResourceAction, error) {
 	luaVM := lua.VM{
 		ResourceOverrides: resourceOverrides,
+		DiscoveryCache:    s.actionDiscoveryCache,
+		ScriptCache:       s.actionScriptCache,
 	}
 
 	discoveryScripts, err := luaVM.GetResourceActionDiscovery(obj)
@@ -2413,13 +2446,26 @@ func (s *Server) getAvailableActions(resourceOverrides map[string]v1alpha1.Resou
 	if len(discoveryScripts) == 0 {
 		return []v1alpha1.ResourceAction{}, nil
 	}
-	availableActions, err := luaVM.ExecuteResourceActionDiscovery(obj, discoveryScripts)
+	availableActions, err := luaVM.ExecuteResourceActionDiscoveryContext(ctx, obj, discoveryScripts)
 	if err != nil {
 		return nil, fmt.Errorf("error executing Lua discovery script: %w", err)
 	}
 	return availableActions, nil
 }
 
+// warnIfResourceActionDeprecated logs a warning when the given action is marked as deprecated by the discovery script.
+// Deprecated actions can still be executed.
+func warnIfResourceActionDeprecated(obj *unstructured.Unstructured, discovered *v1alpha1.ResourceAction) {
+	if discovered == nil || !discovered.Deprecated {
+		return
+	}
+	log.WithFields(log.Fields{
+		"kind":      obj.GetKind(),
+		"namespace": obj.GetNamespace(),
+		"name":      obj.GetName(),
+	}).Warnf("Running deprecated resource action %q: %s", discovered.Name, discovered.DeprecationMessage)
+}
+
 func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceActionRunRequest) (*application.ApplicationResponse, error) {
 	resourceRequest := &application.ApplicationResourceRequest{
 		Name:         q.Name,
@@ -2447,18 +2493,41 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		return nil, fmt.Errorf("error getting resource overrides: %w", err)
 	}
 
+	actionConfig, err := s.settingsMgr.GetResourceActionConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource action config: %w", err)
+	}
+
 	luaVM := lua.VM{
 		ResourceOverrides: resourceOverrides,
+		DiscoveryCache:    s.actionDiscoveryCache,
+		ScriptCache:       s.actionScriptCache,
+		MaxActionTimeout:  maxResourceActionTimeout,
+		ActionConfig:      actionConfig,
+		// The run request cannot carry the confirmation token, the clients ask for the confirmation instead
+		SkipConfirmation: true,
+		// The action itself is already enforced with the resource, this also enforces the steps of composite actions
+		Authorizer: lua.AuthorizerFunc(func(verb string, _ *unstructured.Unstructured) bool {
+			return s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, verb, a.RBACName(s.ns))
+		}),
 	}
 	action, err := luaVM.GetResourceAction(liveObj, q.GetAction())
 	if err != nil {
 		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
 	}
+	// The action is discovered once, for both the deprecation warning and its execution
+	discovered, err := luaVM.DiscoverResourceAction(ctx, liveObj, q.GetAction())
+	if err != nil {
+		return nil, fmt.Errorf("error discovering Lua resource action: %w", err)
+	}
+	warnIfResourceActionDeprecated(liveObj, discovered)
 
-	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua)
+	actionResult, err := luaVM.ExecuteDiscoveredResourceAction(ctx, liveObj, action, discovered, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
 	}
+	// The resources are applied by wave, so that the resources of a wave can depend on those of the previous waves
+	newObjects := lua.SortImpactedResourcesByWave(actionResult.ImpactedResources)
 
 	var app *v1alpha1.Application
 	// Only bother getting the app if we know we're going to need it for a resource permission check.
@@ -2485,19 +2554,38 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	// This is performed separately to reduce the risk of only some of the resources being successfully created later.
 	// TODO: when apply/delete operations would be supported for custom actions,
 	// the dry-run for relevant apply/delete operation would have to be invoked as well.
+	// The resources created in a namespace created by the action itself cannot be dry-run before the namespace exists,
+	// so they are dry-run once the other resources are, see dryRunInCreatedNamespaces.
+	createdNamespaces := make(map[string]*unstructured.Unstructured)
+	var inCreatedNamespaces []*unstructured.Unstructured
 	for _, impactedResource := range newObjects {
+		if err := impactedResource.K8SOperation.Validate(); err != nil {
+			return nil, err
+		}
 		newObj := impactedResource.UnstructuredObj
 		err := s.verifyResourcePermitted(destCluster, proj, newObj)
 		if err != nil {
 			return nil, err
 		}
-		if impactedResource.K8SOperation == lua.CreateOperation {
-			createOptions := metav1.CreateOptions{DryRun: []string{"All"}}
-			_, err := s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, createOptions)
-			if err != nil {
-				return nil, err
-			}
+		if impactedResource.K8SOperation != lua.CreateOperation {
+			continue
+		}
+		if _, ok := createdNamespaces[newObj.GetNamespace()]; ok {
+			inCreatedNamespaces = append(inCreatedNamespaces, newObj)
+			continue
 		}
+		if newObj.GroupVersionKind().GroupKind() == (schema.GroupKind{Kind: kube.NamespaceKind}) {
+			createdNamespaces[newObj.GetName()] = newObj
+		}
+		createOptions := metav1.CreateOptions{DryRun: []string{"All"}}
+		_, err = s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, createOptions)
+		if err != nil {
+			return nil, err
+		}
+	}
+	namespacesCreatedForDryRun, err := s.dryRunInCreatedNamespaces(ctx, config, createdNamespaces, inCreatedNamespaces)
+	if err != nil {
+		return nil, err
 	}
 
 	// Now, perform the actual operations.
@@ -2507,19 +2595,30 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	// thus can fail separately from create).
 	for _, impactedResource := range newObjects {
 		newObj := impactedResource.UnstructuredObj
+		if impactedResource.K8SOperation == lua.PatchOperation && len(impactedResource.ListMergeKeys) > 0 {
+			// The lists are merged by key into those of the live resource, like the action tests preview the patch,
+			// since the merge patch would replace them otherwise
+			newObj, err = lua.ApplyPatch(liveObj, impactedResource)
+			if err != nil {
+				return nil, fmt.Errorf("error merging lists of patched resource: %w", err)
+			}
+		}
 		newObjBytes, err := json.Marshal(newObj)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling new object: %w", err)
 		}
 
 		switch impactedResource.K8SOperation {
-		// No default case since a not supported operation would have failed upon unmarshaling earlier
+		// No default case since the operations were validated earlier
 		case lua.PatchOperation:
-			_, err := s.patchResource(ctx, config, liveObjBytes, newObjBytes, newObj)
+			_, err := s.patchResource(ctx, config, liveObjBytes, newObjBytes, newObj, impactedResource.Subresource)
 			if err != nil {
 				return nil, err
 			}
 		case lua.CreateOperation:
+			if newObj.GroupVersionKind().GroupKind() == (schema.GroupKind{Kind: kube.NamespaceKind}) && namespacesCreatedForDryRun[newObj.GetName()] {
+				continue
+			}
 			_, err := s.createResource(ctx, config, newObj)
 			if err != nil {
 				return nil, err
@@ -2536,7 +2635,7 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	return &application.ApplicationResponse{}, nil
 }
 
-func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObjBytes, newObjBytes []byte, newObj *unstructured.Unstructured) (*application.ApplicationResponse, error) {
+func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObjBytes, newObjBytes []byte, newObj *unstructured.Unstructured, subresource lua.Subresource) (*application.ApplicationResponse, error) {
 	diffBytes, err := jsonpatch.CreateMergePatch(liveObjBytes, newObjBytes)
 	if err != nil {
 		return nil, fmt.Errorf("error calculating merge patch: %w", err)
@@ -2545,6 +2644,16 @@ func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObj
 		return &application.ApplicationResponse{}, nil
 	}
 
+	// The action targets a subresource explicitly, e.g. to scale the resource through its scale subresource, which
+	// only takes the fields it exposes from the patch
+	if subresource != "" {
+		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes, string(subresource))
+		if err != nil {
+			return nil, fmt.Errorf("error patching %s subresource: %w", subresource, err)
+		}
+		return &application.ApplicationResponse{}, nil
+	}
+
 	// The following logic detects if the resource action makes a modification to status and/or spec.
 	// If status was modified, we attempt to patch the status using status subresource, in case the
 	// CRD is configured using the status subresource feature. See:
@@ -2597,6 +2706,38 @@ func (s *Server) verifyResourcePermitted(destCluster *v1alpha1.Cluster, proj *v1
 	return nil
 }
 
+// dryRunInCreatedNamespaces dry-runs the creation of the resources in the namespaces created by the action, which are
+// created beforehand since the resources cannot be dry-run otherwise. The namespaces are deleted again if one of the
+// dry-runs fails. It returns the names of the namespaces it created, which are not to be created again.
+func (s *Server) dryRunInCreatedNamespaces(ctx context.Context, config *rest.Config, namespaces map[string]*unstructured.Unstructured, objs []*unstructured.Unstructured) (map[string]bool, error) {
+	created := make(map[string]bool)
+	rollback := func() {
+		for name := range created {
+			namespace := namespaces[name]
+			err := s.kubectl.DeleteResource(ctx, config, namespace.GroupVersionKind(), name, "", metav1.DeleteOptions{})
+			if err != nil {
+				log.Warnf("Failed to delete namespace %s created to dry-run the resources of an action: %v", name, err)
+			}
+		}
+	}
+	for _, obj := range objs {
+		if !created[obj.GetNamespace()] {
+			if _, err := s.createResource(ctx, config, namespaces[obj.GetNamespace()]); err != nil {
+				rollback()
+				return nil, err
+			}
+			created[obj.GetNamespace()] = true
+		}
+		createOptions := metav1.CreateOptions{DryRun: []string{"All"}}
+		_, err := s.kubectl.CreateResource(ctx, config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), obj, createOptions)
+		if err != nil {
+			rollback()
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
 func (s *Server) createResource(ctx context.Context, config *rest.Config, newObj *unstructured.Unstructured) (*application.ApplicationResponse, error) {
 	_, err := s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, metav1.CreateOptions{})
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/argoproj/pkg/v2/sync"
 	"github.com/golang-jwt/jwt/v5"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -33,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
@@ -2451,6 +2453,14 @@ func TestInferResourcesStatusHealth(t *testing.T) {
 	assert.Nil(t, testApp.Status.Resources[1].Health)
 }
 
+func TestGetRequestLocale(t *testing.T) {
+	assert.Empty(t, getRequestLocale(context.Background()))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("grpcgateway-accept-language", "de-AT,de;q=0.9,en;q=0.8"))
+	assert.Equal(t, "de-AT", getRequestLocale(ctx))
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("grpcgateway-accept-language", "*"))
+	assert.Empty(t, getRequestLocale(ctx))
+}
+
 func TestRunNewStyleResourceAction(t *testing.T) {
 	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
 
@@ -2649,6 +2659,285 @@ func TestRunOldStyleResourceAction(t *testing.T) {
 		require.NoError(t, runErr)
 		assert.NotNil(t, appResponse)
 	})
+
+	t.Run("RequiresConfirmation", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		testApp.Status.Resources = resources
+
+		f := func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:admin")
+		}
+		resourceActions := `
+discovery.lua: |
+  actions = {}
+  actions["scale-to-zero"] = {["requiresConfirmation"] = true}
+  return actions
+definitions:
+- name: scale-to-zero
+  requiresConfirmation: true
+  action.lua: |
+    obj.spec.replicas = 0
+    return obj
+`
+		appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{
+			"resource.customizations.actions.apps_Deployment": resourceActions,
+		}, testApp, kube.MustToUnstructured(&deployment))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+		err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes})
+		require.NoError(t, err)
+
+		confirmedAction := "scale-to-zero"
+		appResponse, runErr := appServer.RunResourceAction(t.Context(), &application.ResourceActionRunRequest{
+			Name:         &testApp.Name,
+			Namespace:    &namespace,
+			Action:       &confirmedAction,
+			AppNamespace: &testApp.Namespace,
+			ResourceName: &resourceName,
+			Version:      &version,
+			Group:        &group,
+			Kind:         &kind,
+		})
+
+		require.NoError(t, runErr)
+		assert.NotNil(t, appResponse)
+	})
+
+	t.Run("CompositeActionStepNotPermitted", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		testApp.Status.Resources = resources
+
+		f := func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			_ = enf.SetUserPolicy(`
+p, role:actions, applications, get, default/*, allow
+p, role:actions, applications, action/apps/Deployment/pause-and-restart, default/*, allow
+p, role:actions, applications, action/apps/Deployment/pause, default/*, allow
+g, actions, role:actions
+`)
+			enf.SetDefaultRole("")
+		}
+		resourceActions := `
+discovery.lua: |
+  actions = {}
+  actions["pause-and-restart"] = {}
+  return actions
+definitions:
+- name: pause-and-restart
+  steps: [pause, restart]
+`
+		appServer := newTestAppServerWithEnforcerConfigure(t, f, map[string]string{
+			"resource.customizations.actions.apps_Deployment": resourceActions,
+		}, testApp, kube.MustToUnstructured(&deployment))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+		err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes})
+		require.NoError(t, err)
+
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"groups": []string{"actions"}})
+		compositeAction := "pause-and-restart"
+		appResponse, runErr := appServer.RunResourceAction(ctx, &application.ResourceActionRunRequest{
+			Name:         &testApp.Name,
+			Namespace:    &namespace,
+			Action:       &compositeAction,
+			AppNamespace: &testApp.Namespace,
+			ResourceName: &resourceName,
+			Version:      &version,
+			Group:        &group,
+			Kind:         &kind,
+		})
+
+		require.ErrorContains(t, runErr, `not authorized to run action "restart"`)
+		assert.Nil(t, appResponse)
+	})
+
+	t.Run("ListMergeKeys", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		testApp.Status.Resources = resources
+
+		deployment := deployment.DeepCopy()
+		deployment.Spec.Template.Spec.Containers = []corev1.Container{{Name: "nginx", Image: "nginx:1"}, {Name: "sidecar", Image: "sidecar:1"}}
+		resourceActions := `
+discovery.lua: |
+  actions = {}
+  actions["update-sidecar"] = {}
+  return actions
+definitions:
+- name: update-sidecar
+  action.lua: |
+    obj.spec.template.spec.containers = {{name = "sidecar", image = "sidecar:2"}}
+    return {{operation = "patch", resource = obj, listMergeKeys = {["spec.template.spec.containers"] = "name"}}}
+`
+		appServer := newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:admin")
+		}, map[string]string{
+			"resource.customizations.actions.apps_Deployment": resourceActions,
+		}, testApp, kube.MustToUnstructured(deployment))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+		kubectl := &recordingKubectl{Kubectl: appServer.kubectl}
+		appServer.kubectl = kubectl
+
+		err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes})
+		require.NoError(t, err)
+
+		mergeAction := "update-sidecar"
+		_, runErr := appServer.RunResourceAction(t.Context(), &application.ResourceActionRunRequest{
+			Name:         &testApp.Name,
+			Namespace:    &namespace,
+			Action:       &mergeAction,
+			AppNamespace: &testApp.Namespace,
+			ResourceName: &resourceName,
+			Version:      &version,
+			Group:        &group,
+			Kind:         &kind,
+		})
+
+		require.NoError(t, runErr)
+		require.Len(t, kubectl.patches, 1)
+		assert.JSONEq(t, `{"spec":{"template":{"spec":{"containers":[{"name":"nginx","image":"nginx:1","resources":{}},{"name":"sidecar","image":"sidecar:2","resources":{}}]}}}}`, string(kubectl.patches[0]))
+	})
+
+	t.Run("CreateInCreatedNamespace", func(t *testing.T) {
+		testApp := newTestApp()
+		testApp.Spec.Project = "bootstrap"
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		testApp.Status.Resources = resources
+		bootstrapProj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "bootstrap", Namespace: testNamespace},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:              []string{"*"},
+				Destinations:             []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				ClusterResourceWhitelist: []metav1.GroupKind{{Group: "", Kind: "Namespace"}},
+			},
+		}
+
+		resourceActions := `
+discovery.lua: |
+  actions = {}
+  actions["bootstrap"] = {}
+  return actions
+definitions:
+- name: bootstrap
+  action.lua: |
+    local namespace = {apiVersion = "v1", kind = "Namespace", metadata = {name = "team-a"}}
+    local quota = {apiVersion = "v1", kind = "ResourceQuota", metadata = {name = "quota", namespace = "team-a"}}
+    obj.spec.paused = true
+    return {{operation = "create", resource = namespace}, {operation = "create", resource = quota}, {operation = "patch", resource = obj}}
+`
+		run := func(t *testing.T, kubectl *recordingKubectl) error {
+			t.Helper()
+			appServer := newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+				_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+				enf.SetDefaultRole("role:admin")
+			}, map[string]string{
+				"resource.customizations.actions.apps_Deployment": resourceActions,
+			}, testApp, bootstrapProj, kube.MustToUnstructured(&deployment))
+			appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+			kubectl.Kubectl = appServer.kubectl
+			appServer.kubectl = kubectl
+
+			err := appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes})
+			require.NoError(t, err)
+
+			bootstrapAction := "bootstrap"
+			_, err = appServer.RunResourceAction(t.Context(), &application.ResourceActionRunRequest{
+				Name:         &testApp.Name,
+				Namespace:    &namespace,
+				Action:       &bootstrapAction,
+				AppNamespace: &testApp.Namespace,
+				ResourceName: &resourceName,
+				Version:      &version,
+				Group:        &group,
+				Kind:         &kind,
+			})
+			return err
+		}
+
+		t.Run("DryRun", func(t *testing.T) {
+			kubectl := &recordingKubectl{}
+			require.NoError(t, run(t, kubectl))
+			assert.Equal(t, []string{
+				"dry-run Namespace/team-a",
+				"create Namespace/team-a",
+				"dry-run ResourceQuota/quota",
+				"create ResourceQuota/quota",
+				"patch Deployment/nginx-deploy",
+			}, kubectl.operations)
+		})
+		t.Run("DryRunFailure", func(t *testing.T) {
+			kubectl := &recordingKubectl{createErr: func(obj *unstructured.Unstructured, dryRun bool) error {
+				if dryRun && obj.GetKind() == "ResourceQuota" {
+					return stderrors.New("quota rejected")
+				}
+				return nil
+			}}
+			require.EqualError(t, run(t, kubectl), "quota rejected")
+			assert.Equal(t, []string{
+				"dry-run Namespace/team-a",
+				"create Namespace/team-a",
+				"dry-run ResourceQuota/quota",
+				"delete Namespace/team-a",
+			}, kubectl.operations)
+		})
+	})
+}
+
+// recordingKubectl records the operations on the resources, which the mock kubectl ignores, and optionally fails the
+// creation of the resources.
+type recordingKubectl struct {
+	kube.Kubectl
+	createErr  func(obj *unstructured.Unstructured, dryRun bool) error
+	patches    [][]byte
+	operations []string
+}
+
+func (k *recordingKubectl) PatchResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, patchType types.PatchType, patchBytes []byte, subresources ...string) (*unstructured.Unstructured, error) {
+	k.patches = append(k.patches, patchBytes)
+	k.operations = append(k.operations, "patch "+gvk.Kind+"/"+name)
+	return k.Kubectl.PatchResource(ctx, config, gvk, name, namespace, patchType, patchBytes, subresources...)
+}
+
+func (k *recordingKubectl) CreateResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, obj *unstructured.Unstructured, createOptions metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	dryRun := len(createOptions.DryRun) > 0
+	operation := "create "
+	if dryRun {
+		operation = "dry-run "
+	}
+	k.operations = append(k.operations, operation+gvk.Kind+"/"+name)
+	if k.createErr != nil {
+		if err := k.createErr(obj, dryRun); err != nil {
+			return nil, err
+		}
+	}
+	return k.Kubectl.CreateResource(ctx, config, gvk, name, namespace, obj, createOptions, subresources...)
+}
+
+func (k *recordingKubectl) DeleteResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error {
+	k.operations = append(k.operations, "delete "+gvk.Kind+"/"+name)
+	return k.Kubectl.DeleteResource(ctx, config, gvk, name, namespace, deleteOptions)
+}
+
+func TestWarnIfResourceActionDeprecated(t *testing.T) {
+	obj := kube.MustToUnstructured(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx-deploy", Namespace: testNamespace},
+	})
+	hook := logtest.NewGlobal()
+	defer hook.Reset()
+
+	warnIfResourceActionDeprecated(obj, nil)
+	warnIfResourceActionDeprecated(obj, &v1alpha1.ResourceAction{Name: "restart"})
+	assert.Empty(t, hook.AllEntries())
+
+	warnIfResourceActionDeprecated(obj, &v1alpha1.ResourceAction{Name: "restart", Deprecated: true, DeprecationMessage: "use rollout-restart"})
+	require.Len(t, hook.AllEntries(), 1)
+	assert.Equal(t, `Running deprecated resource action "restart": use rollout-restart`, hook.LastEntry().Message)
 }
 
 func TestIsApplicationPermitted(t *testing.T) {
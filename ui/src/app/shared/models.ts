@@ -849,6 +849,16 @@ export interface ResourceActionParam {
     value: string;
     type: string;
     default: string;
+    required?: boolean;
+    visibleWhen?: ResourceActionParamCondition;
+    defaultFrom?: string;
+    suggestions?: string[];
+    allowedValues?: string[];
+}
+
+export interface ResourceActionParamCondition {
+    param: string;
+    equals: string;
 }
 
 export interface ResourceAction {
@@ -857,6 +867,11 @@ export interface ResourceAction {
     disabled: boolean;
     iconClass: string;
     displayName: string;
+    deprecated?: boolean;
+    deprecationMessage?: string;
+    requiresConfirmation?: boolean;
+    localizedDisplayNames?: {[locale: string]: string};
+    timeoutSeconds?: number;
 }
 
 export interface SyncWindowsState {
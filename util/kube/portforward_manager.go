@@ -0,0 +1,150 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// maxPortPickAttempts bounds the attempts of a PortForwardManager to pick a local port which none of its
+// port-forwards uses.
+const maxPortPickAttempts = 10
+
+// ErrPortForwardManagerStopped is returned when starting a port-forward with a PortForwardManager which was stopped.
+var ErrPortForwardManagerStopped = errors.New("port-forward manager is stopped")
+
+// PortForwardManager starts several independent port-forwards in the same process, e.g. to the API server, the repo
+// server and Redis for a debug session, and stops them together. The local ports of its port-forwards are distinct,
+// even when they are started concurrently. No port-forward can be started once the manager is stopped.
+type PortForwardManager struct {
+	// freePort returns a free local port. freeLocalPort is used if it is nil.
+	freePort portPicker
+
+	lock    sync.Mutex
+	ports   map[int]*PortForwardHandle
+	stopped bool
+}
+
+// NewPortForwardManager returns a PortForwardManager without port-forwards.
+func NewPortForwardManager() *PortForwardManager {
+	return &PortForwardManager{ports: make(map[int]*PortForwardHandle)}
+}
+
+// StartPortForward is StartPortForward tracked by the manager.
+func (m *PortForwardManager) StartPortForward(overrides *clientcmd.ConfigOverrides, opts PortForwardOptions) (*PortForwardHandle, error) {
+	config, opts, err := loadPortForwardConfig(overrides, opts)
+	if err != nil {
+		return nil, err
+	}
+	return m.StartPortForwardWithConfig(config, opts)
+}
+
+// StartPortForwardWithConfig is StartPortForwardWithConfig tracked by the manager.
+func (m *PortForwardManager) StartPortForwardWithConfig(config *rest.Config, opts PortForwardOptions) (*PortForwardHandle, error) {
+	return m.start(func(pickPort portPicker) (*PortForwardHandle, error) {
+		return startPortForwardWithConfig(config, opts, pickPort)
+	})
+}
+
+// start starts a port-forward on a local port reserved by the manager, and tracks it until it ends.
+func (m *PortForwardManager) start(startOnPort func(pickPort portPicker) (*PortForwardHandle, error)) (*PortForwardHandle, error) {
+	reserved := -1
+	handle, err := startOnPort(func() (int, error) {
+		port, err := m.reservePort()
+		reserved = port
+		return port, err
+	})
+	if err != nil {
+		if reserved != -1 {
+			m.release(reserved, nil)
+		}
+		return nil, err
+	}
+
+	m.lock.Lock()
+	if m.stopped {
+		// The manager was stopped while the port-forward was starting
+		m.lock.Unlock()
+		handle.Stop()
+		<-handle.Done()
+		m.release(handle.LocalPort, nil)
+		return nil, ErrPortForwardManagerStopped
+	}
+	m.ports[handle.LocalPort] = handle
+	m.lock.Unlock()
+	go func() {
+		<-handle.Done()
+		m.release(handle.LocalPort, handle)
+	}()
+	return handle, nil
+}
+
+// reservePort picks a free local port which none of the port-forwards of the manager uses.
+func (m *PortForwardManager) reservePort() (int, error) {
+	freePort := m.freePort
+	if freePort == nil {
+		freePort = freeLocalPort
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.stopped {
+		return -1, ErrPortForwardManagerStopped
+	}
+	for range maxPortPickAttempts {
+		port, err := freePort()
+		if err != nil {
+			return -1, err
+		}
+		if _, ok := m.ports[port]; !ok {
+			// The port is reserved until the port-forward is started
+			m.ports[port] = nil
+			return port, nil
+		}
+	}
+	return -1, fmt.Errorf("failed to pick a free local port after %d attempts", maxPortPickAttempts)
+}
+
+// release stops tracking the port if it is still used by the given handle, or still reserved if the handle is nil.
+func (m *PortForwardManager) release(port int, handle *PortForwardHandle) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if current, ok := m.ports[port]; ok && current == handle {
+		delete(m.ports, port)
+	}
+}
+
+// Handles returns the running port-forwards of the manager, sorted by local port.
+func (m *PortForwardManager) Handles() []*PortForwardHandle {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	handles := make([]*PortForwardHandle, 0, len(m.ports))
+	for _, handle := range m.ports {
+		if handle != nil {
+			handles = append(handles, handle)
+		}
+	}
+	slices.SortFunc(handles, func(a, b *PortForwardHandle) int {
+		return a.LocalPort - b.LocalPort
+	})
+	return handles
+}
+
+// StopAll stops all the port-forwards of the manager and waits for them to end. The port-forwards started
+// concurrently are stopped too, and the manager cannot start port-forwards afterwards.
+func (m *PortForwardManager) StopAll() {
+	m.lock.Lock()
+	m.stopped = true
+	m.lock.Unlock()
+	handles := m.Handles()
+	for _, handle := range handles {
+		handle.Stop()
+	}
+	for _, handle := range handles {
+		<-handle.Done()
+		m.release(handle.LocalPort, handle)
+	}
+}
@@ -0,0 +1,158 @@
+package kube
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPortForwardManagerStopAll(t *testing.T) {
+	clientSet := fake.NewClientset(
+		newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"}),
+		newTestPod("argocd", "argocd-repo-server-1", map[string]string{"app": "argocd-repo-server"}),
+		newTestPod("argocd", "argocd-redis-1", map[string]string{"app": "argocd-redis"}),
+	)
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+	manager := NewPortForwardManager()
+
+	var wg sync.WaitGroup
+	handles := make([]*PortForwardHandle, 3)
+	errs := make([]error, 3)
+	for i, selector := range []string{"app=argocd-server", "app=argocd-repo-server", "app=argocd-redis"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			handles[i], errs[i] = manager.start(func(pickPort portPicker) (*PortForwardHandle, error) {
+				return startPortForwardOnPort(clientSet, newDialer, PortForwardOptions{
+					Namespaces:   []string{"argocd"},
+					PodSelectors: LabelSelectors(selector),
+					TargetPort:   intstr.FromInt32(8080),
+				}, pickPort)
+			})
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	ports := map[int]bool{}
+	for _, handle := range handles {
+		ports[handle.LocalPort] = true
+		assert.Equal(t, "ping", echo(t, handle.LocalPort, "ping"))
+	}
+	assert.Len(t, ports, 3, "the local ports must be distinct")
+	assert.Len(t, manager.Handles(), 3)
+
+	manager.StopAll()
+	for _, handle := range handles {
+		select {
+		case <-handle.Done():
+		default:
+			t.Fatalf("port-forward on port %d was not stopped", handle.LocalPort)
+		}
+		require.NoError(t, handle.Err())
+	}
+	assert.Empty(t, manager.Handles())
+}
+
+func TestPortForwardManagerStopAllConcurrentStart(t *testing.T) {
+	clientSet := fake.NewClientset(newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"}))
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+	manager := NewPortForwardManager()
+	start := func() (*PortForwardHandle, error) {
+		return manager.start(func(pickPort portPicker) (*PortForwardHandle, error) {
+			return startPortForwardOnPort(clientSet, newDialer, PortForwardOptions{
+				Namespaces:   []string{"argocd"},
+				PodSelectors: LabelSelectors("app=argocd-server"),
+				TargetPort:   intstr.FromInt32(8080),
+			}, pickPort)
+		})
+	}
+
+	var wg sync.WaitGroup
+	handles := make([]*PortForwardHandle, 10)
+	errs := make([]error, 10)
+	for i := range handles {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			handles[i], errs[i] = start()
+		}()
+	}
+	manager.StopAll()
+	wg.Wait()
+
+	for i, handle := range handles {
+		if errs[i] != nil {
+			require.ErrorIs(t, errs[i], ErrPortForwardManagerStopped)
+			continue
+		}
+		select {
+		case <-handle.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatalf("port-forward on port %d started concurrently was not stopped", handle.LocalPort)
+		}
+	}
+	assert.Empty(t, manager.Handles())
+	manager.lock.Lock()
+	assert.Empty(t, manager.ports, "no port must stay reserved")
+	manager.lock.Unlock()
+
+	_, err := start()
+	require.ErrorIs(t, err, ErrPortForwardManagerStopped)
+}
+
+func TestPortForwardManagerReservePort(t *testing.T) {
+	var picked []int
+	manager := NewPortForwardManager()
+	manager.freePort = func() (int, error) {
+		// The first ports returned by the system collide with the port of a running port-forward
+		port := 9000
+		if len(picked) >= 2 {
+			port = 9001
+		}
+		picked = append(picked, port)
+		return port, nil
+	}
+	manager.ports[9000] = &PortForwardHandle{LocalPort: 9000}
+
+	port, err := manager.reservePort()
+	require.NoError(t, err)
+	assert.Equal(t, 9001, port)
+	assert.Equal(t, []int{9000, 9000, 9001}, picked)
+
+	t.Run("Exhausted", func(t *testing.T) {
+		manager.freePort = func() (int, error) {
+			return 9000, nil
+		}
+		_, err := manager.reservePort()
+		require.EqualError(t, err, "failed to pick a free local port after 10 attempts")
+	})
+	t.Run("ReleasedOnFailure", func(t *testing.T) {
+		manager.freePort = func() (int, error) {
+			return 9002, nil
+		}
+		_, err := manager.start(func(pickPort portPicker) (*PortForwardHandle, error) {
+			_, err := pickPort()
+			require.NoError(t, err)
+			return nil, errors.New("no pod")
+		})
+		require.EqualError(t, err, "no pod")
+		manager.lock.Lock()
+		defer manager.lock.Unlock()
+		assert.NotContains(t, manager.ports, 9002)
+	})
+}
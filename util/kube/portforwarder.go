@@ -3,15 +3,31 @@ package kube
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"math/rand"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
+	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
@@ -20,47 +36,824 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/io"
 )
 
+// PortForwardOptions holds the options of a port-forward started by StartPortForward.
+type PortForwardOptions struct {
+	// Namespaces are the candidate namespaces of the pod, searched in order until a pod matching the pod selectors is
+	// found. The namespace of the current kubeconfig context is used if there is none.
+	Namespaces []string
+	// PodSelectors are the selectors tried in order until one of them matches a pod.
+	PodSelectors []PodSelector
+	// PodSelection is the strategy choosing among the pods matching a selector. PodSelectionFirst is used if it is
+	// empty.
+	PodSelection PodSelectionStrategy
+	// SelectPod chooses the pod among the pods matching a selector, instead of the pod selection strategy. The next
+	// selector is tried if it returns no pod.
+	SelectPod PodSelectFunc
+	// ListTimeout bounds each call listing the pods matching a selector, so that selecting a pod fails fast when the API
+	// server is slow or unreachable. The calls are not bounded if it is zero.
+	ListTimeout time.Duration
+	// ReadyTimeout enables waiting up to this duration for a ready pod matching the pod selectors, like FindReadyPod,
+	// instead of choosing among the matching pods right away. Only the ready pods are then passed to SelectPod or the
+	// pod selection strategy. Selecting a pod still fails right away if no pod matches the selectors. The pods are not
+	// waited for if it is zero.
+	ReadyTimeout time.Duration
+	// TargetPort is the number or the name of the container port to forward to.
+	TargetPort intstr.IntOrString
+	// HealthCheckInterval is the interval at which the forwarded pod is checked to still be running. The pod is not
+	// checked if it is zero, but the port-forward still stops as soon as the connection to the pod is lost.
+	HealthCheckInterval time.Duration
+	// Reconnect enables re-establishing the port-forward on the same local port after the connection to the pod is
+	// lost or the pod stops running, by selecting a pod again. Its steps bound the number of reconnection attempts
+	// after each drop. The port-forward fails on the first drop if it is nil.
+	Reconnect *wait.Backoff
+	// OnReady is called from the forwarder goroutine with the local port each time the port is forwarded to a pod.
+	OnReady func(port int)
+	// OnError is called from the forwarder goroutine with the errors occurring after the port has been forwarded,
+	// such as the connection to the pod being lost or the port-forward failing to be re-established.
+	OnError func(err error)
+	// ProbeTLS enables checking whether the target port requires TLS once the port is first forwarded, by attempting a
+	// TLS handshake through the local port, since plaintext clients get confusing errors from such ports. The result is
+	// reported by RemoteTLS and a warning is logged. The probe is counted in the transferred bytes.
+	ProbeTLS bool
+	// DisableProxy disables proxying the connections to the pods, ignoring both the proxy of the kubeconfig and the
+	// proxy environment variables.
+	DisableProxy bool
+}
+
+// PodSelectionStrategy is the strategy choosing the pod to forward a port to among the pods matching a selector.
+type PodSelectionStrategy string
+
+const (
+	// PodSelectionFirst chooses the first matching pod returned by the API server.
+	PodSelectionFirst PodSelectionStrategy = "first"
+	// PodSelectionNewest chooses the ready pod started the most recently.
+	PodSelectionNewest PodSelectionStrategy = "newest"
+	// PodSelectionRandom chooses a random ready pod.
+	PodSelectionRandom PodSelectionStrategy = "random"
+)
+
+// UpgradeError is returned when the connection to the pod could not be upgraded to a streaming protocol, such as when
+// websocket tunneling is not supported between the client and the pod.
+type UpgradeError struct {
+	message string
+	// Err is the upgrade failure returned when dialing the pod.
+	Err error
+}
+
+func (e *UpgradeError) Error() string {
+	return e.message
+}
+
+func (e *UpgradeError) Unwrap() error {
+	return e.Err
+}
+
+// PodSelectFunc chooses the pod to forward a port to among the pods matching a selector.
+type PodSelectFunc func(pods []corev1.Pod) (*corev1.Pod, error)
+
+// PodSelector selects the pods matching both its label selector and its field selector.
+type PodSelector struct {
+	// LabelSelector is the label selector of the pods.
+	LabelSelector string
+	// FieldSelector is an optional field selector of the pods, such as status.phase=Running.
+	FieldSelector string
+}
+
+// LabelSelectors returns pod selectors made of the given label selectors.
+func LabelSelectors(labelSelectors ...string) []PodSelector {
+	podSelectors := make([]PodSelector, 0, len(labelSelectors))
+	for _, labelSelector := range labelSelectors {
+		podSelectors = append(podSelectors, PodSelector{LabelSelector: labelSelector})
+	}
+	return podSelectors
+}
+
+func (s PodSelector) String() string {
+	if s.FieldSelector == "" {
+		return s.LabelSelector
+	}
+	return s.LabelSelector + "," + s.FieldSelector
+}
+
+// PortForwardHandle is a port-forward started by StartPortForward.
+type PortForwardHandle struct {
+	// LocalPort is the local port forwarded to the pod. It stays the same when the port-forward is re-established.
+	LocalPort int
+	// RemotePort is the container port the local port was first forwarded to, after resolving a named target port.
+	RemotePort int
+	// Pod is the pod the local port was first forwarded to, as returned by the API server when it was selected.
+	Pod *corev1.Pod
+	// RemoteTLS is whether the target port answered the TLS handshake of the ProbeTLS option.
+	RemoteTLS bool
+
+	stopChan chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+	doneOnce sync.Once
+	err      error
+	counters transferCounters
+}
+
+// BytesSent returns the number of bytes sent to the pods through the port-forward.
+func (h *PortForwardHandle) BytesSent() int64 {
+	return h.counters.sent.Load()
+}
+
+// BytesReceived returns the number of bytes received from the pods through the port-forward.
+func (h *PortForwardHandle) BytesReceived() int64 {
+	return h.counters.received.Load()
+}
+
+// Stop stops forwarding the port.
+func (h *PortForwardHandle) Stop() {
+	h.stopOnce.Do(func() {
+		close(h.stopChan)
+	})
+}
+
+// Done returns a channel closed when the port is no longer forwarded, either because Stop was called or because the
+// port-forward failed.
+func (h *PortForwardHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+// Err returns the reason the port-forward failed once Done is closed, or nil if it was stopped by Stop.
+func (h *PortForwardHandle) Err() error {
+	select {
+	case <-h.done:
+		return h.err
+	default:
+		return nil
+	}
+}
+
+// StopOnSignals stops forwarding the port when one of the given signals, or SIGINT or SIGTERM if none is given, is
+// received by the process. It is meant for CLI commands, since the signals are no longer handled by the default
+// handler. The returned function stops handling the signals.
+func (h *PortForwardHandle) StopOnSignals(signals ...os.Signal) func() {
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, signals...)
+	release := make(chan struct{})
+	go h.stopOnSignal(sigCh, release)
+	var releaseOnce sync.Once
+	return func() {
+		releaseOnce.Do(func() {
+			signal.Stop(sigCh)
+			close(release)
+		})
+	}
+}
+
+// stopOnSignal stops forwarding the port when a signal is received, and waits for the port-forward to end so that
+// the local listener is closed.
+func (h *PortForwardHandle) stopOnSignal(sigCh <-chan os.Signal, release <-chan struct{}) {
+	select {
+	case <-sigCh:
+		h.Stop()
+		<-h.Done()
+	case <-h.Done():
+	case <-release:
+	}
+}
+
+// finish records the reason the port-forward ended and closes the done channel.
+func (h *PortForwardHandle) finish(err error) {
+	h.doneOnce.Do(func() {
+		h.err = err
+		close(h.done)
+	})
+}
+
+// podForward is the forwarding of the local port to a single pod.
+type podForward struct {
+	pod      *corev1.Pod
+	stopChan chan struct{}
+	errChan  chan error
+}
+
+// stop stops forwarding the port to the pod and waits for the listener on the local port to be closed.
+func (f *podForward) stop() {
+	close(f.stopChan)
+	<-f.errChan
+}
+
+// supervise waits for the port-forward to end and re-establishes it if reconnection is enabled, until the handle is
+// stopped or the port-forward can no longer be re-established.
+func (h *PortForwardHandle) supervise(clientSet kubernetes.Interface, connect func() (*podForward, error), current *podForward, opts PortForwardOptions) {
+	for {
+		if opts.OnReady != nil {
+			opts.OnReady(h.LocalPort)
+		}
+		err := h.wait(clientSet, current, opts)
+		if err != nil && opts.OnError != nil {
+			opts.OnError(err)
+		}
+		if err == nil || opts.Reconnect == nil {
+			h.finish(err)
+			return
+		}
+		current, err = h.reconnect(connect, *opts.Reconnect, err)
+		if current == nil {
+			if err != nil && opts.OnError != nil {
+				opts.OnError(err)
+			}
+			h.finish(err)
+			return
+		}
+	}
+}
+
+// wait waits for the forwarding to the pod to end. It returns nil if the handle was stopped, and the reason the
+// forwarding failed otherwise.
+func (h *PortForwardHandle) wait(clientSet kubernetes.Interface, current *podForward, opts PortForwardOptions) error {
+	var tick <-chan time.Time
+	if opts.HealthCheckInterval > 0 {
+		ticker := time.NewTicker(opts.HealthCheckInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	// The health checks are cancelled when the handle is stopped
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-h.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	for {
+		select {
+		case <-h.stopChan:
+			current.stop()
+			return nil
+		case err := <-current.errChan:
+			if err == nil {
+				err = portforward.ErrLostConnectionToPod
+			}
+			return err
+		case <-tick:
+			if err := checkPodHealth(ctx, clientSet, current.pod, healthCheckTimeout(opts)); err != nil {
+				current.stop()
+				return err
+			}
+		}
+	}
+}
+
+// healthCheckTimeout returns the timeout of each health check of the forwarded pod: the list timeout if it is set,
+// and the health check interval otherwise, so that a check never outlasts the next one.
+func healthCheckTimeout(opts PortForwardOptions) time.Duration {
+	if opts.ListTimeout > 0 {
+		return opts.ListTimeout
+	}
+	return opts.HealthCheckInterval
+}
+
+// reconnect re-establishes the port-forward following the retry policy. It returns a nil forwarding and error if the
+// handle was stopped in the meantime.
+func (h *PortForwardHandle) reconnect(connect func() (*podForward, error), backoff wait.Backoff, cause error) (*podForward, error) {
+	attempts := backoff.Steps
+	err := cause
+	for backoff.Steps > 0 {
+		select {
+		case <-h.stopChan:
+			return nil, nil
+		case <-time.After(backoff.Step()):
+		}
+		var current *podForward
+		current, err = connect()
+		if err == nil {
+			return current, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to re-establish port-forward after %d attempts: %w", attempts, err)
+}
+
+// checkPodHealth checks that the forwarded pod still exists and runs, within the timeout.
+func checkPodHealth(ctx context.Context, clientSet kubernetes.Interface, pod *corev1.Pod, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	current, err := clientSet.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Errorf("pod %s/%s no longer exists", pod.Namespace, pod.Name)
+	case err != nil:
+		// The pod is checked again on the next tick
+		return nil
+	case current.UID != pod.UID:
+		return fmt.Errorf("pod %s/%s was replaced", pod.Namespace, pod.Name)
+	case current.DeletionTimestamp != nil || current.Status.Phase == corev1.PodSucceeded || current.Status.Phase == corev1.PodFailed:
+		return fmt.Errorf("pod %s/%s is no longer running", pod.Namespace, pod.Name)
+	}
+	return nil
+}
+
+// dialerFactory creates the dialer used to forward ports of the given pod.
+type dialerFactory func(pod *corev1.Pod) (httpstream.Dialer, error)
+
+// portForwardReadyTimeout is how long PortForward and PortForwardWithConfig wait for a ready pod.
+const portForwardReadyTimeout = 30 * time.Second
+
+// PortForward forwards a local port to the target port of the first ready pod matching the pod selectors, waiting up
+// to portForwardReadyTimeout for one, and returns the local port.
 func PortForward(targetPort int, namespace string, overrides *clientcmd.ConfigOverrides, podSelectors ...string) (int, error) {
+	var namespaces []string
+	if namespace != "" {
+		namespaces = []string{namespace}
+	}
+	handle, err := StartPortForward(overrides, PortForwardOptions{
+		Namespaces:   namespaces,
+		PodSelectors: LabelSelectors(podSelectors...),
+		TargetPort:   intstr.FromInt32(int32(targetPort)),
+		ReadyTimeout: portForwardReadyTimeout,
+	})
+	if err != nil {
+		return -1, err
+	}
+	return handle.LocalPort, nil
+}
+
+// PortForwardWithConfig is PortForward connecting to the cluster with the given config instead of the kubeconfig. The
+// pods are looked up in the default namespace if the namespace is empty.
+func PortForwardWithConfig(config *rest.Config, targetPort int, namespace string, podSelectors ...string) (int, error) {
+	var namespaces []string
+	if namespace != "" {
+		namespaces = []string{namespace}
+	}
+	handle, err := StartPortForwardWithConfig(config, PortForwardOptions{
+		Namespaces:   namespaces,
+		PodSelectors: LabelSelectors(podSelectors...),
+		TargetPort:   intstr.FromInt32(int32(targetPort)),
+		ReadyTimeout: portForwardReadyTimeout,
+	})
+	if err != nil {
+		return -1, err
+	}
+	return handle.LocalPort, nil
+}
+
+// StartPortForward forwards a local port to the target port of the first pod matching the pod selectors, using the
+// kubeconfig loading rules and the given overrides to connect to the cluster.
+func StartPortForward(overrides *clientcmd.ConfigOverrides, opts PortForwardOptions) (*PortForwardHandle, error) {
+	config, opts, err := loadPortForwardConfig(overrides, opts)
+	if err != nil {
+		return nil, err
+	}
+	return StartPortForwardWithConfig(config, opts)
+}
+
+// loadPortForwardConfig loads the config of the cluster with the kubeconfig loading rules and the given overrides, and
+// defaults the namespaces of the options to the namespace of the current kubeconfig context.
+func loadPortForwardConfig(overrides *clientcmd.ConfigOverrides, opts PortForwardOptions) (*rest.Config, PortForwardOptions, error) {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	loadingRules.DefaultClientConfig = &clientcmd.DefaultClientConfig
 	clientConfig := clientcmd.NewInteractiveDeferredLoadingClientConfig(loadingRules, overrides, os.Stdin)
 	config, err := clientConfig.ClientConfig()
 	if err != nil {
-		return -1, err
+		return nil, opts, err
 	}
 
-	if namespace == "" {
-		namespace, _, err = clientConfig.Namespace()
+	if len(opts.Namespaces) == 0 {
+		namespace, _, err := clientConfig.Namespace()
 		if err != nil {
-			return -1, err
+			return nil, opts, err
 		}
+		opts.Namespaces = []string{namespace}
+	}
+	return config, opts, nil
+}
+
+// StartPortForwardWithConfig is StartPortForward connecting to the cluster with the given config, such as an
+// in-cluster config, instead of the kubeconfig. The pods are looked up in the default namespace if the options have
+// no namespace.
+func StartPortForwardWithConfig(config *rest.Config, opts PortForwardOptions) (*PortForwardHandle, error) {
+	return startPortForwardWithConfig(config, opts, freeLocalPort)
+}
+
+func startPortForwardWithConfig(config *rest.Config, opts PortForwardOptions, pickPort portPicker) (*PortForwardHandle, error) {
+	if len(opts.Namespaces) == 0 {
+		opts.Namespaces = []string{metav1.NamespaceDefault}
 	}
 
 	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	dialerConfig := withPortForwardProxy(config, opts.DisableProxy)
+	return startPortForwardOnPort(clientSet, func(pod *corev1.Pod) (httpstream.Dialer, error) {
+		return newPodDialer(dialerConfig, clientSet, pod)
+	}, opts, pickPort)
+}
+
+// portPicker returns the local port to forward.
+type portPicker func() (int, error)
+
+// freeLocalPort returns a local port which is free at the time of the call.
+func freeLocalPort() (int, error) {
+	ln, err := net.Listen("tcp", "localhost:0")
 	if err != nil {
 		return -1, err
 	}
+	defer io.Close(ln)
+	return ln.Addr().(*net.TCPAddr).Port, nil
+}
+
+func startPortForward(clientSet kubernetes.Interface, newDialer dialerFactory, opts PortForwardOptions) (*PortForwardHandle, error) {
+	return startPortForwardOnPort(clientSet, newDialer, opts, freeLocalPort)
+}
+
+func startPortForwardOnPort(clientSet kubernetes.Interface, newDialer dialerFactory, opts PortForwardOptions, pickPort portPicker) (*PortForwardHandle, error) {
+	pod, err := selectPod(clientSet, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	remotePort, err := resolveContainerPort(pod, opts.TargetPort)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := pickPort()
+	if err != nil {
+		return nil, err
+	}
+
+	handle := &PortForwardHandle{LocalPort: port, RemotePort: remotePort, Pod: pod, stopChan: make(chan struct{}), done: make(chan struct{})}
+	current, err := forwardToPod(newDialer, pod, port, remotePort, &handle.counters)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ProbeTLS {
+		handle.RemoteTLS = probeTLS(port)
+		if handle.RemoteTLS {
+			log.Warnf("Port %d of pod %s/%s appears to require TLS, connect to localhost:%d with a TLS client", remotePort, pod.Namespace, pod.Name, port)
+		}
+	}
+
+	connect := func() (*podForward, error) {
+		pod, err := selectPod(clientSet, opts)
+		if err != nil {
+			return nil, err
+		}
+		remotePort, err := resolveContainerPort(pod, opts.TargetPort)
+		if err != nil {
+			return nil, err
+		}
+		return forwardToPod(newDialer, pod, port, remotePort, &handle.counters)
+	}
+	go handle.supervise(clientSet, connect, current, opts)
+	return handle, nil
+}
+
+// selectPod returns the first pod matching one of the pod selectors, in the first namespace having such a pod.
+func selectPod(clientSet kubernetes.Interface, opts PortForwardOptions) (*corev1.Pod, error) {
+	choose := opts.SelectPod
+	if choose == nil {
+		choose = func(pods []corev1.Pod) (*corev1.Pod, error) {
+			return choosePod(pods, opts.PodSelection)
+		}
+	}
+	ctx := context.Background()
+	if opts.ReadyTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.ReadyTimeout)
+		defer cancel()
+	}
+	pod, err := lookupPod(ctx, clientSet, opts.Namespaces, opts.PodSelectors, opts.ListTimeout, choose, opts.ReadyTimeout > 0)
+	if err != nil || pod != nil {
+		return pod, err
+	}
+
+	return nil, fmt.Errorf("cannot find pod with selector: %v - use the --{component}-name flag in this command or set the environmental variable (Refer to https://argo-cd.readthedocs.io/en/stable/user-guide/environment-variables), to change the Argo CD component name in the CLI", opts.PodSelectors)
+}
+
+// readyPodPollInterval is the interval at which the pods are listed again until one of them is ready.
+const readyPodPollInterval = 1 * time.Second
+
+// FindReadyPod waits for a ready pod matching one of the label selectors, tried in order, in the namespace. The pods
+// are listed again every readyPodPollInterval until one of them is ready or the context is done. It fails right away
+// if no pod matches the selectors.
+func FindReadyPod(ctx context.Context, clientSet kubernetes.Interface, namespace string, selectors ...string) (*corev1.Pod, error) {
+	podSelectors := LabelSelectors(selectors...)
+	pod, err := lookupPod(ctx, clientSet, []string{namespace}, podSelectors, 0, func(pods []corev1.Pod) (*corev1.Pod, error) {
+		return &pods[0], nil
+	}, true)
+	if err == nil && pod == nil {
+		return nil, fmt.Errorf("cannot find pod with selector %v in namespace %s", podSelectors, namespace)
+	}
+	return pod, err
+}
+
+// lookupPod returns the pod chosen among the pods matching one of the pod selectors, in the first namespace having
+// one, or nil if there is none. If waitReady is set, the pod is only chosen among the ready pods, and the pods are
+// listed again every readyPodPollInterval while some of them match but none is ready, until one is chosen or the
+// context is done.
+func lookupPod(ctx context.Context, clientSet kubernetes.Interface, namespaces []string, podSelectors []PodSelector, listTimeout time.Duration, choose PodSelectFunc, waitReady bool) (*corev1.Pod, error) {
+	if !waitReady {
+		return findPodInNamespaces(ctx, clientSet, namespaces, podSelectors, listTimeout, choose)
+	}
+	var pod, notReady *corev1.Pod
+	chooseReady := func(pods []corev1.Pod) (*corev1.Pod, error) {
+		ready := make([]corev1.Pod, 0, len(pods))
+		for i := range pods {
+			if isPodReady(&pods[i]) {
+				ready = append(ready, pods[i])
+			}
+		}
+		if len(ready) == 0 {
+			notReady = &pods[0]
+			return nil, nil
+		}
+		return choose(ready)
+	}
+	err := wait.PollUntilContextCancel(ctx, readyPodPollInterval, true, func(ctx context.Context) (bool, error) {
+		var err error
+		notReady = nil
+		pod, err = findPodInNamespaces(ctx, clientSet, namespaces, podSelectors, listTimeout, chooseReady)
+		// Waiting only helps if a pod matches but is not ready yet
+		return pod != nil || notReady == nil, err
+	})
+	switch {
+	case err == nil:
+		return pod, nil
+	case ctx.Err() == nil:
+		return nil, err
+	case notReady != nil:
+		return nil, fmt.Errorf("pod %s/%s matching selector %v is not ready: %w", notReady.Namespace, notReady.Name, podSelectors, err)
+	default:
+		return nil, fmt.Errorf("cannot find pod with selector %v in namespace %s: %w", podSelectors, strings.Join(namespaces, ", "), err)
+	}
+}
 
-	var pod *corev1.Pod
+// findPodInNamespaces returns the pod chosen among the pods matching the pod selectors in the first namespace for
+// which a pod is chosen, or nil if there is none.
+func findPodInNamespaces(ctx context.Context, clientSet kubernetes.Interface, namespaces []string, podSelectors []PodSelector, listTimeout time.Duration, choose PodSelectFunc) (*corev1.Pod, error) {
+	for _, namespace := range namespaces {
+		pod, err := findPod(ctx, clientSet, namespace, podSelectors, listTimeout, choose)
+		if err != nil || pod != nil {
+			return pod, err
+		}
+	}
+	return nil, nil
+}
 
+// findPod returns the pod chosen among the pods of the namespace matching the first pod selector for which a pod is
+// chosen, or nil if there is none.
+func findPod(ctx context.Context, clientSet kubernetes.Interface, namespace string, podSelectors []PodSelector, listTimeout time.Duration, choose PodSelectFunc) (*corev1.Pod, error) {
 	for _, podSelector := range podSelectors {
-		pods, err := clientSet.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{
-			LabelSelector: podSelector,
-		})
+		pods, err := listPods(ctx, clientSet, namespace, podSelector, listTimeout)
 		if err != nil {
-			return -1, err
+			return nil, err
+		}
+		if len(pods.Items) == 0 {
+			continue
+		}
+		pod, err := choose(pods.Items)
+		if err != nil || pod != nil {
+			return pod, err
+		}
+	}
+	return nil, nil
+}
+
+// listPods lists the pods of the namespace matching the pod selector, within the timeout if it is not zero.
+func listPods(ctx context.Context, clientSet kubernetes.Interface, namespace string, podSelector PodSelector, timeout time.Duration) (*corev1.PodList, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	pods, err := clientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: podSelector.LabelSelector,
+		FieldSelector: podSelector.FieldSelector,
+	})
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("timed out listing pods for selector %q: %w", podSelector.String(), err)
+	}
+	return pods, err
+}
+
+// choosePod chooses a pod among the given pods according to the selection strategy. The newest and random strategies
+// choose among the ready pods, or among all the pods if none is ready.
+func choosePod(pods []corev1.Pod, strategy PodSelectionStrategy) (*corev1.Pod, error) {
+	switch strategy {
+	case "", PodSelectionFirst:
+		return &pods[0], nil
+	case PodSelectionNewest, PodSelectionRandom:
+	default:
+		return nil, fmt.Errorf("unknown pod selection strategy %q", strategy)
+	}
+
+	candidates := make([]*corev1.Pod, 0, len(pods))
+	for i := range pods {
+		if isPodReady(&pods[i]) {
+			candidates = append(candidates, &pods[i])
+		}
+	}
+	if len(candidates) == 0 {
+		for i := range pods {
+			candidates = append(candidates, &pods[i])
 		}
+	}
+
+	if strategy == PodSelectionRandom {
+		return candidates[rand.Intn(len(candidates))], nil
+	}
+	newest := candidates[0]
+	for _, pod := range candidates[1:] {
+		if startTime(pod).After(startTime(newest).Time) {
+			newest = pod
+		}
+	}
+	return newest, nil
+}
 
-		if len(pods.Items) > 0 {
-			pod = &pods.Items[0]
-			break
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
 		}
 	}
+	return false
+}
+
+func startTime(pod *corev1.Pod) metav1.Time {
+	if pod.Status.StartTime == nil {
+		return metav1.Time{}
+	}
+	return *pod.Status.StartTime
+}
+
+// forwardToPod forwards the local port to the remote port of the pod and waits for the local port to be ready. The data
+// transferred through the port-forward is counted by the given counters.
+func forwardToPod(newDialer dialerFactory, pod *corev1.Pod, localPort, remotePort int, counters *transferCounters) (*podForward, error) {
+	dialer, err := newDialer(pod)
+	if err != nil {
+		return nil, err
+	}
+	tracker := &trackingDialer{Dialer: dialer, counters: counters}
+
+	readyChan := make(chan struct{}, 1)
+	stopChan := make(chan struct{})
+	errChan := make(chan error, 1)
+	out := new(syncBuffer)
+	errOut := new(syncBuffer)
 
-	if pod == nil {
-		return -1, fmt.Errorf("cannot find pod with selector: %v - use the --{component}-name flag in this command or set the environmental variable (Refer to https://argo-cd.readthedocs.io/en/stable/user-guide/environment-variables), to change the Argo CD component name in the CLI", podSelectors)
+	forwarder, err := portforward.NewOnAddresses(tracker, []string{"localhost"}, []string{fmt.Sprintf("%d:%d", localPort, remotePort)}, stopChan, readyChan, out, errOut)
+	if err != nil {
+		return nil, err
 	}
 
+	go func() {
+		errChan <- forwarder.ForwardPorts()
+	}()
+	select {
+	case err = <-errChan:
+		if httpstream.IsUpgradeFailure(tracker.err) {
+			return nil, &UpgradeError{message: err.Error(), Err: tracker.err}
+		}
+		return nil, err
+	case <-readyChan:
+	}
+	current := &podForward{pod: pod, stopChan: stopChan, errChan: errChan}
+	if len(errOut.String()) != 0 {
+		current.stop()
+		return nil, fmt.Errorf("%s", errOut.String())
+	}
+	return current, nil
+}
+
+// tlsProbeTimeout bounds the TLS handshake of the ProbeTLS option.
+const tlsProbeTimeout = 2 * time.Second
+
+// probeTLS returns whether the remote side of the local port answers a TLS handshake. The handshake is considered
+// answered if it succeeds or if the remote side sends a TLS alert, e.g. because it requires a client certificate,
+// whereas plaintext servers send data which is not a TLS record or close the connection.
+func probeTLS(localPort int) bool {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("localhost:%d", localPort), tlsProbeTimeout)
+	if err != nil {
+		return false
+	}
+	defer io.Close(conn)
+	// The certificate is not verified since the probe only detects whether TLS is spoken
+	tlsConn := tls.Client(conn, &tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+	if err := tlsConn.SetDeadline(time.Now().Add(tlsProbeTimeout)); err != nil {
+		return false
+	}
+	err = tlsConn.Handshake()
+	// The alerts received from the remote side are reported as remote errors
+	var opErr *net.OpError
+	return err == nil || (errors.As(err, &opErr) && opErr.Op == "remote error")
+}
+
+// transferCounters count the bytes transferred through a port-forward.
+type transferCounters struct {
+	sent     atomic.Int64
+	received atomic.Int64
+}
+
+// trackingDialer is a dialer recording the error of the last dial, and whose connections count the bytes transferred
+// through their data streams.
+type trackingDialer struct {
+	httpstream.Dialer
+	counters *transferCounters
+	err      error
+}
+
+func (d *trackingDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, protocol, err := d.Dialer.Dial(protocols...)
+	d.err = err
+	if err != nil {
+		return nil, "", err
+	}
+	return &countingConnection{Connection: conn, counters: d.counters}, protocol, nil
+}
+
+type countingConnection struct {
+	httpstream.Connection
+	counters *transferCounters
+}
+
+func (c *countingConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	stream, err := c.Connection.CreateStream(headers)
+	if err != nil || headers.Get(corev1.StreamType) != corev1.StreamTypeData {
+		return stream, err
+	}
+	return &countingStream{Stream: stream, counters: c.counters}, nil
+}
+
+type countingStream struct {
+	httpstream.Stream
+	counters *transferCounters
+}
+
+func (s *countingStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	s.counters.received.Add(int64(n))
+	return n, err
+}
+
+func (s *countingStream) Write(p []byte) (int, error) {
+	n, err := s.Stream.Write(p)
+	s.counters.sent.Add(int64(n))
+	return n, err
+}
+
+// syncBuffer is a buffer safe for concurrent use, since the forwarder writes to its output from each connection.
+type syncBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+// resolveContainerPort returns the number of the target port, looking up named ports in the containers of the pod.
+func resolveContainerPort(pod *corev1.Pod, targetPort intstr.IntOrString) (int, error) {
+	if targetPort.Type == intstr.Int {
+		return targetPort.IntValue(), nil
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == targetPort.StrVal {
+				return int(containerPort.ContainerPort), nil
+			}
+		}
+	}
+	return -1, fmt.Errorf("pod %s/%s has no container port named %q", pod.Namespace, pod.Name, targetPort.StrVal)
+}
+
+// withPortForwardProxy returns a copy of the config whose proxy is set explicitly, so that the SPDY and the websocket
+// dialers use the same proxy. The proxy of the config is used if it has one, and the proxy environment variables
+// otherwise, unless proxying is disabled.
+func withPortForwardProxy(config *rest.Config, disableProxy bool) *rest.Config {
+	config = rest.CopyConfig(config)
+	switch {
+	case disableProxy:
+		config.Proxy = func(*http.Request) (*url.URL, error) {
+			return nil, nil
+		}
+	case config.Proxy == nil:
+		config.Proxy = utilnet.NewProxierWithNoProxyCIDR(http.ProxyFromEnvironment)
+	}
+	return config
+}
+
+func newPodDialer(config *rest.Config, clientSet kubernetes.Interface, pod *corev1.Pod) (httpstream.Dialer, error) {
 	url := clientSet.CoreV1().RESTClient().Post().
 		Resource("pods").
 		Namespace(pod.Namespace).
@@ -69,7 +862,7 @@ func PortForward(targetPort int, namespace string, overrides *clientcmd.ConfigOv
 
 	transport, upgrader, err := spdy.RoundTripperFor(config)
 	if err != nil {
-		return -1, fmt.Errorf("could not create round tripper: %w", err)
+		return nil, fmt.Errorf("could not create round tripper: %w", err)
 	}
 	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url)
 
@@ -77,43 +870,12 @@ func PortForward(targetPort int, namespace string, overrides *clientcmd.ConfigOv
 	if !cmdutil.PortForwardWebsockets.IsDisabled() {
 		tunnelingDialer, err := portforward.NewSPDYOverWebsocketDialer(url, config)
 		if err != nil {
-			return -1, fmt.Errorf("could not create tunneling dialer: %w", err)
+			return nil, fmt.Errorf("could not create tunneling dialer: %w", err)
 		}
 		// First attempt tunneling (websocket) dialer, then fallback to spdy dialer.
 		dialer = portforward.NewFallbackDialer(tunnelingDialer, dialer, func(err error) bool {
 			return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
 		})
 	}
-
-	readyChan := make(chan struct{}, 1)
-	failedChan := make(chan error, 1)
-	out := new(bytes.Buffer)
-	errOut := new(bytes.Buffer)
-
-	ln, err := net.Listen("tcp", "localhost:0")
-	if err != nil {
-		return -1, err
-	}
-	port := ln.Addr().(*net.TCPAddr).Port
-	io.Close(ln)
-	forwarder, err := portforward.NewOnAddresses(dialer, []string{"localhost"}, []string{fmt.Sprintf("%d:%d", port, targetPort)}, context.Background().Done(), readyChan, out, errOut)
-	if err != nil {
-		return -1, err
-	}
-
-	go func() {
-		err = forwarder.ForwardPorts()
-		if err != nil {
-			failedChan <- err
-		}
-	}()
-	select {
-	case err = <-failedChan:
-		return -1, err
-	case <-readyChan:
-	}
-	if len(errOut.String()) != 0 {
-		return -1, fmt.Errorf("%s", errOut.String())
-	}
-	return port, nil
+	return dialer, nil
 }
@@ -0,0 +1,1052 @@
+package kube
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/portforward"
+
+	tlsutil "github.com/argoproj/argo-cd/v3/util/tls"
+)
+
+// fakeStream is a stream echoing back the data written to it.
+type fakeStream struct {
+	headers http.Header
+	reader  *io.PipeReader
+	writer  *io.PipeWriter
+}
+
+func newFakeStream(headers http.Header) *fakeStream {
+	reader, writer := io.Pipe()
+	stream := &fakeStream{headers: headers.Clone(), reader: reader, writer: writer}
+	if headers.Get(corev1.StreamType) == corev1.StreamTypeError {
+		// No error is reported by the remote side
+		_ = writer.Close()
+	}
+	return stream
+}
+
+func (s *fakeStream) Read(p []byte) (int, error) {
+	return s.reader.Read(p)
+}
+
+func (s *fakeStream) Write(p []byte) (int, error) {
+	if s.headers.Get(corev1.StreamType) == corev1.StreamTypeError {
+		return len(p), nil
+	}
+	return s.writer.Write(p)
+}
+
+func (s *fakeStream) Close() error {
+	return s.writer.Close()
+}
+
+func (s *fakeStream) Reset() error {
+	_ = s.writer.Close()
+	return s.reader.Close()
+}
+
+func (s *fakeStream) Headers() http.Header {
+	return s.headers
+}
+
+func (s *fakeStream) Identifier() uint32 {
+	return 0
+}
+
+// pipeStream is a data stream whose remote side is served by a function of the test.
+type pipeStream struct {
+	net.Conn
+	headers http.Header
+}
+
+func (s *pipeStream) Reset() error {
+	return s.Close()
+}
+
+func (s *pipeStream) Headers() http.Header {
+	return s.headers
+}
+
+func (s *pipeStream) Identifier() uint32 {
+	return 0
+}
+
+type fakeConnection struct {
+	lock      sync.Mutex
+	headers   []http.Header
+	closeChan chan bool
+	closeOnce sync.Once
+	// serve optionally serves the remote side of the data streams instead of echoing back the data written to them
+	serve func(conn net.Conn)
+}
+
+func newFakeConnection() *fakeConnection {
+	return &fakeConnection{closeChan: make(chan bool)}
+}
+
+func (c *fakeConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.headers = append(c.headers, headers.Clone())
+	if c.serve != nil && headers.Get(corev1.StreamType) == corev1.StreamTypeData {
+		local, remote := net.Pipe()
+		go c.serve(remote)
+		return &pipeStream{Conn: local, headers: headers.Clone()}, nil
+	}
+	return newFakeStream(headers), nil
+}
+
+func (c *fakeConnection) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closeChan)
+	})
+	return nil
+}
+
+func (c *fakeConnection) CloseChan() <-chan bool {
+	return c.closeChan
+}
+
+func (c *fakeConnection) SetIdleTimeout(time.Duration) {}
+
+func (c *fakeConnection) RemoveStreams(...httpstream.Stream) {}
+
+type fakeDialer struct {
+	conn *fakeConnection
+	err  error
+}
+
+func (d *fakeDialer) Dial(...string) (httpstream.Connection, string, error) {
+	if d.err != nil {
+		return nil, "", d.err
+	}
+	return d.conn, portforward.PortForwardProtocolV1Name, nil
+}
+
+func newTestPod(namespace, name string, labels map[string]string, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "main", Ports: ports}},
+		},
+	}
+}
+
+// echo sends a message through the forwarded local port and returns the response of the fake remote side.
+func echo(t *testing.T, port int, message string) string {
+	t.Helper()
+	conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", port))
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte(message))
+	require.NoError(t, err)
+	response := make([]byte, len(message))
+	_, err = io.ReadFull(conn, response)
+	require.NoError(t, err)
+	return string(response)
+}
+
+func TestStartPortForwardNamedPort(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"},
+		corev1.ContainerPort{Name: "metrics", ContainerPort: 8083},
+		corev1.ContainerPort{Name: "http", ContainerPort: 8080},
+	)
+	clientSet := fake.NewClientset(pod)
+	conn := newFakeConnection()
+	var dialedPod *corev1.Pod
+	newDialer := func(pod *corev1.Pod) (httpstream.Dialer, error) {
+		dialedPod = pod
+		return &fakeDialer{conn: conn}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromString("http"),
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+
+	assert.Equal(t, 8080, handle.RemotePort)
+	assert.Positive(t, handle.LocalPort)
+	assert.Equal(t, "argocd-server-1", dialedPod.Name)
+	assert.Equal(t, "ping", echo(t, handle.LocalPort, "ping"))
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+	require.NotEmpty(t, conn.headers)
+	assert.Equal(t, "8080", conn.headers[0].Get(corev1.PortHeader))
+}
+
+func TestStartPortForwardUnknownNamedPort(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"},
+		corev1.ContainerPort{Name: "http", ContainerPort: 8080},
+	)
+	clientSet := fake.NewClientset(pod)
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	_, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromString("grpc"),
+	})
+	require.EqualError(t, err, `pod argocd/argocd-server-1 has no container port named "grpc"`)
+}
+
+func TestStartPortForwardNoPod(t *testing.T) {
+	clientSet := fake.NewClientset()
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	_, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+	})
+	require.ErrorContains(t, err, "cannot find pod with selector: [app=argocd-server]")
+}
+
+func TestStartPortForwardLostConnection(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+	conn := newFakeConnection()
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: conn}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+	require.NoError(t, handle.Err())
+
+	// Simulate the connection to the pod being dropped
+	require.NoError(t, conn.Close())
+
+	select {
+	case <-handle.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("port-forward was not reported as done after losing the connection")
+	}
+	require.ErrorIs(t, handle.Err(), portforward.ErrLostConnectionToPod)
+}
+
+func TestStartPortForwardHealthCheck(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:          []string{"argocd"},
+		PodSelectors:        LabelSelectors("app=argocd-server"),
+		TargetPort:          intstr.FromInt32(8080),
+		HealthCheckInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+
+	require.NoError(t, clientSet.CoreV1().Pods("argocd").Delete(t.Context(), "argocd-server-1", metav1.DeleteOptions{}))
+
+	select {
+	case <-handle.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("port-forward was not reported as done after the pod was deleted")
+	}
+	require.EqualError(t, handle.Err(), "pod argocd/argocd-server-1 no longer exists")
+}
+
+func TestCheckPodHealthTimeout(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	cancelled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		// The API server hangs until the client gives up
+		<-r.Context().Done()
+		close(cancelled)
+	}))
+	defer server.Close()
+	clientSet, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- checkPodHealth(t.Context(), clientSet, pod, 50*time.Millisecond)
+	}()
+	select {
+	case err := <-done:
+		// The pod is checked again on the next tick
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("health check did not time out")
+	}
+	<-cancelled
+}
+
+func TestPortForwardHandleStop(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+	})
+	require.NoError(t, err)
+
+	handle.Stop()
+	handle.Stop()
+
+	select {
+	case <-handle.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("port-forward was not reported as done after being stopped")
+	}
+	require.NoError(t, handle.Err())
+}
+
+func TestStartPortForwardReconnect(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+	var lock sync.Mutex
+	conns := map[string]*fakeConnection{}
+	newDialer := func(pod *corev1.Pod) (httpstream.Dialer, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		conn := newFakeConnection()
+		conns[pod.Name] = conn
+		return &fakeDialer{conn: conn}, nil
+	}
+	connection := func(name string) *fakeConnection {
+		lock.Lock()
+		defer lock.Unlock()
+		return conns[name]
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+		Reconnect:    &wait.Backoff{Duration: 10 * time.Millisecond, Steps: 5},
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+	localPort := handle.LocalPort
+	assert.Equal(t, "ping", echo(t, localPort, "ping"))
+
+	// Simulate the pod being replaced during a rollout
+	require.NoError(t, clientSet.CoreV1().Pods("argocd").Delete(t.Context(), "argocd-server-1", metav1.DeleteOptions{}))
+	_, err = clientSet.CoreV1().Pods("argocd").Create(t.Context(), newTestPod("argocd", "argocd-server-2", map[string]string{"app": "argocd-server"}), metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, connection("argocd-server-1").Close())
+
+	require.Eventually(t, func() bool {
+		return connection("argocd-server-2") != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", localPort))
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, localPort, handle.LocalPort)
+	assert.Equal(t, "pong", echo(t, localPort, "pong"))
+	assert.NoError(t, handle.Err())
+	conn := connection("argocd-server-2")
+	conn.lock.Lock()
+	defer conn.lock.Unlock()
+	assert.NotEmpty(t, conn.headers)
+}
+
+func TestStartPortForwardReconnectAttemptsExhausted(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+	conn := newFakeConnection()
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: conn}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+		Reconnect:    &wait.Backoff{Duration: time.Millisecond, Steps: 3},
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+
+	require.NoError(t, clientSet.CoreV1().Pods("argocd").Delete(t.Context(), "argocd-server-1", metav1.DeleteOptions{}))
+	require.NoError(t, conn.Close())
+
+	select {
+	case <-handle.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("port-forward was not reported as done after failing to reconnect")
+	}
+	require.ErrorContains(t, handle.Err(), "failed to re-establish port-forward after 3 attempts: cannot find pod with selector: [app=argocd-server]")
+}
+
+func TestStartPortForwardCallbacks(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+	conn := newFakeConnection()
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: conn}, nil
+	}
+	readyPorts := make(chan int, 1)
+	errs := make(chan error, 1)
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+		OnReady: func(port int) {
+			readyPorts <- port
+		},
+		OnError: func(err error) {
+			errs <- err
+		},
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+
+	select {
+	case port := <-readyPorts:
+		assert.Equal(t, handle.LocalPort, port)
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnReady was not called")
+	}
+	assert.Empty(t, errs)
+
+	require.NoError(t, conn.Close())
+
+	select {
+	case err := <-errs:
+		require.ErrorIs(t, err, portforward.ErrLostConnectionToPod)
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnError was not called after losing the connection")
+	}
+	<-handle.Done()
+	assert.Empty(t, readyPorts)
+}
+
+func TestStartPortForwardCallbacksOnStop(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+	errs := make(chan error, 1)
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+		OnError: func(err error) {
+			errs <- err
+		},
+	})
+	require.NoError(t, err)
+
+	handle.Stop()
+	<-handle.Done()
+	assert.Empty(t, errs)
+}
+
+func TestStartPortForwardNamespaces(t *testing.T) {
+	clientSet := fake.NewClientset(
+		newTestPod("team-a", "argocd-server-a", map[string]string{"app": "argocd-server"}),
+		newTestPod("team-b", "argocd-repo-server-b", map[string]string{"app": "argocd-repo-server"}),
+		newTestPod("team-c", "argocd-repo-server-c", map[string]string{"app": "argocd-repo-server"}),
+	)
+	var dialedPod *corev1.Pod
+	newDialer := func(pod *corev1.Pod) (httpstream.Dialer, error) {
+		dialedPod = pod
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd", "team-a", "team-c", "team-b"},
+		PodSelectors: LabelSelectors("app=argocd-repo-server"),
+		TargetPort:   intstr.FromInt32(8081),
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+
+	assert.Equal(t, "team-c", dialedPod.Namespace)
+	assert.Equal(t, "argocd-repo-server-c", dialedPod.Name)
+}
+
+func TestStartPortForwardFieldSelector(t *testing.T) {
+	clientSet := fake.NewClientset(newTestPod("argocd", "argocd-repo-server-1", map[string]string{"app": "argocd-repo-server"}))
+	var restrictions []k8stesting.ListRestrictions
+	clientSet.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		restrictions = append(restrictions, action.(k8stesting.ListAction).GetListRestrictions())
+		return false, nil, nil
+	})
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: []PodSelector{{LabelSelector: "app=argocd-repo-server", FieldSelector: "status.phase=Running"}},
+		TargetPort:   intstr.FromInt32(8081),
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+
+	require.Len(t, restrictions, 1)
+	assert.Equal(t, "app=argocd-repo-server", restrictions[0].Labels.String())
+	assert.Equal(t, "status.phase=Running", restrictions[0].Fields.String())
+}
+
+func TestStartPortForwardPod(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	pod.Spec.NodeName = "node-1"
+	pod.Spec.Containers[0].Image = "quay.io/argoproj/argocd:latest"
+	clientSet := fake.NewClientset(pod)
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+
+	require.NotNil(t, handle.Pod)
+	assert.Equal(t, pod.ObjectMeta, handle.Pod.ObjectMeta)
+	assert.Equal(t, pod.Spec, handle.Pod.Spec)
+}
+
+func TestStartPortForwardProbeTLS(t *testing.T) {
+	cert, err := tlsutil.GenerateX509KeyPair(tlsutil.CertOptions{Hosts: []string{"localhost"}, Organization: "Argo CD", IsCA: true})
+	require.NoError(t, err)
+	serveTLS := func(conn net.Conn) {
+		defer conn.Close()
+		_ = tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{*cert}}).Handshake()
+	}
+	startWithRemote := func(t *testing.T, serve func(net.Conn), probeTLS bool) *PortForwardHandle {
+		t.Helper()
+		clientSet := fake.NewClientset(newTestPod("argocd", "argocd-repo-server-1", map[string]string{"app": "argocd-repo-server"}))
+		newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+			conn := newFakeConnection()
+			conn.serve = serve
+			return &fakeDialer{conn: conn}, nil
+		}
+		handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-repo-server"),
+			TargetPort:   intstr.FromInt32(8081),
+			ProbeTLS:     probeTLS,
+		})
+		require.NoError(t, err)
+		t.Cleanup(handle.Stop)
+		return handle
+	}
+
+	t.Run("TLS", func(t *testing.T) {
+		handle := startWithRemote(t, serveTLS, true)
+		assert.True(t, handle.RemoteTLS)
+	})
+	t.Run("ClientCertificateRequired", func(t *testing.T) {
+		handle := startWithRemote(t, func(conn net.Conn) {
+			defer conn.Close()
+			_ = tls.Server(conn, &tls.Config{
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   tls.RequireAnyClientCert,
+				MaxVersion:   tls.VersionTLS12,
+			}).Handshake()
+		}, true)
+		assert.True(t, handle.RemoteTLS)
+	})
+	t.Run("Plaintext", func(t *testing.T) {
+		handle := startWithRemote(t, nil, true)
+		assert.False(t, handle.RemoteTLS)
+		assert.Equal(t, "ping", echo(t, handle.LocalPort, "ping"))
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		handle := startWithRemote(t, serveTLS, false)
+		assert.False(t, handle.RemoteTLS)
+	})
+}
+
+func TestStartPortForwardListTimeout(t *testing.T) {
+	// newSlowClientSet returns a client listing the pods after a delay, and then failing with the given error
+	newSlowClientSet := func(err error) *fake.Clientset {
+		clientSet := fake.NewClientset(newTestPod("argocd", "argocd-repo-server-1", map[string]string{"app": "argocd-repo-server"}))
+		clientSet.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+			time.Sleep(50 * time.Millisecond)
+			return err != nil, nil, err
+		})
+		return clientSet
+	}
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+	opts := PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-repo-server"),
+		TargetPort:   intstr.FromInt32(8081),
+	}
+
+	t.Run("TimedOut", func(t *testing.T) {
+		opts := opts
+		opts.ListTimeout = 10 * time.Millisecond
+		// The fake client ignores the context, so it fails like the real client once the context expires
+		_, err := startPortForward(newSlowClientSet(context.DeadlineExceeded), newDialer, opts)
+		require.EqualError(t, err, `timed out listing pods for selector "app=argocd-repo-server": context deadline exceeded`)
+	})
+	t.Run("NoTimeout", func(t *testing.T) {
+		handle, err := startPortForward(newSlowClientSet(nil), newDialer, opts)
+		require.NoError(t, err)
+		handle.Stop()
+	})
+}
+
+func TestPodSelectorString(t *testing.T) {
+	assert.Equal(t, "app=argocd-server", PodSelector{LabelSelector: "app=argocd-server"}.String())
+	assert.Equal(t, "app=argocd-server,status.phase=Running", PodSelector{LabelSelector: "app=argocd-server", FieldSelector: "status.phase=Running"}.String())
+}
+
+func newReadyTestPod(name string, startTime time.Time, ready bool) corev1.Pod {
+	pod := newTestPod("argocd", name, map[string]string{"app": "argocd-repo-server"})
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	pod.Status = corev1.PodStatus{
+		StartTime:  &metav1.Time{Time: startTime},
+		Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+	}
+	return *pod
+}
+
+func TestChoosePod(t *testing.T) {
+	now := time.Now()
+	pods := []corev1.Pod{
+		newReadyTestPod("oldest", now.Add(-time.Hour), true),
+		newReadyTestPod("newest-not-ready", now, false),
+		newReadyTestPod("newest", now.Add(-time.Minute), true),
+		newReadyTestPod("older", now.Add(-30*time.Minute), true),
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		pod, err := choosePod(pods, "")
+		require.NoError(t, err)
+		assert.Equal(t, "oldest", pod.Name)
+	})
+	t.Run("First", func(t *testing.T) {
+		pod, err := choosePod(pods, PodSelectionFirst)
+		require.NoError(t, err)
+		assert.Equal(t, "oldest", pod.Name)
+	})
+	t.Run("Newest", func(t *testing.T) {
+		pod, err := choosePod(pods, PodSelectionNewest)
+		require.NoError(t, err)
+		assert.Equal(t, "newest", pod.Name)
+	})
+	t.Run("NewestWithoutReadyPod", func(t *testing.T) {
+		pod, err := choosePod([]corev1.Pod{
+			newReadyTestPod("older", now.Add(-time.Hour), false),
+			newReadyTestPod("newer", now, false),
+		}, PodSelectionNewest)
+		require.NoError(t, err)
+		assert.Equal(t, "newer", pod.Name)
+	})
+	t.Run("Random", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			pod, err := choosePod(pods, PodSelectionRandom)
+			require.NoError(t, err)
+			assert.NotEqual(t, "newest-not-ready", pod.Name)
+		}
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := choosePod(pods, "oldest")
+		require.EqualError(t, err, `unknown pod selection strategy "oldest"`)
+	})
+}
+
+func TestFindReadyPod(t *testing.T) {
+	now := time.Now()
+	notReady := newReadyTestPod("argocd-repo-server-1", now, false)
+	ready := newReadyTestPod("argocd-repo-server-2", now, true)
+	newContext := func(t *testing.T) context.Context {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		t.Cleanup(cancel)
+		return ctx
+	}
+
+	t.Run("NoMatch", func(t *testing.T) {
+		clientSet := fake.NewClientset(&ready)
+		_, err := FindReadyPod(newContext(t), clientSet, "argocd", "app=argocd-server")
+		require.EqualError(t, err, "cannot find pod with selector [app=argocd-server] in namespace argocd")
+	})
+	t.Run("NotReady", func(t *testing.T) {
+		clientSet := fake.NewClientset(&notReady)
+		_, err := FindReadyPod(newContext(t), clientSet, "argocd", "app=argocd-repo-server")
+		require.EqualError(t, err, "pod argocd/argocd-repo-server-1 matching selector [app=argocd-repo-server] is not ready: context deadline exceeded")
+	})
+	t.Run("Ready", func(t *testing.T) {
+		clientSet := fake.NewClientset(&notReady, &ready)
+		pod, err := FindReadyPod(newContext(t), clientSet, "argocd", "app=argocd-server", "app=argocd-repo-server")
+		require.NoError(t, err)
+		assert.Equal(t, "argocd-repo-server-2", pod.Name)
+	})
+	t.Run("ListError", func(t *testing.T) {
+		clientSet := fake.NewClientset(&ready)
+		clientSet.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("forbidden")
+		})
+		_, err := FindReadyPod(newContext(t), clientSet, "argocd", "app=argocd-repo-server")
+		require.EqualError(t, err, "forbidden")
+	})
+}
+
+func TestStartPortForwardNewestPod(t *testing.T) {
+	now := time.Now()
+	oldest := newReadyTestPod("argocd-repo-server-1", now.Add(-time.Hour), true)
+	newest := newReadyTestPod("argocd-repo-server-2", now, true)
+	clientSet := fake.NewClientset(&oldest, &newest)
+	var dialedPod *corev1.Pod
+	newDialer := func(pod *corev1.Pod) (httpstream.Dialer, error) {
+		dialedPod = pod
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-repo-server"),
+		PodSelection: PodSelectionNewest,
+		TargetPort:   intstr.FromInt32(8081),
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+
+	assert.Equal(t, "argocd-repo-server-2", dialedPod.Name)
+}
+
+func TestStartPortForwardReadyTimeout(t *testing.T) {
+	now := time.Now()
+	notReady := newReadyTestPod("argocd-repo-server-1", now, false)
+	ready := newReadyTestPod("argocd-repo-server-2", now.Add(-time.Hour), true)
+	var dialedPod *corev1.Pod
+	newDialer := func(pod *corev1.Pod) (httpstream.Dialer, error) {
+		dialedPod = pod
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	t.Run("Ready", func(t *testing.T) {
+		handle, err := startPortForward(fake.NewClientset(&notReady, &ready), newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-repo-server"),
+			PodSelection: PodSelectionNewest,
+			TargetPort:   intstr.FromInt32(8081),
+			ReadyTimeout: time.Second,
+		})
+		require.NoError(t, err)
+		defer handle.Stop()
+		assert.Equal(t, "argocd-repo-server-2", dialedPod.Name)
+	})
+	t.Run("NotReady", func(t *testing.T) {
+		_, err := startPortForward(fake.NewClientset(&notReady), newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-repo-server"),
+			TargetPort:   intstr.FromInt32(8081),
+			ReadyTimeout: 50 * time.Millisecond,
+		})
+		require.EqualError(t, err, "pod argocd/argocd-repo-server-1 matching selector [app=argocd-repo-server] is not ready: context deadline exceeded")
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		start := time.Now()
+		_, err := startPortForward(fake.NewClientset(&ready), newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-server"),
+			TargetPort:   intstr.FromInt32(8081),
+			ReadyTimeout: time.Minute,
+		})
+		require.ErrorContains(t, err, "cannot find pod with selector: [app=argocd-server] - use the --{component}-name flag")
+		assert.Less(t, time.Since(start), readyPodPollInterval)
+	})
+}
+
+func TestStartPortForwardSelectPod(t *testing.T) {
+	clientSet := fake.NewClientset(
+		newTestPod("argocd", "argocd-repo-server-1", map[string]string{"app": "argocd-repo-server"}),
+		newTestPod("argocd", "argocd-repo-server-2", map[string]string{"app": "argocd-repo-server"}),
+		newTestPod("argocd", "argocd-repo-server-3", map[string]string{"app": "argocd-repo-server"}),
+	)
+	var dialedPod *corev1.Pod
+	newDialer := func(pod *corev1.Pod) (httpstream.Dialer, error) {
+		dialedPod = pod
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	t.Run("Last", func(t *testing.T) {
+		handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-repo-server"),
+			TargetPort:   intstr.FromInt32(8081),
+			SelectPod: func(pods []corev1.Pod) (*corev1.Pod, error) {
+				return &pods[len(pods)-1], nil
+			},
+		})
+		require.NoError(t, err)
+		defer handle.Stop()
+		assert.Equal(t, "argocd-repo-server-3", dialedPod.Name)
+	})
+	t.Run("NoPod", func(t *testing.T) {
+		_, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-repo-server"),
+			TargetPort:   intstr.FromInt32(8081),
+			SelectPod: func([]corev1.Pod) (*corev1.Pod, error) {
+				return nil, nil
+			},
+		})
+		require.ErrorContains(t, err, "cannot find pod with selector: [app=argocd-repo-server]")
+	})
+	t.Run("Error", func(t *testing.T) {
+		_, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-repo-server"),
+			TargetPort:   intstr.FromInt32(8081),
+			SelectPod: func([]corev1.Pod) (*corev1.Pod, error) {
+				return nil, errors.New("no pod on the requested node")
+			},
+		})
+		require.EqualError(t, err, "no pod on the requested node")
+	})
+}
+
+func TestStartPortForwardTransferredBytes(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+	newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+		return &fakeDialer{conn: newFakeConnection()}, nil
+	}
+
+	handle, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+	})
+	require.NoError(t, err)
+	defer handle.Stop()
+	assert.Zero(t, handle.BytesSent())
+	assert.Zero(t, handle.BytesReceived())
+
+	assert.Equal(t, "ping", echo(t, handle.LocalPort, "ping"))
+	assert.Equal(t, "hello world", echo(t, handle.LocalPort, "hello world"))
+
+	assert.Eventually(t, func() bool {
+		return handle.BytesSent() == 15 && handle.BytesReceived() == 15
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStartPortForwardUpgradeError(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	clientSet := fake.NewClientset(pod)
+
+	t.Run("UpgradeFailure", func(t *testing.T) {
+		newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+			return &fakeDialer{err: &httpstream.UpgradeFailureError{Cause: errors.New("websocket: bad handshake")}}, nil
+		}
+
+		_, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-server"),
+			TargetPort:   intstr.FromInt32(8080),
+		})
+		var upgradeErr *UpgradeError
+		require.ErrorAs(t, err, &upgradeErr)
+		require.EqualError(t, err, "error upgrading connection: unable to upgrade streaming request: websocket: bad handshake")
+		assert.True(t, httpstream.IsUpgradeFailure(err))
+	})
+	t.Run("OtherFailure", func(t *testing.T) {
+		newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+			return &fakeDialer{err: errors.New("connection refused")}, nil
+		}
+
+		_, err := startPortForward(clientSet, newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-server"),
+			TargetPort:   intstr.FromInt32(8080),
+		})
+		require.EqualError(t, err, "error upgrading connection: connection refused")
+		var upgradeErr *UpgradeError
+		assert.NotErrorAs(t, err, &upgradeErr)
+	})
+}
+
+func TestWithPortForwardProxy(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://kubernetes.example.com/api/v1/namespaces/argocd/pods/argocd-server-1/portforward", http.NoBody)
+	require.NoError(t, err)
+	proxyURL, err := url.Parse("http://proxy.example.com:3128")
+	require.NoError(t, err)
+	config := &rest.Config{Host: "https://kubernetes.example.com", Proxy: http.ProxyURL(proxyURL)}
+
+	t.Run("FromConfig", func(t *testing.T) {
+		proxyConfig := withPortForwardProxy(config, false)
+		require.NotNil(t, proxyConfig.Proxy)
+		proxy, err := proxyConfig.Proxy(req)
+		require.NoError(t, err)
+		assert.Equal(t, proxyURL, proxy)
+	})
+	t.Run("FromEnvironment", func(t *testing.T) {
+		proxyConfig := withPortForwardProxy(&rest.Config{Host: "https://kubernetes.example.com"}, false)
+		assert.NotNil(t, proxyConfig.Proxy)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		proxyConfig := withPortForwardProxy(config, true)
+		require.NotNil(t, proxyConfig.Proxy)
+		proxy, err := proxyConfig.Proxy(req)
+		require.NoError(t, err)
+		assert.Nil(t, proxy)
+		// The original config is left unchanged
+		proxy, err = config.Proxy(req)
+		require.NoError(t, err)
+		assert.Equal(t, proxyURL, proxy)
+	})
+}
+
+func TestNewPodDialer(t *testing.T) {
+	config := withPortForwardProxy(&rest.Config{Host: "https://kubernetes.example.com"}, true)
+	clientSet, err := kubernetes.NewForConfig(config)
+	require.NoError(t, err)
+
+	dialer, err := newPodDialer(config, clientSet, newTestPod("argocd", "argocd-server-1", nil))
+	require.NoError(t, err)
+	assert.NotNil(t, dialer)
+}
+
+func TestStartPortForwardWithConfig(t *testing.T) {
+	pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+	var lock sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, r.URL.Path)
+		lock.Unlock()
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/api/v1/namespaces/argocd/pods":
+			w.Header().Set("Content-Type", "application/json")
+			assert.NoError(t, json.NewEncoder(w).Encode(corev1.PodList{Items: []corev1.Pod{*pod}}))
+		default:
+			http.Error(w, "forbidden", http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+	config := &rest.Config{Host: server.URL, BearerToken: "test-token"}
+
+	_, err := StartPortForwardWithConfig(config, PortForwardOptions{
+		Namespaces:   []string{"argocd"},
+		PodSelectors: LabelSelectors("app=argocd-server"),
+		TargetPort:   intstr.FromInt32(8080),
+		DisableProxy: true,
+	})
+	require.Error(t, err)
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.NotEmpty(t, requests)
+	assert.Equal(t, "/api/v1/namespaces/argocd/pods", requests[0])
+	assert.Contains(t, requests, "/api/v1/namespaces/argocd/pods/argocd-server-1/portforward")
+}
+
+func TestPortForwardHandleStopOnSignal(t *testing.T) {
+	start := func(t *testing.T) *PortForwardHandle {
+		t.Helper()
+		pod := newTestPod("argocd", "argocd-server-1", map[string]string{"app": "argocd-server"})
+		newDialer := func(*corev1.Pod) (httpstream.Dialer, error) {
+			return &fakeDialer{conn: newFakeConnection()}, nil
+		}
+		handle, err := startPortForward(fake.NewClientset(pod), newDialer, PortForwardOptions{
+			Namespaces:   []string{"argocd"},
+			PodSelectors: LabelSelectors("app=argocd-server"),
+			TargetPort:   intstr.FromInt32(8080),
+		})
+		require.NoError(t, err)
+		return handle
+	}
+
+	t.Run("Signal", func(t *testing.T) {
+		handle := start(t)
+		defer handle.Stop()
+		sigCh := make(chan os.Signal, 1)
+		stopped := make(chan struct{})
+		go func() {
+			handle.stopOnSignal(sigCh, make(chan struct{}))
+			close(stopped)
+		}()
+
+		sigCh <- syscall.SIGTERM
+
+		select {
+		case <-stopped:
+		case <-time.After(5 * time.Second):
+			t.Fatal("port-forward was not stopped after receiving a signal")
+		}
+		require.NoError(t, handle.Err())
+		_, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", handle.LocalPort))
+		require.Error(t, err)
+	})
+	t.Run("Released", func(t *testing.T) {
+		handle := start(t)
+		defer handle.Stop()
+
+		release := handle.StopOnSignals()
+		release()
+		release()
+
+		assert.Equal(t, "ping", echo(t, handle.LocalPort, "ping"))
+		select {
+		case <-handle.Done():
+			t.Fatal("port-forward was stopped without receiving a signal")
+		default:
+		}
+	})
+}
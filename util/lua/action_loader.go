@@ -0,0 +1,128 @@
+package lua
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ActionLayer is a source of resource action definitions.
+type ActionLayer string
+
+const (
+	// BuiltinActionLayer holds the embedded resource customizations, or those of the ScriptLoader of the VM
+	BuiltinActionLayer ActionLayer = "built-in"
+	// BundledActionLayer holds the actions read by the Bundled loader of the ActionLoader
+	BundledActionLayer ActionLayer = "bundled"
+	// InlineActionLayer holds the actions defined by the common.AnnotationResourceActions annotation of the resource
+	InlineActionLayer ActionLayer = "inline"
+)
+
+// ActionLoader resolves the resource actions from several layers. From the lowest to the highest precedence, an
+// action is read from the built-in actions, the bundled actions and the inline actions of the resource, and the
+// definition of the highest layer wins as a whole: its scripts are never combined with those of a lower layer.
+type ActionLoader struct {
+	// Bundled optionally loads actions from a file system laid out like the resource_customizations directory
+	Bundled *ScriptLoader
+	// AllowInline enables reading the actions defined by the common.AnnotationResourceActions annotation. Since anyone
+	// able to edit a resource can then define the scripts run on it, it is disabled by default.
+	AllowInline bool
+}
+
+// ResolvedResourceAction is an action resolved by the ActionLoader.
+type ResolvedResourceAction struct {
+	Definition appv1.ResourceActionDefinition
+	// Layer is the layer the definition was read from
+	Layer ActionLayer
+	// Overridden are the lower layers which also define the action, from the lowest to the highest
+	Overridden []ActionLayer
+}
+
+// ResolveResourceAction returns the action with the given name from the highest layer of the ActionLoader defining it,
+// along with the lower layers it overrides. It returns a ScriptDoesNotExistError if no layer defines the action.
+func (vm VM) ResolveResourceAction(obj *unstructured.Unstructured, actionName string) (ResolvedResourceAction, error) {
+	var loader ActionLoader
+	if vm.ActionLoader != nil {
+		loader = *vm.ActionLoader
+	}
+	layers := []struct {
+		layer ActionLayer
+		get   func() (*appv1.ResourceActionDefinition, error)
+	}{
+		{BuiltinActionLayer, func() (*appv1.ResourceActionDefinition, error) {
+			return optionalResourceAction(vm.getPredefinedResourceAction(obj, actionName))
+		}},
+		{BundledActionLayer, func() (*appv1.ResourceActionDefinition, error) {
+			if loader.Bundled == nil {
+				return nil, nil
+			}
+			bundledVM := vm
+			bundledVM.ScriptLoader = loader.Bundled
+			return optionalResourceAction(bundledVM.getPredefinedResourceAction(obj, actionName))
+		}},
+		{InlineActionLayer, func() (*appv1.ResourceActionDefinition, error) {
+			if !loader.AllowInline {
+				return nil, nil
+			}
+			return getInlineResourceAction(obj, actionName)
+		}},
+	}
+
+	var resolved ResolvedResourceAction
+	found := false
+	for _, l := range layers {
+		action, err := l.get()
+		if err != nil {
+			return ResolvedResourceAction{}, fmt.Errorf("error reading %s action %q: %w", l.layer, actionName, err)
+		}
+		if action == nil {
+			continue
+		}
+		if found {
+			resolved.Overridden = append(resolved.Overridden, resolved.Layer)
+		}
+		resolved.Definition = *action
+		resolved.Layer = l.layer
+		found = true
+	}
+	if !found {
+		return ResolvedResourceAction{}, &ScriptDoesNotExistError{ScriptName: fmt.Sprintf("%s/actions/%s", GetConfigMapKey(obj.GroupVersionKind()), actionName)}
+	}
+	return resolved, nil
+}
+
+// optionalResourceAction returns nil instead of a ScriptDoesNotExistError.
+func optionalResourceAction(action appv1.ResourceActionDefinition, err error) (*appv1.ResourceActionDefinition, error) {
+	if err != nil {
+		var doesNotExistErr *ScriptDoesNotExistError
+		if errors.As(err, &doesNotExistErr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &action, nil
+}
+
+// getInlineResourceAction returns the action with the given name defined by the common.AnnotationResourceActions
+// annotation of the resource, or nil if the resource does not define it.
+func getInlineResourceAction(obj *unstructured.Unstructured, actionName string) (*appv1.ResourceActionDefinition, error) {
+	value, ok := obj.GetAnnotations()[common.AnnotationResourceActions]
+	if !ok {
+		return nil, nil
+	}
+	var definitions []appv1.ResourceActionDefinition
+	if err := yaml.Unmarshal([]byte(value), &definitions); err != nil {
+		return nil, fmt.Errorf("error parsing annotation %s: %w", common.AnnotationResourceActions, err)
+	}
+	for i := range definitions {
+		if definitions[i].Name == actionName {
+			return &definitions[i], nil
+		}
+	}
+	return nil, nil
+}
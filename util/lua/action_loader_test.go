@@ -0,0 +1,91 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestResolveResourceAction(t *testing.T) {
+	dir := t.TempDir()
+	writeScript(t, dir, "argoproj.io/Rollout/actions/restart/action.lua", "return 'bundled'")
+	writeScript(t, dir, "argoproj.io/Rollout/actions/bundled/action.lua", "return 'bundled'")
+	bundled, err := NewDirScriptLoader(dir)
+	require.NoError(t, err)
+	vm := VM{ActionLoader: &ActionLoader{Bundled: bundled, AllowInline: true}}
+	testObj := StrToUnstructured(objJSON)
+
+	builtin, err := VM{}.GetResourceAction(testObj, "restart")
+	require.NoError(t, err)
+
+	t.Run("Builtin", func(t *testing.T) {
+		resolved, err := vm.ResolveResourceAction(testObj, "resume")
+		require.NoError(t, err)
+		assert.Equal(t, BuiltinActionLayer, resolved.Layer)
+		assert.Empty(t, resolved.Overridden)
+	})
+	t.Run("BundledOverridesBuiltin", func(t *testing.T) {
+		resolved, err := vm.ResolveResourceAction(testObj, "restart")
+		require.NoError(t, err)
+		assert.Equal(t, BundledActionLayer, resolved.Layer)
+		assert.Equal(t, []ActionLayer{BuiltinActionLayer}, resolved.Overridden)
+		assert.Equal(t, "return 'bundled'", resolved.Definition.ActionLua)
+	})
+	t.Run("InlineOverridesBundled", func(t *testing.T) {
+		obj := testObj.DeepCopy()
+		obj.SetAnnotations(map[string]string{common.AnnotationResourceActions: `
+- name: restart
+  action.lua: return 'inline'
+`})
+		resolved, err := vm.ResolveResourceAction(obj, "restart")
+		require.NoError(t, err)
+		assert.Equal(t, InlineActionLayer, resolved.Layer)
+		assert.Equal(t, []ActionLayer{BuiltinActionLayer, BundledActionLayer}, resolved.Overridden)
+		assert.Equal(t, "return 'inline'", resolved.Definition.ActionLua)
+
+		action, err := vm.GetResourceAction(obj, "restart")
+		require.NoError(t, err)
+		assert.Equal(t, "return 'inline'", action.ActionLua)
+
+		t.Run("Disabled", func(t *testing.T) {
+			vm := VM{ActionLoader: &ActionLoader{Bundled: bundled}}
+			action, err := vm.GetResourceAction(obj, "restart")
+			require.NoError(t, err)
+			assert.Equal(t, "return 'bundled'", action.ActionLua)
+		})
+		t.Run("ResourceOverridesTakePrecedence", func(t *testing.T) {
+			vm := vm
+			vm.ResourceOverrides = map[string]appv1.ResourceOverride{
+				"argoproj.io/Rollout": {Actions: "definitions:\n- name: restart\n  action.lua: return 'override'\n"},
+			}
+			action, err := vm.GetResourceAction(obj, "restart")
+			require.NoError(t, err)
+			assert.Equal(t, "return 'override'", action.ActionLua)
+		})
+	})
+	t.Run("BundledOnly", func(t *testing.T) {
+		action, err := vm.GetResourceAction(testObj, "bundled")
+		require.NoError(t, err)
+		assert.Equal(t, "return 'bundled'", action.ActionLua)
+	})
+	t.Run("WithoutActionLoader", func(t *testing.T) {
+		action, err := VM{}.GetResourceAction(testObj, "restart")
+		require.NoError(t, err)
+		assert.Equal(t, builtin, action)
+	})
+	t.Run("Missing", func(t *testing.T) {
+		_, err := vm.GetResourceAction(testObj, "missing")
+		var doesNotExist *ScriptDoesNotExistError
+		require.ErrorAs(t, err, &doesNotExist)
+	})
+	t.Run("InvalidAnnotation", func(t *testing.T) {
+		obj := testObj.DeepCopy()
+		obj.SetAnnotations(map[string]string{common.AnnotationResourceActions: "name: restart"})
+		_, err := vm.GetResourceAction(obj, "restart")
+		require.ErrorContains(t, err, "error reading inline action \"restart\": error parsing annotation argocd.argoproj.io/resource-actions")
+	})
+}
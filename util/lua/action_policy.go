@@ -0,0 +1,61 @@
+package lua
+
+import (
+	"fmt"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+// ActionPolicy restricts the actions which can be run by a VM. Both lists hold action names or glob patterns. Denied
+// actions take precedence over allowed ones. The policy is built by the callers of the VM: AppProjects do not declare
+// action policies, so the API server does not enforce any.
+type ActionPolicy struct {
+	// Allowed are the actions which can be run. All the actions are allowed if it is empty.
+	Allowed []string
+	// Denied are the actions which cannot be run, even if they are allowed.
+	Denied []string
+}
+
+// Permits returns whether the policy permits running the named action. A nil policy permits all the actions.
+func (p *ActionPolicy) Permits(actionName string) bool {
+	if p == nil {
+		return true
+	}
+	for _, pattern := range p.Denied {
+		if glob.Match(pattern, actionName) {
+			return false
+		}
+	}
+	if len(p.Allowed) == 0 {
+		return true
+	}
+	for _, pattern := range p.Allowed {
+		if glob.Match(pattern, actionName) {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns an error if the policy does not permit running the named action.
+func (p *ActionPolicy) check(actionName string) error {
+	if !p.Permits(actionName) {
+		return fmt.Errorf("action %q is not permitted in this project", actionName)
+	}
+	return nil
+}
+
+// filter returns the actions the policy permits.
+func (p *ActionPolicy) filter(actions []appv1.ResourceAction) []appv1.ResourceAction {
+	if p == nil {
+		return actions
+	}
+	permitted := make([]appv1.ResourceAction, 0, len(actions))
+	for _, action := range actions {
+		if p.Permits(action.Name) {
+			permitted = append(permitted, action)
+		}
+	}
+	return permitted
+}
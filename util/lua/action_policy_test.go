@@ -0,0 +1,81 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestActionPolicyPermits(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		policy    *ActionPolicy
+		permitted []string
+		denied    []string
+	}{
+		{name: "Nil", permitted: []string{"restart", "pause"}},
+		{name: "Empty", policy: &ActionPolicy{}, permitted: []string{"restart", "pause"}},
+		{name: "Allowed", policy: &ActionPolicy{Allowed: []string{"restart", "scale-*"}}, permitted: []string{"restart", "scale-up"}, denied: []string{"pause"}},
+		{name: "Denied", policy: &ActionPolicy{Denied: []string{"delete-*"}}, permitted: []string{"restart"}, denied: []string{"delete-pods"}},
+		{name: "DenyPrecedence", policy: &ActionPolicy{Allowed: []string{"*"}, Denied: []string{"restart"}}, permitted: []string{"pause"}, denied: []string{"restart"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, actionName := range tc.permitted {
+				assert.True(t, tc.policy.Permits(actionName), actionName)
+			}
+			for _, actionName := range tc.denied {
+				assert.False(t, tc.policy.Permits(actionName), actionName)
+			}
+		})
+	}
+}
+
+func TestExecuteResourceActionDefinitionWithPolicy(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	action := appv1.ResourceActionDefinition{Name: "restart", ActionLua: "return obj"}
+
+	t.Run("Allowed", func(t *testing.T) {
+		vm := VM{ActionPolicy: &ActionPolicy{Allowed: []string{"re*"}}}
+		_, err := vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.NoError(t, err)
+	})
+	t.Run("NotAllowed", func(t *testing.T) {
+		vm := VM{ActionPolicy: &ActionPolicy{Allowed: []string{"pause"}}}
+		_, err := vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.EqualError(t, err, `action "restart" is not permitted in this project`)
+	})
+	t.Run("DeniedAndAllowed", func(t *testing.T) {
+		vm := VM{ActionPolicy: &ActionPolicy{Allowed: []string{"restart"}, Denied: []string{"restart"}}}
+		_, err := vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.EqualError(t, err, `action "restart" is not permitted in this project`)
+	})
+}
+
+func TestExecuteResourceActionDiscoveryWithPolicy(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	names := func(actions []appv1.ResourceAction) []string {
+		var names []string
+		for _, action := range actions {
+			names = append(names, action.Name)
+		}
+		return names
+	}
+	policy := &ActionPolicy{Denied: []string{"resume"}}
+
+	actions, err := VM{ActionPolicy: policy}.ExecuteResourceActionDiscovery(testObj, []string{validDiscoveryLua})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"scale"}, names(actions))
+
+	t.Run("Cached", func(t *testing.T) {
+		cache := NewDiscoveryCache(10)
+		actions, err := VM{ActionPolicy: policy, DiscoveryCache: cache}.ExecuteResourceActionDiscovery(testObj, []string{validDiscoveryLua})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"scale"}, names(actions))
+		actions, err = VM{DiscoveryCache: cache}.ExecuteResourceActionDiscovery(testObj, []string{validDiscoveryLua})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"resume", "scale"}, names(actions))
+	})
+}
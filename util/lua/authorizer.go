@@ -0,0 +1,36 @@
+package lua
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Authorizer decides whether the subject running actions, e.g. the user calling the API server, is authorized to run
+// them.
+type Authorizer interface {
+	// Authorize returns whether the subject is authorized to run the action on the resource. The verb has the form
+	// action/<group>/<kind>/<action name>, like the actions of the RBAC policies.
+	Authorize(verb string, obj *unstructured.Unstructured) bool
+}
+
+// AuthorizerFunc adapts a function to the Authorizer interface.
+type AuthorizerFunc func(verb string, obj *unstructured.Unstructured) bool
+
+// Authorize calls f(verb, obj).
+func (f AuthorizerFunc) Authorize(verb string, obj *unstructured.Unstructured) bool {
+	return f(verb, obj)
+}
+
+// authorizeAction returns an error if an authorizer is set and does not authorize running the named action on the
+// resource.
+func (vm VM) authorizeAction(obj *unstructured.Unstructured, actionName string) error {
+	if vm.Authorizer == nil {
+		return nil
+	}
+	gvk := obj.GroupVersionKind()
+	if !vm.Authorizer.Authorize(fmt.Sprintf("action/%s/%s/%s", gvk.Group, gvk.Kind, actionName), obj) {
+		return fmt.Errorf("not authorized to run action %q on %s %s/%s", actionName, gvk.GroupKind(), obj.GetNamespace(), obj.GetName())
+	}
+	return nil
+}
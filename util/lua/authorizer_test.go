@@ -0,0 +1,102 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/grpc"
+)
+
+func TestExecuteResourceActionDefinitionWithAuthorizer(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	action := appv1.ResourceActionDefinition{Name: "resume", ActionLua: "return obj"}
+
+	t.Run("Allowed", func(t *testing.T) {
+		var verbs []string
+		vm := VM{Authorizer: AuthorizerFunc(func(verb string, obj *unstructured.Unstructured) bool {
+			verbs = append(verbs, verb)
+			assert.Equal(t, testObj, obj)
+			return true
+		})}
+		_, err := vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"action/argoproj.io/Rollout/resume"}, verbs)
+	})
+	t.Run("Denied", func(t *testing.T) {
+		vm := VM{Authorizer: AuthorizerFunc(func(string, *unstructured.Unstructured) bool {
+			return false
+		})}
+		_, err := vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.EqualError(t, err, `not authorized to run action "resume" on Rollout.argoproj.io default/helm-guestbook`)
+	})
+	t.Run("NoAuthorizer", func(t *testing.T) {
+		_, err := VM{}.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestExecuteResourceActionWithAuthorizer(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+
+	t.Run("Allowed", func(t *testing.T) {
+		var verbs []string
+		vm := VM{Authorizer: AuthorizerFunc(func(verb string, _ *unstructured.Unstructured) bool {
+			verbs = append(verbs, verb)
+			return true
+		})}
+		_, err := vm.ExecuteResourceAction(testObj, "return obj", nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"action/argoproj.io/Rollout/*"}, verbs)
+	})
+	t.Run("Denied", func(t *testing.T) {
+		vm := VM{Authorizer: AuthorizerFunc(func(verb string, _ *unstructured.Unstructured) bool {
+			return verb != "action/argoproj.io/Rollout/*"
+		})}
+		_, err := vm.ExecuteResourceAction(testObj, "return obj", nil)
+		require.EqualError(t, err, `not authorized to run action "*" on Rollout.argoproj.io default/helm-guestbook`)
+		_, err = vm.ExecuteResourceActionWithResult(testObj, "return obj", nil)
+		require.EqualError(t, err, `not authorized to run action "*" on Rollout.argoproj.io default/helm-guestbook`)
+	})
+}
+
+func TestExecuteCompositeResourceActionWithAuthorizer(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	composite := appv1.ResourceActionDefinition{Name: "resume-and-restart", Steps: []string{"resume", "restart"}}
+	overrides := map[string]appv1.ResourceOverride{
+		"argoproj.io/Rollout": {
+			Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+				Definitions: []appv1.ResourceActionDefinition{
+					{Name: "resume", ActionLua: "return obj"},
+					{Name: "restart", ActionLua: "return obj"},
+					composite,
+				},
+			})),
+		},
+	}
+
+	t.Run("Allowed", func(t *testing.T) {
+		var verbs []string
+		vm := VM{ResourceOverrides: overrides, Authorizer: AuthorizerFunc(func(verb string, _ *unstructured.Unstructured) bool {
+			verbs = append(verbs, verb)
+			return true
+		})}
+		_, err := vm.ExecuteResourceActionDefinition(testObj, composite, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"action/argoproj.io/Rollout/resume-and-restart",
+			"action/argoproj.io/Rollout/resume",
+			"action/argoproj.io/Rollout/restart",
+		}, verbs)
+	})
+	t.Run("StepDenied", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides, Authorizer: AuthorizerFunc(func(verb string, _ *unstructured.Unstructured) bool {
+			return verb != "action/argoproj.io/Rollout/restart"
+		})}
+		_, err := vm.ExecuteResourceActionDefinition(testObj, composite, nil)
+		require.EqualError(t, err, `error running step "restart" of action "resume-and-restart": not authorized to run action "restart" on Rollout.argoproj.io default/helm-guestbook`)
+	})
+}
@@ -0,0 +1,201 @@
+package lua
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ActionBackend runs the implementation of a resource action, written in the language of the backend, against a copy
+// of the resource.
+type ActionBackend interface {
+	ExecuteResourceActionWithResult(obj *unstructured.Unstructured, script string, params []*ResourceActionParameters) (*ActionResult, error)
+}
+
+// luaBackend runs the Lua scripts implementing resource actions, which are authorized beforehand.
+type luaBackend struct {
+	vm VM
+}
+
+func (b luaBackend) ExecuteResourceActionWithResult(obj *unstructured.Unstructured, script string, params []*ResourceActionParameters) (*ActionResult, error) {
+	return b.vm.executeResourceActionScript(obj, script, params)
+}
+
+// cueScriptFilename is the name of the CUE transforms in the errors.
+const cueScriptFilename = "action.cue"
+
+// cueBackend runs the CUE transforms implementing resource actions. The transform is evaluated with the resource in
+// obj, the parameters in actionParams, the ActionConfig of the VM in actionConfig, its PreviousObject, or null, in
+// previousObject and its RelatedResources in relatedResources. Its optional patch field is merged into the resource
+// as a JSON merge patch, the resources of its optional create field are created and its optional message field is
+// the message of the result.
+//
+// The transforms are evaluated with cuelang.org/go, the reference implementation of CUE and the only one in Go. It
+// runs in process like gopher-lua, without the binaries or network access an external evaluator would need.
+type cueBackend struct {
+	vm VM
+}
+
+// cueOutput holds the fields of an evaluated CUE transform.
+type cueOutput struct {
+	message string
+	patch   []byte
+	create  []*unstructured.Unstructured
+}
+
+func (b cueBackend) ExecuteResourceActionWithResult(obj *unstructured.Unstructured, script string, params []*ResourceActionParameters) (*ActionResult, error) {
+	actionConfig := maps.Clone(b.vm.ActionConfig)
+	if actionConfig == nil {
+		actionConfig = map[string]string{}
+	}
+	var previousObject map[string]any
+	if b.vm.PreviousObject != nil {
+		previousObject = b.vm.PreviousObject.DeepCopy().Object
+	}
+	relatedResources := make([]map[string]any, 0, len(b.vm.RelatedResources))
+	for _, relatedResource := range b.vm.RelatedResources {
+		relatedResources = append(relatedResources, relatedResource.DeepCopy().Object)
+	}
+	// The inputs are copied, as the evaluation may outlive the call
+	inputs := map[string]any{
+		"obj":              obj.DeepCopy().Object,
+		"actionParams":     cueParams(params),
+		"actionConfig":     actionConfig,
+		"previousObject":   previousObject,
+		"relatedResources": relatedResources,
+	}
+
+	// CUE evaluation cannot be interrupted, so it runs in its own goroutine and is abandoned once the context of the
+	// script is done, like a Lua script is stopped. CUE has no unbounded loops, so the goroutine still ends.
+	ctx, cancel := b.vm.scriptContext()
+	defer cancel()
+	type evaluation struct {
+		output *cueOutput
+		err    error
+	}
+	done := make(chan evaluation, 1)
+	go func() {
+		output, err := evaluateCUE(script, inputs)
+		done <- evaluation{output, err}
+	}()
+	var output *cueOutput
+	select {
+	case evaluated := <-done:
+		if evaluated.err != nil {
+			return nil, evaluated.err
+		}
+		output = evaluated.output
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error evaluating CUE action: %w", ctx.Err())
+	}
+
+	result := &ActionResult{Status: ActionResultStatusOK, Message: output.message}
+	if output.patch != nil {
+		patched, err := cuePatch(obj, output.patch)
+		if err != nil {
+			return nil, err
+		}
+		result.ImpactedResources = append(result.ImpactedResources, ImpactedResource{UnstructuredObj: patched, K8SOperation: PatchOperation})
+	}
+	for _, created := range output.create {
+		clearServerSetMetadata(created)
+		result.ImpactedResources = append(result.ImpactedResources, ImpactedResource{UnstructuredObj: created, K8SOperation: CreateOperation})
+	}
+	if len(result.ImpactedResources) == 0 {
+		return nil, errors.New("CUE action must define a patch or create field")
+	}
+	if err := validateCreatedResourcesAreNotSource(obj, result.ImpactedResources); err != nil {
+		return nil, err
+	}
+	if err := validateCreatedResourceNamespaces(result.ImpactedResources, b.vm.ResourceInfoProvider); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// cueParams returns the values of the parameters by name.
+func cueParams(params []*ResourceActionParameters) map[string]string {
+	values := make(map[string]string, len(params))
+	for _, param := range params {
+		values[param.GetName()] = param.GetValue()
+	}
+	return values
+}
+
+// evaluateCUE evaluates the transform with the inputs in scope and returns its fields.
+func evaluateCUE(script string, inputs map[string]any) (*cueOutput, error) {
+	ctx := cuecontext.New()
+	scope := ctx.Encode(inputs)
+	if err := scope.Err(); err != nil {
+		return nil, fmt.Errorf("error encoding the inputs of the CUE action: %w", err)
+	}
+	value := ctx.CompileString(script, cue.Filename(cueScriptFilename), cue.Scope(scope))
+	if err := value.Err(); err != nil {
+		return nil, fmt.Errorf("error evaluating CUE action: %w", err)
+	}
+
+	output := &cueOutput{}
+	if message := value.LookupPath(cue.ParsePath("message")); message.Exists() {
+		s, err := message.String()
+		if err != nil {
+			return nil, fmt.Errorf("invalid message of CUE action: %w", err)
+		}
+		output.message = s
+	}
+	if patch := value.LookupPath(cue.ParsePath("patch")); patch.Exists() {
+		patchBytes, err := patch.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("invalid patch of CUE action: %w", err)
+		}
+		output.patch = patchBytes
+	}
+	if create := value.LookupPath(cue.ParsePath("create")); create.Exists() {
+		items, err := create.List()
+		if err != nil {
+			return nil, fmt.Errorf("invalid create field of CUE action: %w", err)
+		}
+		for items.Next() {
+			created, err := cueResource(items.Value())
+			if err != nil {
+				return nil, fmt.Errorf("invalid resource %s of CUE action: %w", items.Selector(), err)
+			}
+			output.create = append(output.create, created)
+		}
+	}
+	return output, nil
+}
+
+// cuePatch returns the resource with the patch merged into it.
+func cuePatch(obj *unstructured.Unstructured, patchBytes []byte) (*unstructured.Unstructured, error) {
+	objBytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	patchedBytes, err := jsonpatch.MergePatch(objBytes, patchBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error applying patch of CUE action: %w", err)
+	}
+	patched := &unstructured.Unstructured{}
+	if err := patched.UnmarshalJSON(patchedBytes); err != nil {
+		return nil, fmt.Errorf("error applying patch of CUE action: %w", err)
+	}
+	return patched, nil
+}
+
+func cueResource(value cue.Value) (*unstructured.Unstructured, error) {
+	resourceBytes, err := value.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	resource := &unstructured.Unstructured{}
+	if err := resource.UnmarshalJSON(resourceBytes); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
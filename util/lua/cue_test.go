@@ -0,0 +1,94 @@
+package lua
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestExecuteCUEResourceAction(t *testing.T) {
+	rollout := StrToUnstructured(objJSON)
+	run := func(t *testing.T, vm VM, actionCUE string, params []*ResourceActionParameters) (*ActionResult, error) {
+		t.Helper()
+		return vm.ExecuteResourceActionDefinition(rollout, appv1.ResourceActionDefinition{Name: "test", ActionCUE: actionCUE}, params)
+	}
+
+	t.Run("PatchAndCreate", func(t *testing.T) {
+		result, err := run(t, VM{ActionConfig: map[string]string{"team": "payments"}}, `
+patch: metadata: annotations: "example.com/reason": actionParams.reason
+create: [{
+	apiVersion: "v1"
+	kind:       "ConfigMap"
+	metadata: {name: "\(obj.metadata.name)-audit", namespace: obj.metadata.namespace}
+	data: team: actionConfig.team
+}]
+message: "audited"
+`, NewParams().Set("reason", "incident").Build())
+		require.NoError(t, err)
+		assert.Equal(t, "audited", result.Message)
+		require.Len(t, result.ImpactedResources, 2)
+
+		patched := result.ImpactedResources[0]
+		assert.Equal(t, PatchOperation, patched.K8SOperation)
+		assert.Equal(t, map[string]string{"example.com/reason": "incident"}, patched.UnstructuredObj.GetAnnotations())
+		assert.Equal(t, rollout.GetLabels(), patched.UnstructuredObj.GetLabels(), "the other fields of the resource are kept")
+		assert.Empty(t, rollout.GetAnnotations(), "the source resource must not be modified")
+
+		created := result.ImpactedResources[1]
+		assert.Equal(t, CreateOperation, created.K8SOperation)
+		assert.Equal(t, "helm-guestbook-audit", created.UnstructuredObj.GetName())
+		assert.Equal(t, map[string]any{"team": "payments"}, created.UnstructuredObj.Object["data"])
+	})
+	t.Run("GetResourceAction", func(t *testing.T) {
+		vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+			"argoproj.io/Rollout": {Actions: "definitions:\n- name: pause\n  action.cue: 'patch: spec: paused: true'\n"},
+		}}
+		action, err := vm.GetResourceAction(rollout, "pause")
+		require.NoError(t, err)
+		assert.Empty(t, action.ActionLua)
+		result, err := vm.ExecuteResourceActionDefinition(rollout, action, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		assert.Equal(t, true, result.ImpactedResources[0].UnstructuredObj.Object["spec"].(map[string]any)["paused"])
+	})
+	t.Run("NoOutput", func(t *testing.T) {
+		_, err := run(t, VM{}, `message: "nothing"`, nil)
+		require.EqualError(t, err, "CUE action must define a patch or create field")
+	})
+	t.Run("NotConcrete", func(t *testing.T) {
+		_, err := run(t, VM{}, `patch: spec: replicas: int`, nil)
+		require.ErrorContains(t, err, "invalid patch of CUE action")
+	})
+	t.Run("MissingParameter", func(t *testing.T) {
+		_, err := run(t, VM{}, `patch: metadata: annotations: reason: actionParams.reason`, nil)
+		require.ErrorContains(t, err, "invalid patch of CUE action")
+	})
+	t.Run("Conflict", func(t *testing.T) {
+		_, err := run(t, VM{}, `patch: metadata: name: obj.metadata.name & "other"`, nil)
+		require.ErrorContains(t, err, "error evaluating CUE action")
+	})
+	slowCUE := `
+import "list"
+patch: metadata: annotations: products: "\(len([for a in list.Range(0, 150, 1) for b in list.Range(0, 150, 1) {a * b}]))"
+`
+	t.Run("Timeout", func(t *testing.T) {
+		_, err := run(t, VM{Timeout: 20 * time.Millisecond}, slowCUE, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorContains(t, err, "error evaluating CUE action")
+	})
+	t.Run("Context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+		defer cancel()
+		_, err := run(t, VM{Timeout: time.Minute, ctx: ctx}, slowCUE, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("CreateWithoutNamespace", func(t *testing.T) {
+		_, err := run(t, VM{}, `create: [{apiVersion: "v1", kind: "ConfigMap", metadata: name: "audit"}]`, nil)
+		require.EqualError(t, err, `created resource ConfigMap "audit" is namespaced but has no namespace`)
+	})
+}
@@ -2,18 +2,37 @@ package lua
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
+	"text/template"
+	"time"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
@@ -25,6 +44,9 @@ func (t testNormalizer) Normalize(un *unstructured.Unstructured) error {
 	if un == nil {
 		return nil
 	}
+	if err := NormalizeActionOutput(un); err != nil {
+		return err
+	}
 	if un.GetKind() == "Job" {
 		err := unstructured.SetNestedField(un.Object, map[string]any{"name": "not sure why this works"}, "metadata")
 		if err != nil {
@@ -32,54 +54,11 @@ func (t testNormalizer) Normalize(un *unstructured.Unstructured) error {
 		}
 	}
 	switch un.GetKind() {
-	case "DaemonSet", "Deployment", "StatefulSet":
-		err := unstructured.SetNestedStringMap(un.Object, map[string]string{"kubectl.kubernetes.io/restartedAt": "0001-01-01T00:00:00Z"}, "spec", "template", "metadata", "annotations")
-		if err != nil {
-			return fmt.Errorf("failed to normalize %s: %w", un.GetKind(), err)
-		}
-	}
-	switch un.GetKind() {
 	case "Deployment":
 		err := unstructured.SetNestedField(un.Object, nil, "status")
 		if err != nil {
 			return fmt.Errorf("failed to normalize %s: %w", un.GetKind(), err)
 		}
-		err = unstructured.SetNestedField(un.Object, nil, "metadata", "creationTimestamp")
-		if err != nil {
-			return fmt.Errorf("failed to normalize %s: %w", un.GetKind(), err)
-		}
-		err = unstructured.SetNestedField(un.Object, nil, "metadata", "generation")
-		if err != nil {
-			return fmt.Errorf("failed to normalize %s: %w", un.GetKind(), err)
-		}
-	case "Rollout":
-		err := unstructured.SetNestedField(un.Object, nil, "spec", "restartAt")
-		if err != nil {
-			return fmt.Errorf("failed to normalize %s: %w", un.GetKind(), err)
-		}
-	case "ExternalSecret", "PushSecret":
-		err := unstructured.SetNestedStringMap(un.Object, map[string]string{"force-sync": "0001-01-01T00:00:00Z"}, "metadata", "annotations")
-		if err != nil {
-			return fmt.Errorf("failed to normalize %s: %w", un.GetKind(), err)
-		}
-	case "Workflow":
-		err := unstructured.SetNestedField(un.Object, nil, "metadata", "resourceVersion")
-		if err != nil {
-			return fmt.Errorf("failed to normalize Rollout: %w", err)
-		}
-		err = unstructured.SetNestedField(un.Object, nil, "metadata", "uid")
-		if err != nil {
-			return fmt.Errorf("failed to normalize Rollout: %w", err)
-		}
-		err = unstructured.SetNestedField(un.Object, nil, "metadata", "annotations", "workflows.argoproj.io/scheduled-time")
-		if err != nil {
-			return fmt.Errorf("failed to normalize Rollout: %w", err)
-		}
-	case "HelmRelease", "ImageRepository", "ImageUpdateAutomation", "Kustomization", "Receiver", "Bucket", "GitRepository", "HelmChart", "HelmRepository", "OCIRepository":
-		err := unstructured.SetNestedStringMap(un.Object, map[string]string{"reconcile.fluxcd.io/requestedAt": "By Argo CD at: 0001-01-01T00:00:00"}, "metadata", "annotations")
-		if err != nil {
-			return fmt.Errorf("failed to normalize %s: %w", un.GetKind(), err)
-		}
 	}
 	return nil
 }
@@ -92,47 +71,317 @@ type ActionTestStructure struct {
 type IndividualDiscoveryTest struct {
 	InputPath string                  `yaml:"inputPath"`
 	Result    []appsv1.ResourceAction `yaml:"result"`
+	// Exact requires the discovered actions to match Result exactly (no more, no less), regardless of order
+	Exact bool `yaml:"exact"`
+	// SnapshotPath optionally is the path of a golden file holding the whole discovery output, instead of Result. The
+	// golden files are written by running the tests with the -update flag.
+	SnapshotPath string `yaml:"snapshotPath"`
+	// States optionally are several states of the resource, each with its own discovered actions, instead of InputPath,
+	// so that the actions enabled in some states only can be covered by a single test
+	States []DiscoveryTestState `yaml:"states"`
+}
+
+// DiscoveryTestState is a state of the resource of a discovery test along with the actions discovered for it.
+type DiscoveryTestState struct {
+	InputPath string                  `yaml:"inputPath"`
+	Result    []appsv1.ResourceAction `yaml:"result"`
+	// Exact requires the discovered actions to match Result exactly (no more, no less), regardless of order
+	Exact bool `yaml:"exact"`
+}
+
+var updateSnapshots = flag.Bool("update", false, "update the discovery snapshots of the action tests")
+
+// discoverySnapshot returns the snapshot of the discovered actions, sorted by name.
+func discoverySnapshot(actions []appsv1.ResourceAction) ([]byte, error) {
+	sorted := slices.Clone(actions)
+	slices.SortFunc(sorted, func(a, b appsv1.ResourceAction) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return yaml.Marshal(sorted)
 }
 
+// assertDiscoverySnapshot compares the discovered actions to the snapshot at the given path, or writes them to the
+// snapshot when the -update flag is set.
+func assertDiscoverySnapshot(t *testing.T, path string, actions []appsv1.ResourceAction) {
+	t.Helper()
+	actual, err := discoverySnapshot(actions)
+	require.NoError(t, err)
+	if *updateSnapshots {
+		require.NoError(t, os.WriteFile(path, actual, 0o644))
+		return
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err, "run the tests with -update to write the snapshot")
+	assert.Equalf(t, string(expected), string(actual), "discovery output does not match snapshot %s, run the tests with -update to update it", path)
+}
+
+// testActionTime is the current time of the actions run by the tests.
+var testActionTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
 type IndividualActionTest struct {
 	Action             string `yaml:"action"`
 	InputPath          string `yaml:"inputPath"`
 	ExpectedOutputPath string `yaml:"expectedOutputPath"`
-	InputStr           string `yaml:"input"`
+	// InputStr optionally is the source resource as an inline YAML document, instead of InputPath. InputPath is used if
+	// both are set. The files are decoded through JSON, hence the JSON name of the field.
+	InputStr string `yaml:"input" json:"input"`
+	// Parameters are passed to the action and used to render the expected output, which may reference them as
+	// {{ .Parameters.name }} placeholders
+	Parameters map[string]string `yaml:"parameters"`
+	// MaxHeapGrowthBytes optionally is the maximum number of bytes the in-use heap may grow by while the action runs,
+	// as measured by peakHeapGrowth
+	MaxHeapGrowthBytes uint64 `yaml:"maxHeapGrowthBytes"`
+	// MaxDurationMs optionally is the maximum number of milliseconds the action may run for, instead of
+	// defaultMaxActionDuration
+	MaxDurationMs int64 `yaml:"maxDurationMs"`
+	// Fuzz optionally runs the action with random parameters, in addition to the declared ones
+	Fuzz *FuzzTest `yaml:"fuzz"`
+	// ExpectedSubresource optionally is the subresource which the patches of the action must target
+	ExpectedSubresource Subresource `yaml:"expectedSubresource"`
+	// CompareFields optionally are the JSONPaths of the fields, e.g. spec.replicas, which are compared with the expected
+	// output, instead of the whole resources. The expected output then only needs to hold these fields, along with the
+	// kind and the name of the resources.
+	CompareFields []string `yaml:"compareFields"`
+	// ExpectedWaves optionally are the waves of the resources impacted by the action, in the order the action returns
+	// them
+	ExpectedWaves []int `yaml:"expectedWaves"`
+}
+
+// inputObjs returns the source resource of the test, read from InputPath or parsed from InputStr, and the resources
+// related to it. The input may hold several YAML documents, in which case the first one is the source resource and the
+// others are its related resources.
+func (test IndividualActionTest) inputObjs(t *testing.T, dir string) (*unstructured.Unstructured, []*unstructured.Unstructured) {
+	t.Helper()
+	input := []byte(test.InputStr)
+	if test.InputPath != "" {
+		if test.InputStr != "" {
+			t.Logf("warning: both inputPath and input are set, using inputPath %s", test.InputPath)
+		}
+		var err error
+		input, err = os.ReadFile(filepath.Join(dir, test.InputPath))
+		require.NoError(t, err)
+	}
+	objs, err := kube.SplitYAML(input)
+	require.NoError(t, err)
+	require.NotEmpty(t, objs, "the input of the test holds no resource")
+	return objs[0], objs[1:]
+}
+
+// FuzzTest describes the parameters of an action, for which random valid and invalid values are generated. The action
+// must never panic, whatever the values, but may return errors.
+type FuzzTest struct {
+	// Seed seeds the random values, so that failures are reproducible
+	Seed uint64 `yaml:"seed"`
+	// Iterations is the number of times the action is run. defaultFuzzIterations is used if it is 0.
+	Iterations int `yaml:"iterations"`
+	// Parameters is the schema of the parameters. The values of parameters of type "number" and "boolean" are generated
+	// accordingly, any other type is considered a string.
+	Parameters []appsv1.ResourceActionParam `yaml:"parameters"`
+}
+
+// defaultFuzzIterations is the number of times an action is run with random parameters if the test does not declare it
+const defaultFuzzIterations = 100
+
+// invalidFuzzValues are the values which are generated for parameters of any type, as they often trip scripts up
+var invalidFuzzValues = []string{"", " ", "nil", "null", "-1", "0", "1e309", "NaN", "0x10", "true", "{}", "[]", "\x00", "é", strings.Repeat("x", 4096)}
+
+// fuzzParameters returns random parameters matching the schema of the test, half of which on average have invalid values.
+func (fuzz FuzzTest) fuzzParameters(r *rand.Rand) []*ResourceActionParameters {
+	params := NewParams()
+	for _, param := range fuzz.Parameters {
+		if r.IntN(2) == 0 {
+			params.Set(param.Name, invalidFuzzValues[r.IntN(len(invalidFuzzValues))])
+			continue
+		}
+		switch param.Type {
+		case "number":
+			params.Set(param.Name, strconv.Itoa(r.IntN(2001)-1000))
+		case "boolean":
+			params.Set(param.Name, strconv.FormatBool(r.IntN(2) == 0))
+		default:
+			value := make([]byte, r.IntN(64))
+			for i := range value {
+				value[i] = byte(' ' + r.IntN('~'-' '+1))
+			}
+			params.Set(param.Name, string(value))
+		}
+	}
+	return params.Build()
+}
+
+// runFuzzTest runs the action with random parameters and asserts it never panics.
+func runFuzzTest(t *testing.T, vm VM, obj *unstructured.Unstructured, actionLua string, fuzz FuzzTest) {
+	t.Helper()
+	iterations := fuzz.Iterations
+	if iterations <= 0 {
+		iterations = defaultFuzzIterations
+	}
+	r := rand.New(rand.NewPCG(fuzz.Seed, fuzz.Seed))
+	for i := 0; i < iterations; i++ {
+		params := fuzz.fuzzParameters(r)
+		require.NotPanicsf(t, func() {
+			_, _ = vm.ExecuteResourceAction(obj.DeepCopy(), actionLua, params)
+		}, "iteration %d with seed %d panicked", i, fuzz.Seed)
+	}
+}
+
+// defaultMaxActionDuration is the maximum duration of the actions under test which do not declare one. It is generous
+// so that only pathological scripts, e.g. looping quadratically over the resource, exceed it.
+const defaultMaxActionDuration = 500 * time.Millisecond
+
+// maxDuration returns the maximum duration the action may run for.
+func (test IndividualActionTest) maxDuration() time.Duration {
+	if test.MaxDurationMs > 0 {
+		return time.Duration(test.MaxDurationMs) * time.Millisecond
+	}
+	return defaultMaxActionDuration
+}
+
+// runTimedAction runs the action like the API server, with its precondition, postcondition and steps but without
+// asking for confirmation, and returns how long it ran for.
+func runTimedAction(vm VM, obj *unstructured.Unstructured, action appsv1.ResourceActionDefinition, resourceActionParameters []*ResourceActionParameters) (*ActionResult, time.Duration, error) {
+	vm.SkipConfirmation = true
+	start := time.Now()
+	result, err := vm.ExecuteResourceActionDefinition(obj, action, resourceActionParameters)
+	return result, time.Since(start), err
+}
+
+// assertActionDuration asserts that the action ran for at most the maximum duration of the test.
+func assertActionDuration(t assert.TestingT, test IndividualActionTest, elapsed time.Duration) bool {
+	return assert.LessOrEqualf(t, elapsed, test.maxDuration(), "action ran for %s, over its budget of %s", elapsed, test.maxDuration())
+}
+
+// loadActionTestStructure strictly unmarshals an action_test.yaml file, so that unknown fields or missing required
+// fields are reported instead of silently producing empty tests.
+func loadActionTestStructure(path string) (*ActionTestStructure, error) {
+	yamlBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var resourceTest ActionTestStructure
+	err = yaml.UnmarshalStrict(yamlBytes, &resourceTest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid action test file %s: %w", path, err)
+	}
+	for i, test := range resourceTest.DiscoveryTests {
+		if len(test.States) > 0 {
+			if test.InputPath != "" || test.Result != nil || test.Exact || test.SnapshotPath != "" {
+				return nil, fmt.Errorf("invalid action test file %s: discoveryTests[%d]: states cannot be set with inputPath, result, exact or snapshotPath", path, i)
+			}
+			for j, state := range test.States {
+				if state.InputPath == "" {
+					return nil, fmt.Errorf("invalid action test file %s: discoveryTests[%d].states[%d]: inputPath is required", path, i, j)
+				}
+			}
+			continue
+		}
+		if test.InputPath == "" {
+			return nil, fmt.Errorf("invalid action test file %s: discoveryTests[%d]: inputPath is required", path, i)
+		}
+		if test.SnapshotPath != "" && (test.Result != nil || test.Exact) {
+			return nil, fmt.Errorf("invalid action test file %s: discoveryTests[%d]: snapshotPath cannot be set with result or exact", path, i)
+		}
+	}
+	for i, test := range resourceTest.ActionTests {
+		if test.Action == "" {
+			return nil, fmt.Errorf("invalid action test file %s: actionTests[%d]: action is required", path, i)
+		}
+		if test.InputPath == "" && test.InputStr == "" {
+			return nil, fmt.Errorf("invalid action test file %s: actionTests[%d]: inputPath or input is required", path, i)
+		}
+		if test.ExpectedOutputPath == "" {
+			return nil, fmt.Errorf("invalid action test file %s: actionTests[%d]: expectedOutputPath is required", path, i)
+		}
+		for _, field := range test.CompareFields {
+			if _, err := parseFieldPath(field); err != nil {
+				return nil, fmt.Errorf("invalid action test file %s: actionTests[%d]: invalid compareFields path %q: %w", path, i, field, err)
+			}
+		}
+	}
+	return &resourceTest, nil
 }
 
 func TestLuaResourceActionsScript(t *testing.T) {
-	err := filepath.Walk("../../resource_customizations", func(path string, _ os.FileInfo, err error) error {
+	testResourceActions(t, "../../resource_customizations", nil)
+}
+
+// TestTestdataResourceActionsScript runs the actions of the testdata customizations, which use features no built-in
+// action uses, such as CUE actions and merge patches.
+func TestTestdataResourceActionsScript(t *testing.T) {
+	loader, err := NewDirScriptLoader("testdata/customizations")
+	require.NoError(t, err)
+	testResourceActions(t, "testdata/customizations", loader)
+}
+
+// discoverTestActions runs the discovery scripts against the resource at the given path.
+func discoverTestActions(t *testing.T, vm VM, path string) []appsv1.ResourceAction {
+	t.Helper()
+	obj := getObj(t, path)
+	discoveryLua, err := vm.GetResourceActionDiscovery(obj)
+	require.NoError(t, err)
+	result, err := vm.ExecuteResourceActionDiscovery(obj, discoveryLua)
+	require.NoError(t, err)
+	return result
+}
+
+// assertDiscoveredActions asserts that the discovered actions are among the expected ones, or are exactly the expected
+// ones if exact is set.
+func assertDiscoveredActions(t *testing.T, expected []appsv1.ResourceAction, exact bool, result []appsv1.ResourceAction) {
+	t.Helper()
+	if exact {
+		assert.ElementsMatch(t, expected, result)
+		return
+	}
+	for i := range result {
+		assert.Contains(t, expected, result[i])
+	}
+}
+
+// testResourceActions runs the tests of the action_test.yaml files of the given directory, which is laid out like the
+// resource_customizations directory. The scripts are read with the given loader, or are the embedded ones if it is
+// nil.
+func testResourceActions(t *testing.T, root string, scriptLoader *ScriptLoader) {
+	t.Helper()
+	err := filepath.Walk(root, func(path string, _ os.FileInfo, err error) error {
 		if !strings.Contains(path, "action_test.yaml") {
 			return nil
 		}
 		require.NoError(t, err)
 		dir := filepath.Dir(path)
-		yamlBytes, err := os.ReadFile(filepath.Join(dir, "action_test.yaml"))
-		require.NoError(t, err)
-		var resourceTest ActionTestStructure
-		err = yaml.Unmarshal(yamlBytes, &resourceTest)
+		resourceTest, err := loadActionTestStructure(filepath.Join(dir, "action_test.yaml"))
 		require.NoError(t, err)
+		vm := VM{
+			UseOpenLibs:  true,
+			ScriptLoader: scriptLoader,
+		}
 		for i := range resourceTest.DiscoveryTests {
 			test := resourceTest.DiscoveryTests[i]
+			for _, state := range test.States {
+				t.Run("discovery/"+state.InputPath, func(t *testing.T) {
+					result := discoverTestActions(t, vm, filepath.Join(dir, state.InputPath))
+					assertDiscoveredActions(t, state.Result, state.Exact, result)
+				})
+			}
+			if len(test.States) > 0 {
+				continue
+			}
 			testName := "discovery/" + test.InputPath
 			t.Run(testName, func(t *testing.T) {
-				vm := VM{
-					UseOpenLibs: true,
-				}
-				obj := getObj(t, filepath.Join(dir, test.InputPath))
-				discoveryLua, err := vm.GetResourceActionDiscovery(obj)
-				require.NoError(t, err)
-				result, err := vm.ExecuteResourceActionDiscovery(obj, discoveryLua)
-				require.NoError(t, err)
-				for i := range result {
-					assert.Contains(t, test.Result, result[i])
+				result := discoverTestActions(t, vm, filepath.Join(dir, test.InputPath))
+				if test.SnapshotPath != "" {
+					assertDiscoverySnapshot(t, filepath.Join(dir, test.SnapshotPath), result)
+					return
 				}
+				assertDiscoveredActions(t, test.Result, test.Exact, result)
 			})
 		}
 		for i := range resourceTest.ActionTests {
 			test := resourceTest.ActionTests[i]
-			testName := fmt.Sprintf("actions/%s/%s", test.Action, test.InputPath)
+			inputName := test.InputPath
+			if inputName == "" {
+				inputName = "input"
+			}
+			testName := fmt.Sprintf("actions/%s/%s", test.Action, inputName)
 
 			t.Run(testName, func(t *testing.T) {
 				vm := VM{
@@ -140,50 +389,76 @@ func TestLuaResourceActionsScript(t *testing.T) {
 					// purposes. Otherwise, leave this false to ensure tests reflect the same
 					// privileges that API server has.
 					// UseOpenLibs: true,
+					ScriptLoader: scriptLoader,
+					// The names of the created resources are derived deterministically, so that they can be compared
+					// with the expected output
+					DeterministicNames: true,
+					Now:                func() time.Time { return testActionTime },
 				}
-				sourceObj := getObj(t, filepath.Join(dir, test.InputPath))
+				sourceObj, relatedResources := test.inputObjs(t, dir)
+				vm.RelatedResources = relatedResources
 				action, err := vm.GetResourceAction(sourceObj, test.Action)
 
 				require.NoError(t, err)
 
+				params := NewParams()
+				for name, value := range test.Parameters {
+					params.Set(name, value)
+				}
+				result, elapsed, err := runTimedAction(vm, sourceObj, action, params.Build())
 				require.NoError(t, err)
-				impactedResources, err := vm.ExecuteResourceAction(sourceObj, action.ActionLua)
-				require.NoError(t, err)
+				impactedResources := result.ImpactedResources
+				assertActionDuration(t, test, elapsed)
+				if test.Fuzz != nil {
+					runFuzzTest(t, vm, sourceObj, action.ActionLua, *test.Fuzz)
+				}
+				if test.MaxHeapGrowthBytes > 0 {
+					growth := peakHeapGrowth(func() {
+						_, _ = vm.executeActionScript(sourceObj, action, params.Build())
+					})
+					assert.LessOrEqualf(t, growth, test.MaxHeapGrowthBytes, "the heap grew by %d bytes while the action ran, over its budget of %d bytes", growth, test.MaxHeapGrowthBytes)
+				}
+
+				if test.ExpectedWaves != nil {
+					waves := make([]int, 0, len(impactedResources))
+					for _, impactedResource := range impactedResources {
+						waves = append(waves, impactedResource.Wave)
+					}
+					assert.Equal(t, test.ExpectedWaves, waves, "the waves of the impacted resources do not match")
+				}
+				require.NoError(t, applyCreates(sourceObj, impactedResources))
 
 				// Treat the Lua expected output as a list
-				expectedObjects := getExpectedObjectList(t, filepath.Join(dir, test.ExpectedOutputPath))
+				expectedObjects := getExpectedObjectList(t, filepath.Join(dir, test.ExpectedOutputPath), test.Parameters)
 
 				for _, impactedResource := range impactedResources {
+					require.NoError(t, impactedResource.K8SOperation.Validate())
 					result := impactedResource.UnstructuredObj
+					if impactedResource.K8SOperation == PatchOperation {
+						// Compare the source as patched rather than the produced object, so that a patch which would
+						// not apply cleanly fails the test
+						result, err = previewPatch(sourceObj, impactedResource)
+						require.NoError(t, err)
+					}
 
 					// The expected output is a list of objects
 					// Find the actual impacted resource in the expected output
 					expectedObj := findFirstMatchingItem(expectedObjects.Items, func(u unstructured.Unstructured) bool {
-						// Some resources' name is derived from the source object name, so the returned name is not actually equal to the testdata output name
-						// Considering the resource found in the testdata output if its name starts with source object name
-						// TODO: maybe this should use a normalizer function instead of hard-coding the resource specifics here
-						if (result.GetKind() == "Job" && sourceObj.GetKind() == "CronJob") || (result.GetKind() == "Workflow" && (sourceObj.GetKind() == "CronWorkflow" || sourceObj.GetKind() == "WorkflowTemplate")) {
-							return u.GroupVersionKind() == result.GroupVersionKind() && strings.HasPrefix(u.GetName(), sourceObj.GetName()) && u.GetNamespace() == result.GetNamespace()
-						}
 						return u.GroupVersionKind() == result.GroupVersionKind() && u.GetName() == result.GetName() && u.GetNamespace() == result.GetNamespace()
 					})
 
-					assert.NotNil(t, expectedObj)
+					require.NotNilf(t, expectedObj, "the expected output holds no %s %s/%s", result.GetKind(), result.GetNamespace(), result.GetName())
 
-					switch impactedResource.K8SOperation {
-					// No default case since a not supported operation would have failed upon unmarshaling earlier
-					case PatchOperation:
+					if impactedResource.K8SOperation == PatchOperation {
+						assert.Equal(t, test.ExpectedSubresource, impactedResource.Subresource)
 						// Patching is only allowed for the source resource, so the GVK + name + ns must be the same as the impacted resource
 						assert.Equal(t, sourceObj.GroupVersionKind(), result.GroupVersionKind())
 						assert.Equal(t, sourceObj.GetName(), result.GetName())
 						assert.Equal(t, sourceObj.GetNamespace(), result.GetNamespace())
-					case CreateOperation:
-						switch result.GetKind() {
-						case "Job":
-						case "Workflow":
-							// The name of the created resource is derived from the source object name, so the returned name is not actually equal to the testdata output name
-							result.SetName(expectedObj.GetName())
-						}
+					}
+					if len(test.CompareFields) > 0 {
+						assertFieldsEqual(t, test.CompareFields, expectedObj, result)
+						continue
 					}
 					// Ideally, we would use a assert.Equal to detect the difference, but the Lua VM returns a object with float64 instead of the original int32.  As a result, the assert.Equal is never true despite that the change has been applied.
 					diffResult, err := diff.Diff(expectedObj, result, diff.WithNormalizer(testNormalizer{}))
@@ -202,15 +477,279 @@ func TestLuaResourceActionsScript(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// parseFieldPath parses the JSONPath of a field, with or without its enclosing braces.
+func parseFieldPath(field string) (*jsonpath.JSONPath, error) {
+	expression := field
+	if !strings.HasPrefix(expression, "{") {
+		expression = "{." + strings.TrimPrefix(expression, ".") + "}"
+	}
+	path := jsonpath.New(field).AllowMissingKeys(true)
+	if err := path.Parse(expression); err != nil {
+		return nil, err
+	}
+	return path, nil
+}
+
+// assertFieldsEqual asserts that the fields at the given JSONPaths are equal in both resources. The fields are
+// compared as JSON, since the Lua VM returns numbers as float64 rather than as the integers of the expected output.
+func assertFieldsEqual(t *testing.T, fields []string, expected, actual *unstructured.Unstructured) {
+	t.Helper()
+	fieldJSON := func(path *jsonpath.JSONPath, obj *unstructured.Unstructured) string {
+		results, err := path.FindResults(obj.Object)
+		require.NoError(t, err)
+		var values []any
+		for _, result := range results {
+			for _, value := range result {
+				values = append(values, value.Interface())
+			}
+		}
+		valuesJSON, err := json.Marshal(values)
+		require.NoError(t, err)
+		return string(valuesJSON)
+	}
+	for _, field := range fields {
+		path, err := parseFieldPath(field)
+		require.NoError(t, err)
+		assert.JSONEqf(t, fieldJSON(path, expected), fieldJSON(path, actual), "field %s of %s %s does not match the expected output", field, actual.GetKind(), actual.GetName())
+	}
+}
+
+// previewPatch returns the source resource patched like the API server patches it with the resource produced by a patch
+// action: the difference between both resources is applied as a JSON merge patch, or merged with ApplyPatch if the
+// impacted resource declares list merge keys. The patched resource must then decode into the type of its kind, if the
+// kind is a built-in one, since the API server would otherwise reject the patch or silently drop the unknown fields.
+func previewPatch(sourceObj *unstructured.Unstructured, impactedResource ImpactedResource) (*unstructured.Unstructured, error) {
+	var patched *unstructured.Unstructured
+	if len(impactedResource.ListMergeKeys) > 0 {
+		var err error
+		patched, err = ApplyPatch(sourceObj, impactedResource)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		sourceBytes, err := json.Marshal(sourceObj)
+		if err != nil {
+			return nil, err
+		}
+		newBytes, err := json.Marshal(impactedResource.UnstructuredObj)
+		if err != nil {
+			return nil, err
+		}
+		patch, err := jsonpatch.CreateMergePatch(sourceBytes, newBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error calculating merge patch: %w", err)
+		}
+		patchedBytes, err := jsonpatch.MergePatch(sourceBytes, patch)
+		if err != nil {
+			return nil, fmt.Errorf("error applying merge patch: %w", err)
+		}
+		patched = &unstructured.Unstructured{}
+		if err := json.Unmarshal(patchedBytes, &patched.Object); err != nil {
+			return nil, err
+		}
+	}
+
+	typed, err := scheme.Scheme.New(patched.GroupVersionKind())
+	if err != nil {
+		// Custom resources are not validated against their schema
+		return patched, nil
+	}
+	patchedBytes, err := json.Marshal(patched)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(patchedBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(typed); err != nil {
+		return nil, fmt.Errorf("patched %s does not match its schema: %w", patched.GetKind(), err)
+	}
+	return patched, nil
+}
+
+func TestPreviewPatch(t *testing.T) {
+	actions, err := os.ReadFile("testdata/misspelled-field-action.yaml")
+	require.NoError(t, err)
+	vm := VM{ResourceOverrides: map[string]appsv1.ResourceOverride{
+		"apps/Deployment": {Actions: string(actions)},
+	}}
+	deployment := StrToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 1
+`)
+	action, err := vm.GetResourceAction(deployment, "pause")
+	require.NoError(t, err)
+	impactedResources, err := vm.ExecuteResourceAction(deployment, action.ActionLua, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 1)
+
+	// The misspelled field is dropped when diffing the produced object, so it matches the unchanged resource
+	diffResult, err := diff.Diff(deployment, impactedResources[0].UnstructuredObj, diff.WithNormalizer(testNormalizer{}))
+	require.NoError(t, err)
+	assert.False(t, diffResult.Modified)
+
+	_, err = previewPatch(deployment, impactedResources[0])
+	require.ErrorContains(t, err, `patched Deployment does not match its schema: json: unknown field "pause"`)
+
+	t.Run("CustomResource", func(t *testing.T) {
+		rollout := StrToUnstructured(objJSON)
+		patched := rollout.DeepCopy()
+		require.NoError(t, unstructured.SetNestedField(patched.Object, true, "spec", "pause"))
+		result, err := previewPatch(rollout, ImpactedResource{UnstructuredObj: patched, K8SOperation: PatchOperation})
+		require.NoError(t, err)
+		assert.Equal(t, patched, result)
+	})
+}
+
+// applyCreates creates the resources of the create operations in their order with a fake client, which initially
+// holds the namespace of the source resource. It returns an error if a resource is created in a namespace which does
+// not exist yet, like the API server would.
+func applyCreates(sourceObj *unstructured.Unstructured, impactedResources []ImpactedResource) error {
+	ctx := context.Background()
+	namespaces := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	client := dynamicfake.NewSimpleDynamicClient(apiruntime.NewScheme())
+	if namespace := sourceObj.GetNamespace(); namespace != "" {
+		_, err := client.Resource(namespaces).Create(ctx, &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]any{"name": namespace},
+		}}, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	for i, impactedResource := range impactedResources {
+		if impactedResource.K8SOperation != CreateOperation {
+			continue
+		}
+		obj := impactedResource.UnstructuredObj
+		namespace := obj.GetNamespace()
+		if namespace != "" {
+			if _, err := client.Resource(namespaces).Get(ctx, namespace, metav1.GetOptions{}); err != nil {
+				return fmt.Errorf("created resource %d, %s %q, is in namespace %q which does not exist yet: %w", i, obj.GetKind(), obj.GetName(), namespace, err)
+			}
+		}
+		gvr, _ := meta.UnsafeGuessKindToResource(obj.GroupVersionKind())
+		if _, err := client.Resource(gvr).Namespace(namespace).Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating resource %d, %s %q: %w", i, obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func TestApplyCreates(t *testing.T) {
+	actions, err := os.ReadFile("testdata/bootstrap-namespace-action.yaml")
+	require.NoError(t, err)
+	vm := VM{ResourceOverrides: map[string]appsv1.ResourceOverride{
+		"ConfigMap": {Actions: string(actions)},
+	}}
+	request := StrToUnstructured(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: team-request
+  namespace: default
+data:
+  team: payments
+  pods: "10"
+`)
+	action, err := vm.GetResourceAction(request, "bootstrap-namespace")
+	require.NoError(t, err)
+	impactedResources, err := vm.ExecuteResourceAction(request, action.ActionLua, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 2)
+	assert.Equal(t, "Namespace", impactedResources[0].UnstructuredObj.GetKind(), "the order of the returned resources must be kept")
+	assert.Equal(t, "ResourceQuota", impactedResources[1].UnstructuredObj.GetKind())
+
+	require.NoError(t, applyCreates(request, impactedResources))
+
+	t.Run("MissingNamespace", func(t *testing.T) {
+		reversed := slices.Clone(impactedResources)
+		slices.Reverse(reversed)
+		err := applyCreates(request, reversed)
+		require.ErrorContains(t, err, `created resource 0, ResourceQuota "default", is in namespace "payments" which does not exist yet`)
+	})
+	t.Run("AlreadyExists", func(t *testing.T) {
+		err := applyCreates(request, append(slices.Clone(impactedResources), impactedResources[1]))
+		require.ErrorContains(t, err, `error creating resource 2, ResourceQuota "default"`)
+	})
+}
+
+// heapSampleRuns is the number of times peakHeapGrowth runs the function it measures.
+const heapSampleRuns = 3
+
+// heapSampleInterval is the interval at which peakHeapGrowth samples the in-use heap.
+const heapSampleInterval = time.Millisecond
+
+// peakHeapGrowth returns by how many bytes the in-use heap grew at most while running f, as sampled every
+// heapSampleInterval and once f returns. The heap is read from the process-wide memory statistics, since gopher-lua
+// does not track the memory of its states, so the heap used by the other goroutines meanwhile is counted too. The
+// smallest growth of heapSampleRuns runs of f is returned, as the heap of the other goroutines only adds up, and the
+// garbage is collected before every run. The tests measuring the heap must not run in parallel with other tests for
+// the same reason.
+func peakHeapGrowth(f func()) uint64 {
+	smallest := uint64(math.MaxUint64)
+	for range heapSampleRuns {
+		runtime.GC()
+		var before runtime.MemStats
+		runtime.ReadMemStats(&before)
+		peak := before.HeapInuse
+		sample := func() {
+			var stats runtime.MemStats
+			runtime.ReadMemStats(&stats)
+			peak = max(peak, stats.HeapInuse)
+		}
+		done := make(chan struct{})
+		sampled := make(chan struct{})
+		go func() {
+			defer close(sampled)
+			ticker := time.NewTicker(heapSampleInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-done:
+					return
+				case <-ticker.C:
+					sample()
+				}
+			}
+		}()
+		f()
+		close(done)
+		<-sampled
+		sample()
+		smallest = min(smallest, peak-before.HeapInuse)
+	}
+	return smallest
+}
+
 // Handling backward compatibility.
 // The old-style actions return a single object in the expected output from testdata, so will wrap them in a list
-func getExpectedObjectList(t *testing.T, path string) *unstructured.UnstructuredList {
+// Expected output files with a .json extension are parsed as JSON, where a top-level array is the new-style output.
+// When the test declares parameters, the file is rendered as a template with them before being parsed.
+func getExpectedObjectList(t *testing.T, path string, parameters map[string]string) *unstructured.UnstructuredList {
 	t.Helper()
 	yamlBytes, err := os.ReadFile(path)
 	require.NoError(t, err)
+	if len(parameters) > 0 {
+		tmpl, err := template.New(filepath.Base(path)).Option("missingkey=error").Parse(string(yamlBytes))
+		require.NoError(t, err)
+		var rendered bytes.Buffer
+		err = tmpl.Execute(&rendered, map[string]any{"Parameters": parameters})
+		require.NoError(t, err)
+		yamlBytes = rendered.Bytes()
+	}
 	unstructuredList := &unstructured.UnstructuredList{}
 	yamlString := bytes.NewBuffer(yamlBytes).String()
-	if yamlString[0] == '-' {
+	isList := yamlString[0] == '-'
+	if filepath.Ext(path) == ".json" {
+		isList = strings.HasPrefix(strings.TrimSpace(yamlString), "[")
+	}
+	if isList {
 		// The string represents a new-style action array output, where each member is a wrapper around a k8s unstructured resource
 		objList := make([]map[string]any, 5)
 		err = yaml.Unmarshal(yamlBytes, &objList)
@@ -243,3 +782,216 @@ func findFirstMatchingItem(items []unstructured.Unstructured, f func(unstructure
 	}
 	return matching
 }
+
+func TestGetExpectedObjectListJSON(t *testing.T) {
+	dir := "../../resource_customizations/apps/Deployment/actions/testdata"
+	fromYAML := getExpectedObjectList(t, filepath.Join(dir, "deployment-pause.yaml"), nil)
+	fromJSON := getExpectedObjectList(t, filepath.Join(dir, "deployment-pause.json"), nil)
+	assert.Equal(t, fromYAML, fromJSON)
+}
+
+func TestGetExpectedObjectListTemplated(t *testing.T) {
+	dir := "testdata/customizations/apps/Deployment/actions/testdata"
+	expected := getExpectedObjectList(t, filepath.Join(dir, "deployment-replicas-set.yaml"), map[string]string{"replicas": "7"})
+	require.Len(t, expected.Items, 1)
+	replicas, found, err := unstructured.NestedFieldNoCopy(expected.Items[0].Object, "spec", "replicas")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.InDelta(t, float64(7), replicas, 0)
+}
+
+func TestLoadActionTestStructure(t *testing.T) {
+	t.Run("Unknown field", func(t *testing.T) {
+		_, err := loadActionTestStructure("testdata/action_test_unknown_field.yaml")
+		require.ErrorContains(t, err, `unknown field "expectedOutput"`)
+	})
+	t.Run("Inline input", func(t *testing.T) {
+		test, err := loadActionTestStructure("testdata/action_test_max_duration.yaml")
+		require.NoError(t, err)
+		assert.Contains(t, test.ActionTests[1].InputStr, "kind: Deployment")
+	})
+	t.Run("Missing required field", func(t *testing.T) {
+		_, err := loadActionTestStructure("testdata/action_test_missing_field.yaml")
+		require.ErrorContains(t, err, "actionTests[1]: expectedOutputPath is required")
+	})
+	t.Run("Snapshot with result", func(t *testing.T) {
+		_, err := loadActionTestStructure("testdata/action_test_snapshot_with_result.yaml")
+		require.ErrorContains(t, err, "discoveryTests[0]: snapshotPath cannot be set with result or exact")
+	})
+	t.Run("States with input", func(t *testing.T) {
+		_, err := loadActionTestStructure("testdata/action_test_states_with_input.yaml")
+		require.ErrorContains(t, err, "discoveryTests[0]: states cannot be set with inputPath, result, exact or snapshotPath")
+	})
+}
+
+// minActionTestCoverage is the minimum fraction of resource kinds defining actions that must also provide an
+// action_test.yaml.
+const minActionTestCoverage = 1.0
+
+// findActionsWithoutTests returns the actions directories below root that contain an action script but no
+// action_test.yaml.
+func findActionsWithoutTests(root string) (withActions int, missing []string, err error) {
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() || info.Name() != "actions" {
+			return nil
+		}
+		scripts, err := filepath.Glob(filepath.Join(path, "*", actionScriptFile))
+		if err != nil {
+			return err
+		}
+		if len(scripts) == 0 {
+			return nil
+		}
+		withActions++
+		if _, err := os.Stat(filepath.Join(path, "action_test.yaml")); os.IsNotExist(err) {
+			missing = append(missing, filepath.Dir(path))
+		}
+		return nil
+	})
+	return withActions, missing, err
+}
+
+func TestLuaResourceActionsCoverage(t *testing.T) {
+	withActions, missing, err := findActionsWithoutTests("../../resource_customizations")
+	require.NoError(t, err)
+	for _, dir := range missing {
+		t.Logf("%s defines actions but has no action_test.yaml", dir)
+	}
+	require.Positive(t, withActions)
+	coverage := float64(withActions-len(missing)) / float64(withActions)
+	assert.GreaterOrEqualf(t, coverage, minActionTestCoverage, "%d of %d resource kinds with actions have no action_test.yaml", len(missing), withActions)
+}
+
+func TestFindActionsWithoutTests(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"apps/Tested/actions/restart", "apps/Untested/actions/restart"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, dir), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(root, dir, actionScriptFile), []byte("return obj"), 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(root, "apps/Tested/actions/action_test.yaml"), []byte("actionTests: []"), 0o644))
+
+	withActions, missing, err := findActionsWithoutTests(root)
+	require.NoError(t, err)
+	assert.Equal(t, 2, withActions)
+	assert.Equal(t, []string{filepath.Join(root, "apps/Untested")}, missing)
+}
+
+func TestPeakHeapGrowth(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	test, err := loadActionTestStructure("../../resource_customizations/apps/Deployment/actions/action_test.yaml")
+	require.NoError(t, err)
+	budget := test.ActionTests[0].MaxHeapGrowthBytes
+	require.Positive(t, budget)
+
+	vm := VM{}
+	growth := peakHeapGrowth(func() {
+		_, err = vm.ExecuteResourceAction(testObj, "return obj", nil)
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, growth, budget)
+
+	// A ballooning script exceeds the budget of the restart action
+	growth = peakHeapGrowth(func() {
+		_, err = vm.ExecuteResourceAction(testObj, `
+local balloon = {}
+for i = 1, 20000 do
+  balloon[i] = {index = i, labels = obj.metadata.labels}
+end
+return obj
+`, nil)
+	})
+	require.NoError(t, err)
+	assert.Greater(t, growth, budget)
+}
+
+func TestRunTimedAction(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	action := appsv1.ResourceActionDefinition{Name: "test", ActionLua: "return obj", RequiresConfirmation: true}
+
+	// The harness does not ask for confirmation, but checks the conditions of the action like the API server
+	_, _, err := runTimedAction(VM{}, testObj, action, nil)
+	require.NoError(t, err)
+	action.Precondition = `return false, "not now"`
+	_, _, err = runTimedAction(VM{}, testObj, action, nil)
+	require.EqualError(t, err, "precondition failed: not now")
+}
+
+// recordingT records the failures of the assertions instead of failing the test.
+type recordingT struct {
+	errors []string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestActionMaxDuration(t *testing.T) {
+	test, err := loadActionTestStructure("testdata/action_test_max_duration.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, 50*time.Millisecond, test.ActionTests[0].maxDuration())
+	assert.Equal(t, defaultMaxActionDuration, IndividualActionTest{}.maxDuration())
+
+	actions, err := os.ReadFile("testdata/slow-action.yaml")
+	require.NoError(t, err)
+	vm := VM{ResourceOverrides: map[string]appsv1.ResourceOverride{
+		"apps/Deployment": {Actions: string(actions)},
+	}}
+	slowTest := test.ActionTests[1]
+	sourceObj, _ := slowTest.inputObjs(t, "")
+	action, err := vm.GetResourceAction(sourceObj, slowTest.Action)
+	require.NoError(t, err)
+	_, elapsed, err := runTimedAction(vm, sourceObj, action, nil)
+	require.NoError(t, err)
+
+	// The slow action fails the check of the harness, but not with the default budget
+	recorder := &recordingT{}
+	assert.False(t, assertActionDuration(recorder, slowTest, elapsed))
+	require.Len(t, recorder.errors, 1)
+	assert.Contains(t, recorder.errors[0], "over its budget of 1ms")
+	assert.True(t, assertActionDuration(recorder, IndividualActionTest{}, elapsed))
+}
+
+func TestFuzzParameters(t *testing.T) {
+	fuzz := FuzzTest{Seed: 42, Parameters: []appsv1.ResourceActionParam{
+		{Name: "replicas", Type: "number"},
+		{Name: "enabled", Type: "boolean"},
+		{Name: "reason", Type: "string"},
+	}}
+	first := fuzz.fuzzParameters(rand.New(rand.NewPCG(fuzz.Seed, fuzz.Seed)))
+	second := fuzz.fuzzParameters(rand.New(rand.NewPCG(fuzz.Seed, fuzz.Seed)))
+	assert.Equal(t, first, second)
+	require.Len(t, first, 3)
+	assert.Equal(t, "replicas", first[0].GetName())
+	assert.Equal(t, "enabled", first[1].GetName())
+	assert.Equal(t, "reason", first[2].GetName())
+}
+
+func TestRunFuzzTest(t *testing.T) {
+	test, err := loadActionTestStructure("testdata/action_test_fuzz.yaml")
+	require.NoError(t, err)
+	require.NotNil(t, test.ActionTests[0].Fuzz)
+	loader, err := NewDirScriptLoader("testdata/customizations")
+	require.NoError(t, err)
+	vm := VM{ScriptLoader: loader}
+	testObj := getObj(t, "testdata/customizations/apps/Deployment/actions/testdata/deployment.yaml")
+	action, err := vm.GetResourceAction(testObj, test.ActionTests[0].Action)
+	require.NoError(t, err)
+	runFuzzTest(t, vm, testObj, action.ActionLua, *test.ActionTests[0].Fuzz)
+}
+
+func TestDiscoverySnapshot(t *testing.T) {
+	snapshot, err := discoverySnapshot([]appsv1.ResourceAction{{Name: "restart", DisplayName: "Restart"}, {Name: "pause", Disabled: true}})
+	require.NoError(t, err)
+	assert.Equal(t, `- disabled: true
+  name: pause
+- displayName: Restart
+  name: restart
+`, string(snapshot))
+
+	path := filepath.Join(t.TempDir(), "snapshot.yaml")
+	require.NoError(t, os.WriteFile(path, snapshot, 0o644))
+	assertDiscoverySnapshot(t, path, []appsv1.ResourceAction{{Name: "pause", Disabled: true}, {Name: "restart", DisplayName: "Restart"}})
+}
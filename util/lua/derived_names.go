@@ -0,0 +1,99 @@
+package lua
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// derivedNameHashLength is the number of hexadecimal characters of the hash suffixing the derived names.
+const derivedNameHashLength = 10
+
+// deriveCreatedResourceNames replaces the names of the created resources which are derived from the name of the source,
+// i.e. which start with it followed by a dash, with the name of the source suffixed with a hash of the action, its
+// parameters, the current time of the VM and the position of the resource. The names are thus stable when the VM has a
+// fixed Now, rather than depending on the clock of the scripts, e.g. os.date. The reference fields of the impacted
+// resources holding a replaced name, such as the name of a config map volume or of a configMapRef, hold the new name
+// instead.
+func (vm VM) deriveCreatedResourceNames(obj *unstructured.Unstructured, actionName string, resourceActionParameters []*ResourceActionParameters, impactedResources []ImpactedResource) {
+	if !vm.DeterministicNames {
+		return
+	}
+	prefix := obj.GetName() + "-"
+	names := make(map[string]string)
+	for i, impactedResource := range impactedResources {
+		created := impactedResource.UnstructuredObj
+		if impactedResource.K8SOperation != CreateOperation || created == nil || !strings.HasPrefix(created.GetName(), prefix) {
+			continue
+		}
+		name := prefix + vm.derivedNameHash(actionName, resourceActionParameters, created, i)
+		names[created.GetName()] = name
+		created.SetName(name)
+	}
+	if len(names) == 0 {
+		return
+	}
+	for _, impactedResource := range impactedResources {
+		if impactedResource.UnstructuredObj != nil {
+			impactedResource.UnstructuredObj.Object = replaceNames(impactedResource.UnstructuredObj.Object, "", names).(map[string]any)
+		}
+	}
+}
+
+// referenceNameFields are the fields which name another resource in any object, rather than only in the objects of a
+// reference field.
+var referenceNameFields = []string{"secretName", "claimName", "serviceAccountName"}
+
+// referenceObjectFields are the fields holding an object whose name field names another resource, besides the fields
+// ending with Ref, such as configMapRef or scaleTargetRef.
+var referenceObjectFields = []string{"configMap", "ownerReferences"}
+
+// isReferenceField returns whether the field named key, in the object held by the field named parentKey, names another
+// resource.
+func isReferenceField(parentKey, key string) bool {
+	if slices.Contains(referenceNameFields, key) {
+		return true
+	}
+	return key == "name" && (strings.HasSuffix(parentKey, "Ref") || slices.Contains(referenceObjectFields, parentKey))
+}
+
+// replaceNames returns the value with the names of the reference fields which are keys of names replaced with their
+// value. The other fields, such as labels and annotations, are kept as they are even if they hold such a name.
+// parentKey is the name of the field holding the value, and the items of a list are held by the field of the list.
+func replaceNames(value any, parentKey string, names map[string]string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if s, ok := item.(string); ok {
+				if name, ok := names[s]; ok && isReferenceField(parentKey, key) {
+					v[key] = name
+				}
+				continue
+			}
+			v[key] = replaceNames(item, key, names)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = replaceNames(item, parentKey, names)
+		}
+	}
+	return value
+}
+
+// derivedNameHash returns the hash suffixing the derived name of the resource created at the given index by the action.
+func (vm VM) derivedNameHash(actionName string, resourceActionParameters []*ResourceActionParameters, created *unstructured.Unstructured, index int) string {
+	params := make([]string, 0, len(resourceActionParameters))
+	for _, param := range resourceActionParameters {
+		params = append(params, param.GetName()+"="+param.GetValue())
+	}
+	// The parameters are sorted, so that their order does not change the names
+	slices.Sort(params)
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%s\n%s\n%s\n%d\n%s\n", actionName, strings.Join(params, "\n"), created.GroupVersionKind().String(), index, vm.now().UTC().Format(time.RFC3339Nano))
+	return hex.EncodeToString(hash.Sum(nil))[:derivedNameHashLength]
+}
@@ -0,0 +1,104 @@
+package lua
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestDeterministicNames(t *testing.T) {
+	action := appv1.ResourceActionDefinition{Name: "create-jobs", ActionLua: `
+local os = require("os")
+local function job(name)
+  return {operation = "create", resource = {apiVersion = "batch/v1", kind = "Job", metadata = {name = name, namespace = obj.metadata.namespace}}}
+end
+return {
+  job(obj.metadata.name .. "-" .. os.date("!%Y%m%d%H%M%S") .. "a"),
+  job(obj.metadata.name .. "-" .. os.date("!%Y%m%d%H%M%S") .. "b"),
+  job(obj.metadata.name .. "other"),
+}`}
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	names := func(t *testing.T, vm VM, sourceName string, params ...*ResourceActionParameters) []string {
+		t.Helper()
+		obj := StrToUnstructured(pausableRolloutYaml)
+		obj.SetName(sourceName)
+		result, err := vm.ExecuteResourceActionDefinition(obj, action, params)
+		require.NoError(t, err)
+		names := make([]string, 0, len(result.ImpactedResources))
+		for _, impactedResource := range result.ImpactedResources {
+			names = append(names, impactedResource.UnstructuredObj.GetName())
+		}
+		return names
+	}
+	param := func(name, value string) *ResourceActionParameters {
+		return &ResourceActionParameters{Name: &name, Value: &value}
+	}
+
+	vm := VM{DeterministicNames: true, Now: func() time.Time { return now }}
+	generated := names(t, vm, "guestbook", param("replicas", "2"), param("tier", "frontend"))
+	require.Len(t, generated, 3)
+	assert.Regexp(t, `^guestbook-[0-9a-f]{10}$`, generated[0])
+	assert.Regexp(t, `^guestbook-[0-9a-f]{10}$`, generated[1])
+	assert.NotEqual(t, generated[0], generated[1], "the resources created by the action must not have the same name")
+	assert.Equal(t, "guestbookother", generated[2], "the names which are not derived from the source must not be replaced")
+
+	t.Run("Stable", func(t *testing.T) {
+		for range 3 {
+			assert.Equal(t, generated, names(t, vm, "guestbook", param("tier", "frontend"), param("replicas", "2")))
+		}
+	})
+	t.Run("Parameters", func(t *testing.T) {
+		assert.NotEqual(t, generated[:2], names(t, vm, "guestbook", param("replicas", "3"), param("tier", "frontend"))[:2])
+	})
+	t.Run("Time", func(t *testing.T) {
+		later := VM{DeterministicNames: true, Now: func() time.Time { return now.Add(time.Second) }}
+		assert.NotEqual(t, generated[:2], names(t, later, "guestbook", param("replicas", "2"), param("tier", "frontend"))[:2])
+	})
+	t.Run("UnicodeName", func(t *testing.T) {
+		assert.Regexp(t, `^привет\.задача-[0-9a-f]{10}$`, names(t, vm, "привет.задача")[0])
+	})
+	t.Run("References", func(t *testing.T) {
+		action := appv1.ResourceActionDefinition{Name: "mount-config", ActionLua: `
+local configMap = {apiVersion = "v1", kind = "ConfigMap", metadata = {name = obj.metadata.name .. "-config", namespace = obj.metadata.namespace}}
+obj.spec.template = {spec = {
+  volumes = {{name = "config", configMap = {name = configMap.metadata.name}}},
+  containers = {{name = "guestbook", envFrom = {{configMapRef = {name = configMap.metadata.name}}}}},
+}}
+obj.metadata.annotations = {["example.com/config"] = configMap.metadata.name, ["example.com/old-config"] = "guestbook-config-old"}
+obj.metadata.labels = {config = configMap.metadata.name}
+return {{operation = "create", resource = configMap}, {operation = "patch", resource = obj}}`}
+		result, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(pausableRolloutYaml), action, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 2)
+		name := result.ImpactedResources[0].UnstructuredObj.GetName()
+		assert.Regexp(t, `^guestbook-[0-9a-f]{10}$`, name)
+		volumes, _, err := unstructured.NestedSlice(result.ImpactedResources[1].UnstructuredObj.Object, "spec", "template", "spec", "volumes")
+		require.NoError(t, err)
+		require.Len(t, volumes, 1)
+		reference, _, err := unstructured.NestedString(volumes[0].(map[string]any), "configMap", "name")
+		require.NoError(t, err)
+		assert.Equal(t, name, reference)
+		containers, _, err := unstructured.NestedSlice(result.ImpactedResources[1].UnstructuredObj.Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		require.Len(t, containers, 1)
+		envFrom, _, err := unstructured.NestedSlice(containers[0].(map[string]any), "envFrom")
+		require.NoError(t, err)
+		require.Len(t, envFrom, 1)
+		reference, _, err = unstructured.NestedString(envFrom[0].(map[string]any), "configMapRef", "name")
+		require.NoError(t, err)
+		assert.Equal(t, name, reference)
+
+		patched := result.ImpactedResources[1].UnstructuredObj
+		assert.Equal(t, "guestbook-config", patched.GetAnnotations()["example.com/config"], "the annotations must not be replaced")
+		assert.Equal(t, "guestbook-config-old", patched.GetAnnotations()["example.com/old-config"])
+		assert.Equal(t, "guestbook-config", patched.GetLabels()["config"], "the labels must not be replaced")
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		assert.Regexp(t, `^guestbook-[0-9]{14}a$`, names(t, VM{}, "guestbook")[0])
+	})
+}
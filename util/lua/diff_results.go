@@ -0,0 +1,97 @@
+package lua
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/google/go-cmp/cmp"
+)
+
+// DiffActionResults compares two results of an action, e.g. a recorded baseline and the result of the action after a
+// shared script changed, and returns whether they differ along with a report of the differences. The impacted
+// resources are paired by operation, kind, namespace and name, and compared once normalized with an
+// ActionOutputNormalizer, so that the integers which become float64 numbers in Lua and the volatile fields do not
+// differ. The statistics of the results are not compared.
+func DiffActionResults(a, b ActionResult) (changed bool, report string) {
+	var lines []string
+	if a.Status != b.Status {
+		lines = append(lines, fmt.Sprintf("status changed from %q to %q", a.Status, b.Status))
+	}
+	if a.Message != b.Message {
+		lines = append(lines, fmt.Sprintf("message changed from %q to %q", a.Message, b.Message))
+	}
+	if !slices.Equal(a.Warnings, b.Warnings) {
+		lines = append(lines, fmt.Sprintf("warnings changed from %q to %q", a.Warnings, b.Warnings))
+	}
+
+	aResources := groupImpactedResources(a.ImpactedResources)
+	bResources := groupImpactedResources(b.ImpactedResources)
+	for _, key := range impactedResourceKeys(a.ImpactedResources, b.ImpactedResources) {
+		aGroup, bGroup := aResources[key], bResources[key]
+		for i := 0; i < max(len(aGroup), len(bGroup)); i++ {
+			switch {
+			case i >= len(bGroup):
+				lines = append(lines, fmt.Sprintf("%s was removed", key))
+			case i >= len(aGroup):
+				lines = append(lines, fmt.Sprintf("%s was added", key))
+			default:
+				lines = append(lines, diffImpactedResources(key, aGroup[i], bGroup[i])...)
+			}
+		}
+	}
+	return len(lines) > 0, strings.Join(lines, "\n")
+}
+
+// impactedResourceKey identifies an impacted resource in the report of DiffActionResults.
+func impactedResourceKey(impactedResource ImpactedResource) string {
+	obj := impactedResource.UnstructuredObj
+	if obj == nil {
+		return fmt.Sprintf("%s of no resource", impactedResource.K8SOperation)
+	}
+	return fmt.Sprintf("%s of %s %s/%s", impactedResource.K8SOperation, obj.GroupVersionKind().GroupKind(), obj.GetNamespace(), obj.GetName())
+}
+
+// groupImpactedResources groups the impacted resources by key, in the order of the result.
+func groupImpactedResources(impactedResources []ImpactedResource) map[string][]ImpactedResource {
+	groups := make(map[string][]ImpactedResource)
+	for _, impactedResource := range impactedResources {
+		key := impactedResourceKey(impactedResource)
+		groups[key] = append(groups[key], impactedResource)
+	}
+	return groups
+}
+
+// impactedResourceKeys returns the keys of the impacted resources of both results, in the order of the results.
+func impactedResourceKeys(a, b []ImpactedResource) []string {
+	var keys []string
+	for _, impactedResource := range slices.Concat(a, b) {
+		if key := impactedResourceKey(impactedResource); !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// diffImpactedResources returns the lines reporting the differences between two impacted resources with the same key.
+func diffImpactedResources(key string, a, b ImpactedResource) []string {
+	var lines []string
+	if a.Subresource != b.Subresource {
+		lines = append(lines, fmt.Sprintf("%s changed from subresource %q to %q", key, a.Subresource, b.Subresource))
+	}
+	if !maps.Equal(a.ListMergeKeys, b.ListMergeKeys) {
+		lines = append(lines, fmt.Sprintf("%s changed from list merge keys %v to %v", key, a.ListMergeKeys, b.ListMergeKeys))
+	}
+	if a.UnstructuredObj == nil || b.UnstructuredObj == nil {
+		return lines
+	}
+	aObj, bObj := a.UnstructuredObj.DeepCopy(), b.UnstructuredObj.DeepCopy()
+	diff.Normalize(aObj, diff.WithNormalizer(ActionOutputNormalizer{}))
+	diff.Normalize(bObj, diff.WithNormalizer(ActionOutputNormalizer{}))
+	if objDiff := cmp.Diff(aObj.Object, bObj.Object); objDiff != "" {
+		lines = append(lines, fmt.Sprintf("%s changed (-a +b):\n%s", key, strings.TrimRight(objDiff, "\n")))
+	}
+	return lines
+}
@@ -0,0 +1,60 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestDiffActionResults(t *testing.T) {
+	vm := VM{}
+	run := func(t *testing.T, actionLua string) ActionResult {
+		t.Helper()
+		result, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(pausableRolloutYaml), appv1.ResourceActionDefinition{Name: "test", ActionLua: actionLua}, nil)
+		require.NoError(t, err)
+		return *result
+	}
+	baseline := run(t, `
+obj.spec.replicas = 3
+return obj`)
+
+	t.Run("Identical", func(t *testing.T) {
+		changed, report := DiffActionResults(baseline, run(t, `
+obj.spec.replicas = 2 + 1
+return obj`))
+		assert.False(t, changed)
+		assert.Empty(t, report)
+	})
+	t.Run("Numbers", func(t *testing.T) {
+		withReplicas := func(replicas any) ActionResult {
+			obj := StrToUnstructured(pausableRolloutYaml)
+			obj.Object["spec"].(map[string]any)["replicas"] = replicas
+			return ActionResult{ImpactedResources: []ImpactedResource{{UnstructuredObj: obj, K8SOperation: PatchOperation}}, Status: ActionResultStatusOK}
+		}
+		changed, report := DiffActionResults(withReplicas(int64(3)), withReplicas(float64(3)))
+		assert.False(t, changed, report)
+		changed, _ = DiffActionResults(withReplicas(int64(3)), withReplicas(3.5))
+		assert.True(t, changed)
+	})
+	t.Run("Changed", func(t *testing.T) {
+		changed, report := DiffActionResults(baseline, run(t, `
+obj.spec.replicas = 4
+return obj, {status = "warning", warnings = {"scaled up"}}`))
+		assert.True(t, changed)
+		assert.Contains(t, report, `status changed from "ok" to "warning"`)
+		assert.Contains(t, report, `warnings changed from [] to ["scaled up"]`)
+		assert.Contains(t, report, "patch of Rollout.argoproj.io default/guestbook changed (-a +b):")
+		assert.Contains(t, report, "replicas")
+	})
+	t.Run("AddedAndRemoved", func(t *testing.T) {
+		created := run(t, `
+local configMap = {apiVersion = "v1", kind = "ConfigMap", metadata = {name = "guestbook-config", namespace = "default"}}
+return {{operation = "create", resource = configMap}}`)
+		changed, report := DiffActionResults(baseline, created)
+		assert.True(t, changed)
+		assert.Equal(t, "patch of Rollout.argoproj.io default/guestbook was removed\ncreate of ConfigMap default/guestbook-config was added", report)
+	})
+}
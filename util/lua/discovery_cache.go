@@ -0,0 +1,104 @@
+package lua
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// discoveryCacheKeyFields are the fields of an object which the results of the action discovery scripts are assumed to
+// depend on. Objects differing only by other fields, such as their name or resource version, share cached results.
+var discoveryCacheKeyFields = [][]string{
+	{"apiVersion"},
+	{"kind"},
+	{"metadata", "labels"},
+	{"metadata", "annotations"},
+	{"metadata", "generation"},
+	{"spec"},
+	{"status"},
+}
+
+// DiscoveryCache caches the actions discovered for objects, keyed by the discovery scripts and the fields of the
+// objects the scripts read. Since the scripts are part of the key, reloading or overriding them invalidates the
+// results discovered with the previous scripts. The cache is cleared once it holds its maximum number of entries.
+type DiscoveryCache struct {
+	maxEntries int
+
+	lock    sync.RWMutex
+	entries map[string][]appv1.ResourceAction
+}
+
+// NewDiscoveryCache returns a DiscoveryCache holding up to the given number of entries.
+func NewDiscoveryCache(maxEntries int) *DiscoveryCache {
+	return &DiscoveryCache{maxEntries: maxEntries, entries: make(map[string][]appv1.ResourceAction)}
+}
+
+// Len returns the number of cached discovery results.
+func (c *DiscoveryCache) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return len(c.entries)
+}
+
+// Clear removes all the cached discovery results.
+func (c *DiscoveryCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries = make(map[string][]appv1.ResourceAction)
+}
+
+func (c *DiscoveryCache) get(key string) ([]appv1.ResourceAction, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	actions, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return copyResourceActions(actions), true
+}
+
+func (c *DiscoveryCache) set(key string, actions []appv1.ResourceAction) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.entries = make(map[string][]appv1.ResourceAction)
+	}
+	c.entries[key] = copyResourceActions(actions)
+}
+
+// discoveryCacheKey returns the hash of the discovery scripts and of the fields of the object they are assumed to read.
+func discoveryCacheKey(obj *unstructured.Unstructured, scripts []string) (string, error) {
+	fields := make(map[string]any, len(discoveryCacheKeyFields))
+	for _, path := range discoveryCacheKeyFields {
+		value, ok, err := unstructured.NestedFieldNoCopy(obj.Object, path...)
+		if err != nil {
+			return "", fmt.Errorf("error reading field %v: %w", path, err)
+		}
+		if ok {
+			fields[fmt.Sprintf("%v", path)] = value
+		}
+	}
+	data, err := json.Marshal(struct {
+		Scripts []string       `json:"scripts"`
+		Fields  map[string]any `json:"fields"`
+	}{Scripts: scripts, Fields: fields})
+	if err != nil {
+		return "", fmt.Errorf("error marshaling discovery cache key: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func copyResourceActions(actions []appv1.ResourceAction) []appv1.ResourceAction {
+	copied := make([]appv1.ResourceAction, len(actions))
+	for i := range actions {
+		actions[i].DeepCopyInto(&copied[i])
+	}
+	return copied
+}
@@ -0,0 +1,104 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// nameDiscoveryLua is a discovery script reading a field which is not part of the cache key, which shows whether its
+// result comes from the cache.
+const nameDiscoveryLua = `
+actions = {}
+actions["restart-" .. obj.metadata.name] = {}
+return actions
+`
+
+func newDiscoveryCacheTestObj(t *testing.T, name string, replicas int64) *unstructured.Unstructured {
+	t.Helper()
+	obj := StrToUnstructured(objJSON)
+	obj.SetName(name)
+	require.NoError(t, unstructured.SetNestedField(obj.Object, replicas, "spec", "replicas"))
+	return obj
+}
+
+func TestExecuteResourceActionDiscoveryWithCache(t *testing.T) {
+	cache := NewDiscoveryCache(10)
+	vm := VM{DiscoveryCache: cache}
+
+	actions, err := vm.ExecuteResourceActionDiscovery(newDiscoveryCacheTestObj(t, "first", 1), []string{nameDiscoveryLua})
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "restart-first", actions[0].Name)
+	assert.Equal(t, 1, cache.Len())
+
+	t.Run("Hit", func(t *testing.T) {
+		cached, err := vm.ExecuteResourceActionDiscovery(newDiscoveryCacheTestObj(t, "second", 1), []string{nameDiscoveryLua})
+		require.NoError(t, err)
+		assert.Equal(t, actions, cached)
+		assert.Equal(t, 1, cache.Len())
+	})
+	t.Run("DifferentSpec", func(t *testing.T) {
+		discovered, err := vm.ExecuteResourceActionDiscovery(newDiscoveryCacheTestObj(t, "third", 2), []string{nameDiscoveryLua})
+		require.NoError(t, err)
+		require.Len(t, discovered, 1)
+		assert.Equal(t, "restart-third", discovered[0].Name)
+		assert.Equal(t, 2, cache.Len())
+	})
+	t.Run("DifferentScripts", func(t *testing.T) {
+		discovered, err := vm.ExecuteResourceActionDiscovery(newDiscoveryCacheTestObj(t, "fourth", 1), []string{validDiscoveryLua})
+		require.NoError(t, err)
+		assert.Len(t, discovered, 2)
+		assert.Equal(t, 3, cache.Len())
+	})
+	t.Run("CopiedResults", func(t *testing.T) {
+		cached, err := vm.ExecuteResourceActionDiscovery(newDiscoveryCacheTestObj(t, "fifth", 1), []string{nameDiscoveryLua})
+		require.NoError(t, err)
+		cached[0].Name = "modified"
+		cached, err = vm.ExecuteResourceActionDiscovery(newDiscoveryCacheTestObj(t, "fifth", 1), []string{nameDiscoveryLua})
+		require.NoError(t, err)
+		assert.Equal(t, "restart-first", cached[0].Name)
+	})
+	t.Run("Clear", func(t *testing.T) {
+		cache.Clear()
+		assert.Equal(t, 0, cache.Len())
+		discovered, err := vm.ExecuteResourceActionDiscovery(newDiscoveryCacheTestObj(t, "sixth", 1), []string{nameDiscoveryLua})
+		require.NoError(t, err)
+		require.Len(t, discovered, 1)
+		assert.Equal(t, "restart-sixth", discovered[0].Name)
+	})
+}
+
+func TestDiscoveryCacheMaxEntries(t *testing.T) {
+	cache := NewDiscoveryCache(2)
+	vm := VM{DiscoveryCache: cache}
+
+	for replicas := int64(1); replicas <= 3; replicas++ {
+		_, err := vm.ExecuteResourceActionDiscovery(newDiscoveryCacheTestObj(t, "test", replicas), []string{nameDiscoveryLua})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, cache.Len())
+}
+
+func BenchmarkExecuteResourceActionDiscovery(b *testing.B) {
+	obj := getObj(b, "../../resource_customizations/argoproj.io/Rollout/actions/testdata/healthy_rollout.yaml")
+	vm := VM{}
+	scripts, err := vm.GetResourceActionDiscovery(obj)
+	require.NoError(b, err)
+
+	b.Run("WithoutCache", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			_, err := vm.ExecuteResourceActionDiscovery(obj, scripts)
+			require.NoError(b, err)
+		}
+	})
+	b.Run("WithCache", func(b *testing.B) {
+		vm := VM{DiscoveryCache: NewDiscoveryCache(10)}
+		for n := 0; n < b.N; n++ {
+			_, err := vm.ExecuteResourceActionDiscovery(obj, scripts)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package lua
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// defaultDryRunFieldManager is the field manager of the dry-run applies if the DryRunApplier does not configure one
+const defaultDryRunFieldManager = "argocd-action-preview"
+
+// DryRunApplier previews the resources impacted by an action by submitting them to the API server with a dry-run
+// server-side apply. Unlike comparing the resources returned by the action with their source, the preview includes the
+// defaults and mutations of the API server and of the admission webhooks, and fails like the action would if they
+// reject a resource. Nothing is persisted.
+type DryRunApplier struct {
+	// Client submits the resources. It is required.
+	Client dynamic.Interface
+	// Mapper maps the kinds of the resources to their API resources. It is required.
+	Mapper meta.RESTMapper
+	// FieldManager is the field manager of the applies. defaultDryRunFieldManager is used if it is empty.
+	FieldManager string
+}
+
+// Apply returns the resources the API server would persist for the resources impacted by an action run on the source
+// resource, in the order of the impacted resources. The patched resources are previewed with ApplyPatch first.
+func (a DryRunApplier) Apply(ctx context.Context, source *unstructured.Unstructured, impactedResources []ImpactedResource) ([]*unstructured.Unstructured, error) {
+	if a.Client == nil || a.Mapper == nil {
+		return nil, fmt.Errorf("dry-run apply requires a client and a REST mapper")
+	}
+	fieldManager := a.FieldManager
+	if fieldManager == "" {
+		fieldManager = defaultDryRunFieldManager
+	}
+	results := make([]*unstructured.Unstructured, 0, len(impactedResources))
+	for i, impactedResource := range impactedResources {
+		var obj *unstructured.Unstructured
+		switch impactedResource.K8SOperation {
+		case PatchOperation:
+			patched, err := ApplyPatch(source, impactedResource)
+			if err != nil {
+				return nil, fmt.Errorf("error previewing patch of resource %d: %w", i, err)
+			}
+			obj = patched
+		case CreateOperation:
+			obj = impactedResource.UnstructuredObj.DeepCopy()
+		default:
+			return nil, fmt.Errorf("unsupported operation for dry-run apply: %s", impactedResource.K8SOperation)
+		}
+		// The API server rejects applied objects with managed fields, and the resource version of the source is not
+		// meant as a precondition of the action
+		obj.SetManagedFields(nil)
+		obj.SetResourceVersion("")
+
+		gvk := obj.GroupVersionKind()
+		mapping, err := a.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, fmt.Errorf("error mapping resource %d, %s %q: %w", i, obj.GetKind(), obj.GetName(), err)
+		}
+		var resourceClient dynamic.ResourceInterface = a.Client.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			resourceClient = a.Client.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+		}
+		result, err := resourceClient.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+			DryRun:       []string{metav1.DryRunAll},
+			FieldManager: fieldManager,
+			Force:        true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error dry-run applying resource %d, %s %q: %w", i, obj.GetKind(), obj.GetName(), err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,109 @@
+package lua
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
+)
+
+const dryRunActionLua = `
+obj.spec.replicas = 3
+impactedResources = {}
+impactedResources[1] = {operation = "patch", resource = obj}
+impactedResources[2] = {operation = "create", resource = {
+  apiVersion = "v1",
+  kind = "ConfigMap",
+  metadata = {name = "guestbook-settings", namespace = obj.metadata.namespace},
+}}
+return impactedResources
+`
+
+const dryRunSourceObj = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  resourceVersion: "123"
+  managedFields:
+  - manager: kubectl
+    operation: Apply
+spec:
+  replicas: 1
+`
+
+func newDryRunTestMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	return mapper
+}
+
+func TestDryRunApplierApply(t *testing.T) {
+	source := StrToUnstructured(dryRunSourceObj)
+	vm := VM{}
+	impactedResources, err := vm.ExecuteResourceAction(source, dryRunActionLua, nil)
+	require.NoError(t, err)
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(apiruntime.NewScheme(), map[schema.GroupVersionResource]string{
+		{Group: "apps", Version: "v1", Resource: "deployments"}: "DeploymentList",
+		{Version: "v1", Resource: "configmaps"}:                 "ConfigMapList",
+	})
+	var applied []*unstructured.Unstructured
+	// The fake server defaults the strategy of the deployments, like the API server would
+	client.PrependReactor("patch", "*", func(action kubetesting.Action) (bool, apiruntime.Object, error) {
+		patchAction := action.(kubetesting.PatchAction)
+		require.Equal(t, types.ApplyPatchType, patchAction.GetPatchType())
+		obj := &unstructured.Unstructured{}
+		require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &obj.Object))
+		applied = append(applied, obj.DeepCopy())
+		if obj.GetKind() == "Deployment" {
+			require.NoError(t, unstructured.SetNestedField(obj.Object, "RollingUpdate", "spec", "strategy", "type"))
+		}
+		return true, obj, nil
+	})
+
+	applier := DryRunApplier{Client: client, Mapper: newDryRunTestMapper()}
+	results, err := applier.Apply(context.Background(), source, impactedResources)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	replicas, _, _ := unstructured.NestedFieldNoCopy(results[0].Object, "spec", "replicas")
+	assert.EqualValues(t, 3, replicas)
+	strategy, _, _ := unstructured.NestedString(results[0].Object, "spec", "strategy", "type")
+	assert.Equal(t, "RollingUpdate", strategy, "the result of the server must be returned")
+	assert.Equal(t, "guestbook-settings", results[1].GetName())
+
+	require.Len(t, applied, 2)
+	assert.Nil(t, applied[0].GetManagedFields())
+	assert.Empty(t, applied[0].GetResourceVersion())
+
+	_, err = client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}).Namespace("default").Get(context.Background(), "guestbook-settings", metav1.GetOptions{})
+	require.Error(t, err, "dry-run applies must not persist the resources")
+}
+
+func TestDryRunApplierApplyErrors(t *testing.T) {
+	source := StrToUnstructured(dryRunSourceObj)
+	impactedResources := []ImpactedResource{{UnstructuredObj: source, K8SOperation: PatchOperation}}
+
+	t.Run("MissingClient", func(t *testing.T) {
+		_, err := DryRunApplier{Mapper: newDryRunTestMapper()}.Apply(context.Background(), source, impactedResources)
+		require.EqualError(t, err, "dry-run apply requires a client and a REST mapper")
+	})
+	t.Run("UnknownKind", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(apiruntime.NewScheme())
+		_, err := DryRunApplier{Client: client, Mapper: meta.NewDefaultRESTMapper(nil)}.Apply(context.Background(), source, impactedResources)
+		require.ErrorContains(t, err, `error mapping resource 0, Deployment "guestbook"`)
+	})
+}
@@ -22,7 +22,7 @@ type IndividualTest struct {
 	HealthStatus health.HealthStatus `yaml:"healthStatus"`
 }
 
-func getObj(t *testing.T, path string) *unstructured.Unstructured {
+func getObj(t testing.TB, path string) *unstructured.Unstructured {
 	t.Helper()
 	yamlBytes, err := os.ReadFile(path)
 	require.NoError(t, err)
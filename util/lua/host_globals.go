@@ -0,0 +1,46 @@
+package lua
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// reservedGlobals are the globals which the VM sets for the scripts, or which hold its libraries, and therefore cannot
+// be registered.
+var reservedGlobals = []string{
+	"obj", "actionParams", "actionConfig", NullGlobal, ToggleFuncName, "kubeVersion", "previousObject",
+	"previousResources", "relatedResources",
+	lua.BaseLibName, lua.LoadLibName, lua.TabLibName, lua.StringLibName, lua.MathLibName, lua.CoroutineLibName,
+	lua.IoLibName, lua.DebugLibName, lua.ChannelLibName, lua.OsLibName,
+	ReLibName, URLLibName, YAMLLibName, MetaLibName, TimeLibName, NumLibName,
+}
+
+// RegisterGlobal exposes a function of the host, e.g. a feature flag check, to the scripts as the named global. The
+// function runs with the privileges of the host, so that it extends the sandbox of the scripts deliberately, unlike
+// UseOpenLibs. The globals set by the VM and the names of the libraries cannot be registered.
+func (vm *VM) RegisterGlobal(name string, fn lua.LGFunction) error {
+	if name == "" || slices.Contains(reservedGlobals, name) {
+		return fmt.Errorf("cannot register global %q, which is reserved", name)
+	}
+	if fn == nil {
+		return fmt.Errorf("cannot register global %q without a function", name)
+	}
+	// The globals are copied, so that the copies of the VM made before are not affected
+	globals := maps.Clone(vm.hostGlobals)
+	if globals == nil {
+		globals = make(map[string]lua.LGFunction)
+	}
+	globals[name] = fn
+	vm.hostGlobals = globals
+	return nil
+}
+
+// setHostGlobals sets the registered globals of the VM in the state of a script.
+func (vm VM) setHostGlobals(l *lua.LState) {
+	for name, fn := range vm.hostGlobals {
+		l.SetGlobal(name, l.NewFunction(fn))
+	}
+}
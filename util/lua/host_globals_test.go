@@ -0,0 +1,43 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func TestRegisterGlobal(t *testing.T) {
+	flags := map[string]bool{"fast-rollouts": true}
+	featureEnabled := func(l *lua.LState) int {
+		l.Push(lua.LBool(flags[l.CheckString(1)]))
+		return 1
+	}
+
+	vm := VM{}
+	unregistered := vm
+	require.NoError(t, vm.RegisterGlobal("featureEnabled", featureEnabled))
+
+	script := `
+if featureEnabled("fast-rollouts") then
+  obj.metadata.labels["rollout-speed"] = "fast"
+end
+return obj`
+	impactedResources, err := vm.ExecuteResourceAction(StrToUnstructured(objJSON), script, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 1)
+	assert.Equal(t, "fast", impactedResources[0].UnstructuredObj.GetLabels()["rollout-speed"])
+
+	_, err = unregistered.ExecuteResourceAction(StrToUnstructured(objJSON), script, nil)
+	require.ErrorContains(t, err, "attempt to call a non-function object", "the copies made before registering must not be affected")
+
+	t.Run("Reserved", func(t *testing.T) {
+		for _, name := range []string{"obj", "os", "toggle", ""} {
+			require.EqualError(t, vm.RegisterGlobal(name, featureEnabled), `cannot register global "`+name+`", which is reserved`)
+		}
+	})
+	t.Run("NilFunction", func(t *testing.T) {
+		require.EqualError(t, vm.RegisterGlobal("lookup", nil), `cannot register global "lookup" without a function`)
+	})
+}
@@ -0,0 +1,95 @@
+package lua
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+// ImpactAnalyzer reviews the resources impacted by an action before they are returned, e.g. to flag sensitive changes.
+type ImpactAnalyzer interface {
+	// Analyze returns warnings about the resources impacted by the named action run on the source resource, which are
+	// added to the warnings of the action. It returns an error to reject the action.
+	Analyze(actionName string, source *unstructured.Unstructured, impactedResources []ImpactedResource) ([]string, error)
+}
+
+// rbacGroup is the API group of the RBAC resources
+const rbacGroup = "rbac.authorization.k8s.io"
+
+// rbacFields are the fields of the RBAC resources which grant permissions, by kind
+var rbacFields = map[string][]string{
+	"Role":               {"rules"},
+	"ClusterRole":        {"rules", "aggregationRule"},
+	"RoleBinding":        {"subjects", "roleRef"},
+	"ClusterRoleBinding": {"subjects", "roleRef"},
+}
+
+// RBACAnalyzer warns about the actions which create RBAC roles or bindings, or change the rules of the roles or the
+// subjects and role of the bindings, since such changes may widen the permissions granted in the cluster.
+type RBACAnalyzer struct {
+	// AllowedActions optionally are the glob patterns of the actions which may change RBAC resources. If it is not nil,
+	// the other actions are rejected when they do instead of being warned about.
+	AllowedActions []string
+}
+
+// Analyze implements ImpactAnalyzer.
+func (a RBACAnalyzer) Analyze(actionName string, source *unstructured.Unstructured, impactedResources []ImpactedResource) ([]string, error) {
+	var warnings []string
+	for _, impactedResource := range impactedResources {
+		obj := impactedResource.UnstructuredObj
+		gvk := obj.GroupVersionKind()
+		fields, ok := rbacFields[gvk.Kind]
+		if gvk.Group != rbacGroup || !ok {
+			continue
+		}
+		var warning string
+		switch impactedResource.K8SOperation {
+		case CreateOperation:
+			warning = fmt.Sprintf("action %q creates %s %s", actionName, gvk.Kind, resourceName(obj))
+		case PatchOperation:
+			for _, field := range fields {
+				if !reflect.DeepEqual(obj.Object[field], source.Object[field]) {
+					warning = fmt.Sprintf("action %q changes the %s of %s %s", actionName, field, gvk.Kind, resourceName(obj))
+					break
+				}
+			}
+		}
+		if warning == "" {
+			continue
+		}
+		if a.AllowedActions != nil && !glob.MatchStringInList(a.AllowedActions, actionName, glob.GLOB) {
+			return nil, fmt.Errorf("%s, which is not allowed", warning)
+		}
+		warnings = append(warnings, warning)
+	}
+	return warnings, nil
+}
+
+func resourceName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// analyzeImpact runs the impact analyzers of the VM on the result of the named action, adding their warnings to the
+// result.
+func (vm VM) analyzeImpact(obj *unstructured.Unstructured, actionName string, result *ActionResult) error {
+	for _, analyzer := range vm.ImpactAnalyzers {
+		warnings, err := analyzer.Analyze(actionName, obj, result.ImpactedResources)
+		if err != nil {
+			return err
+		}
+		if len(warnings) == 0 {
+			continue
+		}
+		result.Warnings = append(result.Warnings, warnings...)
+		if result.Status == ActionResultStatusOK {
+			result.Status = ActionResultStatusWarning
+		}
+	}
+	return nil
+}
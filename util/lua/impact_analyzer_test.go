@@ -0,0 +1,76 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const roleObj = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: guestbook
+  namespace: default
+  labels:
+    app: guestbook
+rules:
+- apiGroups: [""]
+  resources: ["configmaps"]
+  verbs: ["get"]
+`
+
+const grantSecretsLua = `
+obj.rules[2] = {apiGroups = {""}, resources = {"secrets"}, verbs = {"get"}}
+return obj
+`
+
+func TestRBACAnalyzer(t *testing.T) {
+	testObj := StrToUnstructured(roleObj)
+
+	t.Run("RoleRulesChanged", func(t *testing.T) {
+		vm := VM{ImpactAnalyzers: []ImpactAnalyzer{RBACAnalyzer{}}}
+		result, err := vm.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "grant-secrets", ActionLua: grantSecretsLua}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{`action "grant-secrets" changes the rules of Role default/guestbook`}, result.Warnings)
+		assert.Equal(t, ActionResultStatusWarning, result.Status)
+	})
+	t.Run("LabelsChanged", func(t *testing.T) {
+		vm := VM{ImpactAnalyzers: []ImpactAnalyzer{RBACAnalyzer{}}}
+		result, err := vm.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "relabel", ActionLua: `obj.metadata.labels.app = "other"
+return obj`}, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result.Warnings)
+		assert.Equal(t, ActionResultStatusOK, result.Status)
+	})
+	t.Run("BindingCreated", func(t *testing.T) {
+		vm := VM{ImpactAnalyzers: []ImpactAnalyzer{RBACAnalyzer{}}}
+		action := appv1.ResourceActionDefinition{Name: "bind", ActionLua: `
+return {{operation = "create", resource = {
+  apiVersion = "rbac.authorization.k8s.io/v1",
+  kind = "RoleBinding",
+  metadata = {name = "guestbook", namespace = "default"},
+  roleRef = {apiGroup = "rbac.authorization.k8s.io", kind = "Role", name = "guestbook"},
+}}}`}
+		result, err := vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{`action "bind" creates RoleBinding default/guestbook`}, result.Warnings)
+	})
+	t.Run("AllowedActions", func(t *testing.T) {
+		vm := VM{ImpactAnalyzers: []ImpactAnalyzer{RBACAnalyzer{AllowedActions: []string{"grant-*"}}}}
+		result, err := vm.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "grant-secrets", ActionLua: grantSecretsLua}, nil)
+		require.NoError(t, err)
+		assert.Len(t, result.Warnings, 1)
+
+		_, err = vm.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "escalate", ActionLua: grantSecretsLua}, nil)
+		require.EqualError(t, err, `action "escalate" changes the rules of Role default/guestbook, which is not allowed`)
+	})
+	t.Run("NoAnalyzers", func(t *testing.T) {
+		result, err := VM{}.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "grant-secrets", ActionLua: grantSecretsLua}, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result.Warnings)
+	})
+}
@@ -1,9 +1,16 @@
 package lua
 
 import (
+	"cmp"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // This struct represents a wrapper, that is returned from Lua custom action script, around the unstructured k8s resource + a k8s operation
@@ -13,7 +20,6 @@ import (
 // This enables ArgoCD to create NEW resources upon custom action.
 // Note that the Lua code in the custom action is coupled to this type, since Lua json output is then unmarshalled to this struct.
 // Avoided using iota, since need the mapping of the string value the end users will write in Lua code ("create" and "patch").
-// TODO: maybe there is a nicer general way to marshal and unmarshal, instead of explicit iteration over the enum values.
 type K8SOperation string
 
 const (
@@ -24,27 +30,238 @@ const (
 type ImpactedResource struct {
 	UnstructuredObj *unstructured.Unstructured `json:"resource"`
 	K8SOperation    K8SOperation               `json:"operation"`
+	// ListMergeKeys optionally maps the dot-separated paths of lists of a patched resource, e.g.
+	// spec.template.spec.containers, to the field identifying their items, so that the items are merged by key with
+	// those of the source resource instead of replacing them. Custom resources need them, since they have no strategic
+	// merge patch metadata. Both the API server and the patch preview of the action tests merge the lists with
+	// ApplyPatch.
+	ListMergeKeys map[string]string `json:"listMergeKeys,omitempty"`
+	// PatchType optionally is the way the returned resource is applied to the source resource of a "patch" operation.
+	// Once the action is executed, the resource holds the patched source resource whatever its patch type.
+	PatchType PatchType `json:"patchType,omitempty"`
+	// Subresource optionally is the subresource of the source resource which a "patch" operation targets instead of
+	// the resource itself, e.g. scale, since some controllers only react to the replicas being changed through it.
+	Subresource Subresource `json:"subresource,omitempty"`
+	// Wave optionally is the phase in which the resource is applied, like the sync waves of the applications. The
+	// resources of lower waves are applied first, e.g. to create the prerequisites of the patched resources, and the
+	// resources of the same wave are applied in order.
+	Wave int `json:"wave,omitempty"`
+	// IntegerFields optionally are the dot-separated paths of the integer fields of the resource, e.g. spec.replicas,
+	// which are converted to integers once the action is executed. The action fails if one of them holds a fractional
+	// number, rather than the resource being rejected when it is applied.
+	IntegerFields []string `json:"integerFields,omitempty"`
 }
 
-func (op *K8SOperation) UnmarshalJSON(data []byte) error {
-	switch string(data) {
-	case `"create"`:
-		*op = CreateOperation
-	case `"patch"`:
-		*op = PatchOperation
+// Subresource is a subresource of the source resource, which a patch action can target.
+type Subresource string
+
+const (
+	// ScaleSubresource is the scale subresource, exposing the replicas of the workloads
+	ScaleSubresource Subresource = "scale"
+	// StatusSubresource is the status subresource, which only updates the status of the resources
+	StatusSubresource Subresource = "status"
+)
+
+// validateSubresources returns an error if an impacted resource targets an unsupported subresource, or targets a
+// subresource without being patched.
+func validateSubresources(impactedResources []ImpactedResource) error {
+	for i, impactedResource := range impactedResources {
+		switch impactedResource.Subresource {
+		case "":
+			continue
+		case ScaleSubresource, StatusSubresource:
+		default:
+			return fmt.Errorf("unsupported subresource: %s", impactedResource.Subresource)
+		}
+		if impactedResource.K8SOperation != PatchOperation {
+			return fmt.Errorf("resource %d targets the %s subresource but is not patched", i, impactedResource.Subresource)
+		}
+	}
+	return nil
+}
+
+// convertIntegerFields converts the integer fields of the impacted resources, which are float64 numbers once they went
+// through Lua, to integers. The fields which the resources do not have are ignored.
+func convertIntegerFields(impactedResources []ImpactedResource) error {
+	for i, impactedResource := range impactedResources {
+		obj := impactedResource.UnstructuredObj
+		if obj == nil {
+			continue
+		}
+		for _, field := range impactedResource.IntegerFields {
+			path := strings.Split(field, ".")
+			value, found, err := unstructured.NestedFieldNoCopy(obj.Object, path...)
+			if err != nil || !found {
+				continue
+			}
+			var converted int64
+			switch number := value.(type) {
+			case int64:
+				continue
+			case float64:
+				converted, err = toInt(number)
+				if err != nil {
+					return fmt.Errorf("resource %d, integer field %q: %w", i, field, err)
+				}
+			default:
+				return fmt.Errorf("resource %d, integer field %q is a %T, not a number", i, field, value)
+			}
+			if err := unstructured.SetNestedField(obj.Object, converted, path...); err != nil {
+				return fmt.Errorf("resource %d, integer field %q: %w", i, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the operation is not supported by the actions.
+func (op K8SOperation) Validate() error {
+	switch op {
+	case CreateOperation, PatchOperation:
+		return nil
 	default:
+		return fmt.Errorf("unsupported operation: %q", string(op))
+	}
+}
+
+// String returns the name of the operation, as written in the scripts.
+func (op K8SOperation) String() string {
+	return string(op)
+}
+
+func (op *K8SOperation) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
 		return fmt.Errorf("unsupported operation: %s", data)
 	}
+	if err := K8SOperation(name).Validate(); err != nil {
+		return err
+	}
+	*op = K8SOperation(name)
 	return nil
 }
 
 func (op K8SOperation) MarshalJSON() ([]byte, error) {
-	switch op {
-	case CreateOperation:
-		return []byte(`"create"`), nil
-	case PatchOperation:
-		return []byte(`"patch"`), nil
-	default:
-		return nil, fmt.Errorf("unsupported operation: %s", op)
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(op))
+}
+
+// clusterScopedKinds are the well-known cluster-scoped kinds, used to tell whether a resource created by an action must
+// have a namespace when the VM has no ResourceInfoProvider.
+var clusterScopedKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "Namespace"}:                                                  true,
+	{Group: "", Kind: "Node"}:                                                       true,
+	{Group: "", Kind: "PersistentVolume"}:                                           true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}:                       true,
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}:                true,
+	{Group: "storage.k8s.io", Kind: "StorageClass"}:                                 true,
+	{Group: "storage.k8s.io", Kind: "CSIDriver"}:                                    true,
+	{Group: "storage.k8s.io", Kind: "VolumeAttachment"}:                             true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}:               true,
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"}:   true,
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"}: true,
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"}:                             true,
+	{Group: "networking.k8s.io", Kind: "IngressClass"}:                              true,
+	{Group: "apiregistration.k8s.io", Kind: "APIService"}:                           true,
+	{Group: "argoproj.io", Kind: "ClusterWorkflowTemplate"}:                         true,
+}
+
+type wellKnownResourceInfoProvider struct{}
+
+func (wellKnownResourceInfoProvider) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	return !clusterScopedKinds[gk], nil
+}
+
+// validateCreatedResourceNamespaces returns an error if a namespaced resource created by an action has no namespace,
+// which would otherwise only fail when the resource is applied.
+func validateCreatedResourceNamespaces(impactedResources []ImpactedResource, infoProvider kube.ResourceInfoProvider) error {
+	if infoProvider == nil {
+		infoProvider = wellKnownResourceInfoProvider{}
+	}
+	for _, impactedResource := range impactedResources {
+		obj := impactedResource.UnstructuredObj
+		if impactedResource.K8SOperation != CreateOperation || obj == nil || obj.GetNamespace() != "" {
+			continue
+		}
+		gk := obj.GroupVersionKind().GroupKind()
+		if kube.IsNamespacedOrUnknown(infoProvider, gk) {
+			return fmt.Errorf("created resource %s %q is namespaced but has no namespace", obj.GetKind(), obj.GetName())
+		}
 	}
+	return nil
+}
+
+// validateCreatedResourcesAreNotSource returns an error if an action creates its source resource, which already exists
+// and is almost always returned with the wrong operation.
+func validateCreatedResourcesAreNotSource(source *unstructured.Unstructured, impactedResources []ImpactedResource) error {
+	for _, impactedResource := range impactedResources {
+		if impactedResource.K8SOperation == CreateOperation && impactedResource.UnstructuredObj != nil && isSameResource(impactedResource.UnstructuredObj, source) {
+			return errors.New("create operation must not target the source resource; use patch instead")
+		}
+	}
+	return nil
+}
+
+// ImpactedResourceSelector selects impacted resources by operation, kind, namespace and name. The empty fields match
+// all the impacted resources.
+type ImpactedResourceSelector struct {
+	Operation K8SOperation
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// Matches returns whether the selector selects the impacted resource.
+func (s ImpactedResourceSelector) Matches(impactedResource ImpactedResource) bool {
+	obj := impactedResource.UnstructuredObj
+	return (s.Operation == "" || s.Operation == impactedResource.K8SOperation) &&
+		(s.Kind == "" || s.Kind == obj.GetKind()) &&
+		(s.Namespace == "" || s.Namespace == obj.GetNamespace()) &&
+		(s.Name == "" || s.Name == obj.GetName())
+}
+
+// FilterImpactedResources returns the impacted resources matching any of the selectors, in their order, so that only
+// some of the resources impacted by an action are applied, e.g. as chosen by the user.
+func FilterImpactedResources(impactedResources []ImpactedResource, selectors ...ImpactedResourceSelector) []ImpactedResource {
+	filtered := make([]ImpactedResource, 0, len(impactedResources))
+	for _, impactedResource := range impactedResources {
+		for _, selector := range selectors {
+			if selector.Matches(impactedResource) {
+				filtered = append(filtered, impactedResource)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// SelectImpactedResources returns the impacted resources at the given indexes, in their order. It returns an error if
+// an index is out of range.
+func SelectImpactedResources(impactedResources []ImpactedResource, indexes ...int) ([]ImpactedResource, error) {
+	selected := make([]bool, len(impactedResources))
+	for _, index := range indexes {
+		if index < 0 || index >= len(impactedResources) {
+			return nil, fmt.Errorf("impacted resource index %d is out of range, the action impacted %d resources", index, len(impactedResources))
+		}
+		selected[index] = true
+	}
+	filtered := make([]ImpactedResource, 0, len(indexes))
+	for i, impactedResource := range impactedResources {
+		if selected[i] {
+			filtered = append(filtered, impactedResource)
+		}
+	}
+	return filtered, nil
+}
+
+// SortImpactedResourcesByWave returns the impacted resources in the order they are applied: by wave, then in their
+// order within a wave.
+func SortImpactedResourcesByWave(impactedResources []ImpactedResource) []ImpactedResource {
+	sorted := slices.Clone(impactedResources)
+	slices.SortStableFunc(sorted, func(a, b ImpactedResource) int {
+		return cmp.Compare(a.Wave, b.Wave)
+	})
+	return sorted
 }
@@ -0,0 +1,89 @@
+package lua
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestK8SOperation(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		require.NoError(t, CreateOperation.Validate())
+		require.NoError(t, PatchOperation.Validate())
+		// Deleting resources is not supported by the actions yet
+		require.EqualError(t, K8SOperation("delete").Validate(), `unsupported operation: "delete"`)
+		require.EqualError(t, K8SOperation("").Validate(), `unsupported operation: ""`)
+	})
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "patch", PatchOperation.String())
+	})
+	t.Run("JSON", func(t *testing.T) {
+		for _, op := range []K8SOperation{CreateOperation, PatchOperation} {
+			data, err := json.Marshal(op)
+			require.NoError(t, err)
+			var decoded K8SOperation
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, op, decoded)
+		}
+
+		var decoded K8SOperation
+		require.EqualError(t, json.Unmarshal([]byte(`"delete"`), &decoded), `unsupported operation: "delete"`)
+		require.EqualError(t, json.Unmarshal([]byte(`3`), &decoded), "unsupported operation: 3")
+		_, err := json.Marshal(K8SOperation("delete"))
+		require.ErrorContains(t, err, `unsupported operation: "delete"`)
+	})
+}
+
+func TestFilterImpactedResources(t *testing.T) {
+	source := StrToUnstructured(objJSON)
+	impactedResources, err := VM{}.ExecuteResourceAction(source, `
+local job = {apiVersion = "batch/v1", kind = "Job", metadata = {name = "backup", namespace = obj.metadata.namespace}}
+local configMap = {apiVersion = "v1", kind = "ConfigMap", metadata = {name = "settings", namespace = obj.metadata.namespace}}
+return {{operation = "patch", resource = obj}, {operation = "create", resource = job}, {operation = "create", resource = configMap}}`, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 3)
+
+	t.Run("Selectors", func(t *testing.T) {
+		filtered := FilterImpactedResources(impactedResources, ImpactedResourceSelector{Operation: PatchOperation}, ImpactedResourceSelector{Kind: "ConfigMap"})
+		require.Len(t, filtered, 2)
+		assert.Equal(t, "Rollout", filtered[0].UnstructuredObj.GetKind())
+		assert.Equal(t, "settings", filtered[1].UnstructuredObj.GetName())
+
+		assert.Empty(t, FilterImpactedResources(impactedResources))
+		assert.Len(t, FilterImpactedResources(impactedResources, ImpactedResourceSelector{}), 3)
+	})
+	t.Run("Indexes", func(t *testing.T) {
+		selected, err := SelectImpactedResources(impactedResources, 2, 0)
+		require.NoError(t, err)
+		require.Len(t, selected, 2)
+		assert.Equal(t, PatchOperation, selected[0].K8SOperation)
+		assert.Equal(t, "settings", selected[1].UnstructuredObj.GetName())
+
+		_, err = SelectImpactedResources(impactedResources, 3)
+		require.EqualError(t, err, "impacted resource index 3 is out of range, the action impacted 3 resources")
+	})
+}
+
+func TestSortImpactedResourcesByWave(t *testing.T) {
+	impactedResources, err := VM{}.ExecuteResourceAction(StrToUnstructured(objJSON), `
+local function configMap(name)
+  return {apiVersion = "v1", kind = "ConfigMap", metadata = {name = name, namespace = obj.metadata.namespace}}
+end
+return {
+  {operation = "patch", resource = obj, wave = 1},
+  {operation = "create", resource = configMap("first")},
+  {operation = "create", resource = configMap("last"), wave = 2},
+  {operation = "create", resource = configMap("second")},
+  {operation = "create", resource = configMap("prerequisite"), wave = -1},
+}`, nil)
+	require.NoError(t, err)
+	sorted := SortImpactedResourcesByWave(impactedResources)
+	names := make([]string, 0, len(sorted))
+	for _, impactedResource := range sorted {
+		names = append(names, impactedResource.UnstructuredObj.GetName())
+	}
+	assert.Equal(t, []string{"prerequisite", "first", "second", "helm-guestbook", "last"}, names)
+	assert.Equal(t, 1, impactedResources[0].Wave, "the impacted resources must not be sorted in place")
+}
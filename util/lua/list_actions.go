@@ -0,0 +1,132 @@
+package lua
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/resource_customizations"
+)
+
+// ListResourceActions returns all the actions defined for the kind, independently of the state of any resource, e.g.
+// to document them. The actions are those defined in ResourceOverrides as well as the built-in and bundled ones,
+// sorted by name and filtered by the ActionPolicy. Their parameters and display metadata are read from the discovery
+// scripts run against an empty resource of the kind, and are left empty if a script fails for such a resource, since
+// most discovery scripts read the fields of the resource. The display name of their definition is used if discovery
+// gives none.
+func (vm VM) ListResourceActions(gvk schema.GroupVersionKind) ([]appv1.ResourceAction, error) {
+	key := GetConfigMapKey(gvk)
+	var names []string
+	override, ok := vm.ResourceOverrides[key]
+	mergeBuiltinActions := true
+	if ok && override.Actions != "" {
+		actions, err := override.GetActions()
+		if err != nil {
+			return nil, err
+		}
+		for _, definition := range actions.Definitions {
+			names = append(names, definition.Name)
+		}
+		mergeBuiltinActions = actions.MergeBuiltinActions
+	}
+	if mergeBuiltinActions {
+		builtin, err := vm.predefinedActionNames(gvk)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, builtin...)
+		if vm.ActionLoader != nil && vm.ActionLoader.Bundled != nil {
+			bundledVM := vm
+			bundledVM.ScriptLoader = vm.ActionLoader.Bundled
+			bundled, err := bundledVM.predefinedActionNames(gvk)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, bundled...)
+		}
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	discovered := make(map[string]appv1.ResourceAction)
+	scripts, err := vm.GetResourceActionDiscovery(obj)
+	if err != nil {
+		return nil, err
+	}
+	// The scripts are run one by one, so that a failing built-in script does not hide the actions of an override
+	for _, script := range scripts {
+		actions, err := vm.executeResourceActionDiscovery(obj, []string{script})
+		if err != nil {
+			log.Debugf("Cannot discover the actions of an empty %s: %v", key, err)
+			continue
+		}
+		for _, action := range actions {
+			if _, ok := discovered[action.Name]; !ok {
+				discovered[action.Name] = action
+				names = append(names, action.Name)
+			}
+		}
+	}
+
+	slices.Sort(names)
+	names = slices.Compact(names)
+	actions := make([]appv1.ResourceAction, 0, len(names))
+	for _, name := range names {
+		action, ok := discovered[name]
+		if !ok {
+			action = appv1.ResourceAction{Name: name}
+		}
+		// Whether the action is disabled depends on the state of the resource
+		action.Disabled = false
+		if action.DisplayName == "" {
+			if definition, err := vm.GetResourceAction(obj, name); err == nil {
+				action.DisplayName = definition.DisplayName
+			}
+		}
+		actions = append(actions, action)
+	}
+	return vm.ActionPolicy.filter(actions), nil
+}
+
+// predefinedActionNames returns the names of the built-in actions of the kind, including those provided for its API
+// version only.
+func (vm VM) predefinedActionNames(gvk schema.GroupVersionKind) ([]string, error) {
+	key := GetConfigMapKey(gvk)
+	dirs := []string{key + "/actions"}
+	if gvk.Version != "" {
+		dirs = append(dirs, fmt.Sprintf("%s/%s/actions", key, gvk.Version))
+	}
+	var names []string
+	for _, dir := range dirs {
+		if vm.ScriptLoader != nil {
+			names = append(names, vm.ScriptLoader.actionNames(dir)...)
+			continue
+		}
+		entries, err := fs.ReadDir(resource_customizations.Embedded, dir)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			for _, scriptFile := range []string{actionScriptFile, actionCUEScriptFile} {
+				if _, err := fs.Stat(resource_customizations.Embedded, path.Join(dir, entry.Name(), scriptFile)); err == nil {
+					names = append(names, entry.Name())
+					break
+				}
+			}
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,81 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestListResourceActions(t *testing.T) {
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	names := func(actions []appv1.ResourceAction) []string {
+		var names []string
+		for _, action := range actions {
+			names = append(names, action.Name)
+		}
+		return names
+	}
+
+	t.Run("Builtin", func(t *testing.T) {
+		actions, err := VM{}.ListResourceActions(deployment)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pause", "restart", "resume"}, names(actions))
+	})
+	t.Run("Overrides", func(t *testing.T) {
+		vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+			"apps/Deployment": {Actions: `
+mergeBuiltinActions: true
+discovery.lua: |
+  local actions = {}
+  actions["set-image"] = {["displayName"] = "Set image", ["params"] = {{["name"] = "image", ["required"] = true}}}
+  return actions
+definitions:
+- name: set-image
+  action.lua: return obj
+`},
+		}}
+		actions, err := vm.ListResourceActions(deployment)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pause", "restart", "resume", "set-image"}, names(actions))
+		assert.Equal(t, appv1.ResourceAction{
+			Name:        "set-image",
+			DisplayName: "Set image",
+			Params:      []appv1.ResourceActionParam{{Name: "image", Required: true}},
+		}, actions[3])
+
+		t.Run("WithoutBuiltinActions", func(t *testing.T) {
+			override := vm.ResourceOverrides["apps/Deployment"]
+			override.Actions = "definitions:\n- name: set-image\n  action.lua: return obj\n"
+			vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{"apps/Deployment": override}}
+			actions, err := vm.ListResourceActions(deployment)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"set-image"}, names(actions))
+		})
+	})
+	t.Run("ActionPolicy", func(t *testing.T) {
+		vm := VM{ActionPolicy: &ActionPolicy{Denied: []string{"pause", "resume"}}}
+		actions, err := vm.ListResourceActions(deployment)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"restart"}, names(actions))
+	})
+	t.Run("ScriptLoader", func(t *testing.T) {
+		dir := t.TempDir()
+		writeScript(t, dir, "apps/Deployment/actions/restart/action.lua", "return obj")
+		writeScript(t, dir, "apps/Deployment/v1/actions/migrate/action.lua", "return obj")
+		writeScript(t, dir, "apps/Deployment/actions/testdata/deployment.yaml", "kind: Deployment")
+		loader, err := NewDirScriptLoader(dir)
+		require.NoError(t, err)
+		actions, err := VM{ScriptLoader: loader}.ListResourceActions(deployment)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"migrate", "restart"}, names(actions))
+	})
+	t.Run("NoActions", func(t *testing.T) {
+		actions, err := VM{}.ListResourceActions(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Unknown"})
+		require.NoError(t, err)
+		assert.Empty(t, actions)
+	})
+}
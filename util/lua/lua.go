@@ -9,13 +9,22 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
 	lua "github.com/yuin/gopher-lua"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/util/jsonpath"
 	luajson "layeh.com/gopher-json"
+	"sigs.k8s.io/yaml"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/resource_customizations"
@@ -27,9 +36,18 @@ const (
 	invalidHealthStatus       = "Lua returned an invalid health status"
 	healthScriptFile          = "health.lua"
 	actionScriptFile          = "action.lua"
+	actionCUEScriptFile       = "action.cue"
+	preconditionScriptFile    = "precondition.lua"
+	postconditionScriptFile   = "postcondition.lua"
+	whenScriptFile            = "when.lua"
 	actionDiscoveryScriptFile = "discovery.lua"
+	actionManifestFile        = "manifest.yaml"
 )
 
+// ConfirmationTokenParameter is the name of the action parameter holding the confirmation token of actions requiring
+// confirmation. The token is the name of the action being confirmed.
+const ConfirmationTokenParameter = "confirmationToken"
+
 // ScriptDoesNotExistError is an error type for when a built-in script does not exist.
 type ScriptDoesNotExistError struct {
 	// ScriptName is the name of the script that does not exist.
@@ -40,6 +58,27 @@ func (e ScriptDoesNotExistError) Error() string {
 	return fmt.Sprintf("built-in script %q does not exist", e.ScriptName)
 }
 
+// ResourceActionParameters is a named parameter passed to a custom action. Parameters are exposed to the action script
+// through the actionParams global table.
+type ResourceActionParameters struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+func (p *ResourceActionParameters) GetName() string {
+	if p != nil && p.Name != nil {
+		return *p.Name
+	}
+	return ""
+}
+
+func (p *ResourceActionParameters) GetValue() string {
+	if p != nil && p.Value != nil {
+		return *p.Value
+	}
+	return ""
+}
+
 type ResourceHealthOverrides map[string]appv1.ResourceOverride
 
 func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
@@ -67,13 +106,111 @@ type VM struct {
 	ResourceOverrides map[string]appv1.ResourceOverride
 	// UseOpenLibs flag to enable open libraries. Libraries are disabled by default while running, but enabled during testing to allow the use of print statements
 	UseOpenLibs bool
+	// ScriptLoader optionally provides the built-in scripts instead of the embedded resource customizations
+	ScriptLoader *ScriptLoader
+	// ResourceInfoProvider optionally tells whether the kinds of the resources created by actions are namespaced. Only
+	// well-known kinds are considered cluster-scoped if it is not set.
+	ResourceInfoProvider kube.ResourceInfoProvider
+	// DiscoveryCache optionally caches the actions discovered for objects of the same shape
+	DiscoveryCache *DiscoveryCache
+	// ScriptCache optionally caches the compiled scripts. It can be shared by VMs configured differently.
+	ScriptCache *ScriptCache
+	// MaxScriptBytes is the maximum size of the action and discovery scripts. The size is not limited if it is 0.
+	MaxScriptBytes int
+	// Timeout is the maximum duration of a script. defaultScriptTimeout is used if it is 0.
+	Timeout time.Duration
+	// MaxActionTimeout caps the timeouts declared by actions in discovery. The timeouts are not capped if it is 0.
+	MaxActionTimeout time.Duration
+	// ActionPolicy optionally restricts the actions which can be run. The actions it does not permit are not discovered
+	// either. The API server does not set it.
+	ActionPolicy *ActionPolicy
+	// SkipConfirmation disables requiring the confirmation token to run the actions requiring confirmation, e.g. when
+	// the clients ask for the confirmation themselves and the requests running the actions cannot carry the token.
+	SkipConfirmation bool
+	// Authorizer optionally authorizes running the actions, the steps of the composite actions and the scripts run by
+	// ExecuteResourceAction. The actions can be run by anyone if it is not set.
+	Authorizer Authorizer
+	// ActionConfig optionally holds values passed to the scripts as the actionConfig global, such as organization
+	// specific constants. The global is an empty table if it is not set.
+	ActionConfig map[string]string
+	// ActionLoader optionally layers bundled and inline action definitions over the built-in actions. The actions
+	// defined in ResourceOverrides still take precedence over all of them.
+	ActionLoader *ActionLoader
+	// Now optionally provides the current time to the time library of the scripts, e.g. a fixed time in tests.
+	// time.Now is used if it is not set.
+	Now func() time.Time
+	// DeterministicNames enables replacing the names of the resources created by the actions which are derived from the
+	// name of the source, such as <source>-<date>, with <source>-<hash>, where the hash is computed from the action, its
+	// parameters and the time given by Now. The names of the created resources are thus stable with a fixed Now, e.g. to
+	// compare them with expected outputs. The references to the replaced names in the impacted resources, such as
+	// the *Ref.name fields, config map volumes and owner references, are replaced too, but not the labels, annotations
+	// or other fields holding them.
+	DeterministicNames bool
+	// PreviousObject optionally is a previous state of the resource, e.g. its state after the last sync, which is passed
+	// to the scripts as the previousObject global so that they can act on what changed since. The global is nil if it
+	// is not set.
+	PreviousObject *unstructured.Unstructured
+	// RelatedResources optionally are resources related to the resource, e.g. the pods of a workload, which are passed
+	// to the scripts as the relatedResources global. The global is an empty table if it is not set.
+	RelatedResources []*unstructured.Unstructured
+	// Libraries optionally restricts the optional libraries opened for the scripts, among optionalLibraries, e.g. to
+	// harden a deployment. All of them are opened if it is nil.
+	Libraries []string
+	// KubeVersion optionally is the version of the cluster of the resources, which is passed to the scripts as the
+	// kubeVersion global. The global is nil if it is not set.
+	KubeVersion *version.Info
+	// SchemaProvider optionally provides the schemas of the kinds, against which the resources produced by the actions
+	// are validated. The resources are not validated if it is not set.
+	SchemaProvider ResourceSchemaProvider
+	// TrackStats enables measuring the statistics of the actions, returned in the Stats of their results. It slows the
+	// actions down, since measuring the memory allocations stops the world.
+	TrackStats bool
+	// ImpactAnalyzers optionally review the resources impacted by the actions, e.g. RBACAnalyzer. Their warnings are
+	// added to the results of the actions.
+	ImpactAnalyzers []ImpactAnalyzer
+
+	// prepared optionally is the state which the scripts of a PreparedVM run in
+	prepared *preparedState
+	// hostGlobals are the functions of the host registered with RegisterGlobal
+	hostGlobals map[string]lua.LGFunction
+	// previousResources are the resources impacted by the previous steps of a composite action
+	previousResources []ImpactedResource
+	// ctx optionally bounds the execution of the scripts instead of the timeout of every script
+	ctx context.Context
+	// parentCtx optionally is the context which the timeout of every script is derived from, if ctx is not set
+	parentCtx context.Context
 }
 
-func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState, error) {
+// defaultScriptTimeout is the maximum duration of a script if the VM does not configure a timeout
+const defaultScriptTimeout = 1 * time.Second
+
+func (vm VM) scriptTimeout() time.Duration {
+	if vm.Timeout > 0 {
+		return vm.Timeout
+	}
+	return defaultScriptTimeout
+}
+
+func (vm VM) now() time.Time {
+	if vm.Now != nil {
+		return vm.Now()
+	}
+	return time.Now()
+}
+
+// optionalLibraries are the libraries opened for the scripts in addition to the base, package and table libraries
+var optionalLibraries = []string{lua.OsLibName, ReLibName, URLLibName, YAMLLibName, MetaLibName, TimeLibName, NumLibName}
+
+// libraryEnabled returns whether the given optional library is opened for the scripts.
+func (vm VM) libraryEnabled(name string) bool {
+	return slices.Contains(optionalLibraries, name) && (vm.Libraries == nil || slices.Contains(vm.Libraries, name))
+}
+
+// newLuaState returns a new state with the libraries and the registered globals of the VM.
+func (vm VM) newLuaState() *lua.LState {
 	l := lua.NewState(lua.Options{
 		SkipOpenLibs: !vm.UseOpenLibs,
 	})
-	defer l.Close()
 	// Opens table library to allow access to functions to manipulate tables
 	for _, pair := range []struct {
 		n string
@@ -82,8 +219,6 @@ func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState,
 		{lua.LoadLibName, lua.OpenPackage},
 		{lua.BaseLibName, lua.OpenBase},
 		{lua.TabLibName, lua.OpenTable},
-		// load our 'safe' version of the OS library
-		{lua.OsLibName, OpenSafeOs},
 	} {
 		if err := l.CallByParam(lua.P{
 			Fn:      l.NewFunction(pair.f),
@@ -93,21 +228,177 @@ func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState,
 			panic(err)
 		}
 	}
-	// preload our 'safe' version of the OS library. Allows the 'local os = require("os")' to work
-	l.PreloadModule(lua.OsLibName, SafeOsLoader)
+	for _, lib := range []struct {
+		n      string
+		f      lua.LGFunction
+		loader lua.LGFunction
+	}{
+		// load our 'safe' version of the OS library
+		{lua.OsLibName, OpenSafeOs, SafeOsLoader},
+		{ReLibName, OpenRe, ReLoader},
+		{URLLibName, OpenURL, URLLoader},
+		{YAMLLibName, OpenYAML, YAMLLoader},
+		{MetaLibName, OpenMeta, MetaLoader},
+		{TimeLibName, OpenTime(vm.now), TimeLoader(vm.now)},
+		{NumLibName, OpenNum, NumLoader},
+	} {
+		if !vm.libraryEnabled(lib.n) {
+			continue
+		}
+		if err := l.CallByParam(lua.P{
+			Fn:      l.NewFunction(lib.f),
+			NRet:    0,
+			Protect: true,
+		}, lua.LString(lib.n)); err != nil {
+			panic(err)
+		}
+		// preload the library too. Allows e.g. the 'local os = require("os")' to work
+		l.PreloadModule(lib.n, lib.loader)
+	}
+	vm.setHostGlobals(l)
+	return l
+}
+
+// scriptContext returns the context bounding the execution of a script: the context of the VM if it is set, or one
+// expiring after the timeout of the script otherwise.
+func (vm VM) scriptContext() (context.Context, context.CancelFunc) {
+	if vm.ctx != nil {
+		return vm.ctx, func() {}
+	}
+	parent := vm.parentCtx
+	if parent == nil {
+		parent = context.Background()
+	}
+	return context.WithTimeout(parent, vm.scriptTimeout())
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+func (vm VM) runLua(obj *unstructured.Unstructured, script string, resourceActionParameters []*ResourceActionParameters) (*lua.LState, error) {
+	var l *lua.LState
+	if vm.prepared != nil {
+		l = vm.prepared.reset()
+	} else {
+		l = vm.newLuaState()
+		defer l.Close()
+	}
+
+	ctx, cancel := vm.scriptContext()
 	defer cancel()
 	l.SetContext(ctx)
 	objectValue := decodeValue(l, obj.Object)
 	l.SetGlobal("obj", objectValue)
-	err := l.DoString(script)
+	// The parameters table is always set, so that scripts can index it whether parameters are passed or not
+	paramsTable := l.NewTable()
+	for _, param := range resourceActionParameters {
+		paramsTable.RawSetString(param.GetName(), lua.LString(param.GetValue()))
+	}
+	l.SetGlobal("actionParams", paramsTable)
+	configTable := l.NewTable()
+	for key, value := range vm.ActionConfig {
+		configTable.RawSetString(key, lua.LString(value))
+	}
+	l.SetGlobal("actionConfig", configTable)
+	l.SetGlobal(NullGlobal, newNullValue(l))
+	l.SetGlobal(ToggleFuncName, l.NewFunction(toggleField))
+	if vm.KubeVersion != nil {
+		l.SetGlobal("kubeVersion", kubeVersionTable(l, vm.KubeVersion))
+	}
+	if vm.PreviousObject != nil {
+		l.SetGlobal("previousObject", decodeValue(l, vm.PreviousObject.Object))
+	}
+	relatedResources := make([]any, 0, len(vm.RelatedResources))
+	for _, relatedResource := range vm.RelatedResources {
+		relatedResources = append(relatedResources, relatedResource.Object)
+	}
+	l.SetGlobal("relatedResources", decodeValue(l, relatedResources))
+	if vm.previousResources != nil {
+		previousResources := make([]any, 0, len(vm.previousResources))
+		for _, impactedResource := range vm.previousResources {
+			previousResources = append(previousResources, map[string]any{
+				"operation": impactedResource.K8SOperation.String(),
+				"resource":  impactedResource.UnstructuredObj.Object,
+			})
+		}
+		l.SetGlobal("previousResources", decodeValue(l, previousResources))
+	}
+	if vm.ScriptCache == nil {
+		err := l.DoString(script)
+		return l, err
+	}
+	proto, err := vm.ScriptCache.compile(script)
+	if err != nil {
+		return l, err
+	}
+	l.Push(l.NewFunctionFromProto(proto))
+	err = l.PCall(0, lua.MultRet, nil)
 	return l, err
 }
 
+// mergeMetadataFields are the fields of the compact output of patch actions, mapped to the metadata fields they merge
+var mergeMetadataFields = map[string]string{
+	"mergeAnnotations": "annotations",
+	"mergeLabels":      "labels",
+}
+
+// expandMergeMetadata returns the resource with the annotations and labels of the action output merged, and whether
+// the output is in the compact form, i.e. only holds mergeAnnotations and mergeLabels tables. Existing annotations and
+// labels are preserved, and those with the same keys are overwritten.
+func expandMergeMetadata(obj *unstructured.Unstructured, jsonBytes []byte) (*unstructured.Unstructured, bool, error) {
+	var output map[string]any
+	if err := json.Unmarshal(jsonBytes, &output); err != nil || len(output) == 0 {
+		return nil, false, nil
+	}
+	for key := range output {
+		if _, ok := mergeMetadataFields[key]; !ok {
+			return nil, false, nil
+		}
+	}
+	merged := obj.DeepCopy()
+	for key, field := range mergeMetadataFields {
+		value, ok := output[key]
+		if !ok {
+			continue
+		}
+		values, ok := value.(map[string]any)
+		if !ok {
+			return nil, false, fmt.Errorf("%s must be a table of strings", key)
+		}
+		existing, _, err := unstructured.NestedStringMap(merged.Object, "metadata", field)
+		if err != nil {
+			return nil, false, fmt.Errorf("error reading %s: %w", field, err)
+		}
+		if existing == nil {
+			existing = make(map[string]string, len(values))
+		}
+		for name, v := range values {
+			str, ok := v.(string)
+			if !ok {
+				return nil, false, fmt.Errorf("%s must be a table of strings, got %T for %q", key, v, name)
+			}
+			existing[name] = str
+		}
+		if err := unstructured.SetNestedStringMap(merged.Object, existing, "metadata", field); err != nil {
+			return nil, false, fmt.Errorf("error setting %s: %w", field, err)
+		}
+	}
+	return merged, true, nil
+}
+
+// kubeVersionTable returns the version as a table whose major and minor fields are numbers, so that scripts can compare
+// them. Providers suffix the minor version, e.g. "28+", and the suffix is dropped.
+func kubeVersionTable(l *lua.LState, info *version.Info) *lua.LTable {
+	table := l.NewTable()
+	for key, value := range map[string]string{"major": info.Major, "minor": info.Minor} {
+		if number, err := strconv.Atoi(strings.TrimRightFunc(value, func(r rune) bool { return !unicode.IsDigit(r) })); err == nil {
+			table.RawSetString(key, lua.LNumber(number))
+		}
+	}
+	table.RawSetString("gitVersion", lua.LString(info.GitVersion))
+	return table
+}
+
 // ExecuteHealthLua runs the lua script to generate the health status of a resource
 func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*health.HealthStatus, error) {
-	l, err := vm.runLua(obj, script)
+	l, err := vm.runLua(obj, script, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -173,13 +464,74 @@ func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (script string, use
 	return builtInScript, true, err
 }
 
-func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) ([]ImpactedResource, error) {
-	l, err := vm.runLua(obj, script)
+// ActionResult is the outcome of running a resource action.
+type ActionResult struct {
+	// ImpactedResources are the resources to patch or create
+	ImpactedResources []ImpactedResource `json:"impactedResources"`
+	// Warnings are non-fatal advisories returned by the action as its second return value
+	Warnings []string `json:"warnings,omitempty"`
+	// Status describes the outcome of the action so that clients can style it
+	Status ActionResultStatus `json:"status"`
+	// Message optionally is a human readable summary of the outcome of the action
+	Message string `json:"message,omitempty"`
+	// Stats are the statistics of the execution of the action, which are zero unless the VM tracks them
+	Stats ActionStats `json:"stats"`
+}
+
+// ActionResultStatus is the outcome of an action, returned by the action in the status field of its second return value.
+type ActionResultStatus string
+
+const (
+	// ActionResultStatusOK is the default status, the action was executed successfully
+	ActionResultStatusOK ActionResultStatus = "ok"
+	// ActionResultStatusNoop means that the action did not need to change anything
+	ActionResultStatusNoop ActionResultStatus = "noop"
+	// ActionResultStatusWarning means that the action was executed but needs the attention of the user
+	ActionResultStatusWarning ActionResultStatus = "warning"
+)
+
+// ExecuteResourceAction runs the action script against the resource, passing the given parameters to the script as
+// the actionParams table.
+func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string, resourceActionParameters []*ResourceActionParameters) ([]ImpactedResource, error) {
+	result, err := vm.ExecuteResourceActionWithResult(obj, script, resourceActionParameters)
+	if err != nil {
+		return nil, err
+	}
+	return result.ImpactedResources, nil
+}
+
+// ExecuteResourceActionWithResult runs the action script like ExecuteResourceAction and additionally returns the
+// status and the warnings of the action. An action returns them as an optional second value, either a table with
+// status and warnings fields, or the warnings only as a string or a list of strings. As the script is not a named
+// action, the Authorizer of the VM, if any, must authorize running every action of the resource, i.e. the action
+// named "*".
+func (vm VM) ExecuteResourceActionWithResult(obj *unstructured.Unstructured, script string, resourceActionParameters []*ResourceActionParameters) (*ActionResult, error) {
+	if err := vm.authorizeAction(obj, "*"); err != nil {
+		return nil, err
+	}
+	return vm.executeResourceActionScript(obj, script, resourceActionParameters)
+}
+
+// executeResourceActionScript runs the Lua action script like ExecuteResourceActionWithResult, without authorizing it.
+func (vm VM) executeResourceActionScript(obj *unstructured.Unstructured, script string, resourceActionParameters []*ResourceActionParameters) (*ActionResult, error) {
+	// The returned resources may share the values of the source resource, e.g. when cleaning them, so the source
+	// resource is copied to guarantee that neither the action nor the callers modifying the results change it
+	obj = obj.DeepCopy()
+	l, err := vm.runLua(obj, script, resourceActionParameters)
 	if err != nil {
 		return nil, err
 	}
 	returnValue := l.Get(-1)
+	result := &ActionResult{Status: ActionResultStatusOK}
+	if l.GetTop() > 1 {
+		result, err = getActionResultDetails(returnValue)
+		if err != nil {
+			return nil, err
+		}
+		returnValue = l.Get(-2)
+	}
 	if returnValue.Type() == lua.LTTable {
+		replaceNulls(returnValue, make(map[*lua.LTable]bool))
 		jsonBytes, err := luajson.Encode(returnValue)
 		if err != nil {
 			return nil, err
@@ -202,27 +554,459 @@ func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string
 				return nil, err
 			}
 		} else {
-			// The string represents an old-style action object output
-			newObj, err := appv1.UnmarshalToUnstructured(string(jsonBytes))
+			// The string represents an old-style action object output, or its compact form merging metadata
+			newObj, merged, err := expandMergeMetadata(obj, jsonBytes)
 			if err != nil {
 				return nil, err
 			}
+			if !merged {
+				newObj, err = appv1.UnmarshalToUnstructured(string(jsonBytes))
+				if err != nil {
+					return nil, err
+				}
+			}
 			// Wrap the old-style action output with a single-member array.
 			// The default definition of the old-style action is a "patch" one.
-			impactedResources = append(impactedResources, ImpactedResource{newObj, PatchOperation})
+			impactedResources = append(impactedResources, ImpactedResource{UnstructuredObj: newObj, K8SOperation: PatchOperation})
 		}
 
 		for _, impactedResource := range impactedResources {
-			// Cleaning the resource is only relevant to "patch"
-			if impactedResource.K8SOperation == PatchOperation {
+			switch impactedResource.K8SOperation {
+			case PatchOperation:
+				// Cleaning the resource is only relevant to "patch"
 				impactedResource.UnstructuredObj.Object = cleanReturnedObj(impactedResource.UnstructuredObj.Object, obj.Object)
+			case CreateOperation:
+				clearServerSetMetadata(impactedResource.UnstructuredObj)
 			}
 		}
-		return impactedResources, nil
+		if err := convertIntegerFields(impactedResources); err != nil {
+			return nil, err
+		}
+		if err := applyPatchTypes(obj, impactedResources); err != nil {
+			return nil, err
+		}
+		if err := validateSubresources(impactedResources); err != nil {
+			return nil, err
+		}
+		if err := validateCreatedResourcesAreNotSource(obj, impactedResources); err != nil {
+			return nil, err
+		}
+		if err := validateCreatedResourceNamespaces(impactedResources, vm.ResourceInfoProvider); err != nil {
+			return nil, err
+		}
+		result.ImpactedResources = impactedResources
+		return result, nil
 	}
 	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
 }
 
+// ExecuteResourceActionDefinition runs the action defined by the given definition against the resource. The
+// precondition of the action, if any, is evaluated against the resource first and the action is only executed if it
+// holds. The postcondition of the action, if any, is then evaluated against every impacted resource. Actions requiring
+// confirmation are only executed if the confirmation token is passed as the ConfirmationTokenParameter parameter.
+// Composite actions run their steps instead of their own script. The impact analyzers of the VM then review the
+// impacted resources.
+func (vm VM) ExecuteResourceActionDefinition(obj *unstructured.Unstructured, action appv1.ResourceActionDefinition, resourceActionParameters []*ResourceActionParameters) (*ActionResult, error) {
+	tracker := vm.trackStats()
+	if err := vm.ActionPolicy.check(action.Name); err != nil {
+		return nil, err
+	}
+	if err := vm.authorizeAction(obj, action.Name); err != nil {
+		return nil, err
+	}
+	var result *ActionResult
+	var err error
+	if len(action.Steps) > 0 {
+		result, err = vm.executeCompositeResourceAction(obj, action, resourceActionParameters)
+	} else {
+		if err := vm.checkConfirmation(action.Name, action.RequiresConfirmation, resourceActionParameters); err != nil {
+			return nil, err
+		}
+		result, err = vm.executeResourceActionDefinition(obj, action, resourceActionParameters)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := vm.analyzeImpact(obj, action.Name, result); err != nil {
+		return nil, err
+	}
+	result.Stats = tracker.stats()
+	return result, nil
+}
+
+// ExecuteResourceActionContext runs the action like ExecuteResourceActionDefinition, within the deadline of the given
+// context. The scripts of the action also run for at most the timeout the action declares in discovery, capped by
+// MaxActionTimeout, or for the default timeout if the action does not declare one. The parameters hidden by the
+// visibility conditions declared in discovery are not passed to the action, and the required ones must be passed.
+func (vm VM) ExecuteResourceActionContext(ctx context.Context, obj *unstructured.Unstructured, action appv1.ResourceActionDefinition, resourceActionParameters []*ResourceActionParameters) (*ActionResult, error) {
+	discovered, err := vm.DiscoverResourceAction(ctx, obj, action.Name)
+	if err != nil {
+		return nil, err
+	}
+	return vm.ExecuteDiscoveredResourceAction(ctx, obj, action, discovered, resourceActionParameters)
+}
+
+// ExecuteDiscoveredResourceAction runs the action like ExecuteResourceActionContext, with the action already
+// discovered for the resource by DiscoverResourceAction, so that callers also needing the discovered action, e.g. to
+// tell whether it is deprecated, only run the discovery once. The discovered action may be nil if it is not discovered.
+func (vm VM) ExecuteDiscoveredResourceAction(ctx context.Context, obj *unstructured.Unstructured, action appv1.ResourceActionDefinition, discovered *appv1.ResourceAction, resourceActionParameters []*ResourceActionParameters) (*ActionResult, error) {
+	resourceActionParameters, err := visibleResourceActionParameters(discovered, resourceActionParameters)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, vm.actionTimeout(discovered))
+	defer cancel()
+	vm.ctx = ctx
+	return vm.ExecuteResourceActionDefinition(obj, action, resourceActionParameters)
+}
+
+// DiscoverResourceAction returns the action with the given name discovered for the resource, or nil if the resource
+// has no discovery scripts or the action is not discovered.
+func (vm VM) DiscoverResourceAction(ctx context.Context, obj *unstructured.Unstructured, actionName string) (*appv1.ResourceAction, error) {
+	discoveryScripts, err := vm.GetResourceActionDiscovery(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting action discovery of action %q: %w", actionName, err)
+	}
+	if len(discoveryScripts) == 0 {
+		return nil, nil
+	}
+	actions, err := vm.ExecuteResourceActionDiscoveryContext(ctx, obj, discoveryScripts)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering action %q: %w", actionName, err)
+	}
+	for i := range actions {
+		if actions[i].Name == actionName {
+			return &actions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// actionTimeout returns the timeout the discovered action declares, capped by MaxActionTimeout, or the default timeout
+// of the scripts if it does not declare one.
+func (vm VM) actionTimeout(discovered *appv1.ResourceAction) time.Duration {
+	if discovered == nil || discovered.TimeoutSeconds <= 0 {
+		return vm.scriptTimeout()
+	}
+	timeout := time.Duration(discovered.TimeoutSeconds) * time.Second
+	if vm.MaxActionTimeout > 0 && timeout > vm.MaxActionTimeout {
+		timeout = vm.MaxActionTimeout
+	}
+	return timeout
+}
+
+// visibleResourceActionParameters returns the parameters without those the discovered action hides, and fails if a
+// required parameter is visible but neither passed nor defaulted, or if a visible parameter is passed a value which is
+// not one of its allowed values. Suggested values are not enforced. A parameter is visible if it has no visibility
+// condition, or if the parameter of the condition, or its default value, equals the value of the condition. The
+// parameters which the action does not declare are kept.
+func visibleResourceActionParameters(discovered *appv1.ResourceAction, resourceActionParameters []*ResourceActionParameters) ([]*ResourceActionParameters, error) {
+	if discovered == nil || len(discovered.Params) == 0 {
+		return resourceActionParameters, nil
+	}
+	values := make(map[string]string, len(resourceActionParameters))
+	for _, param := range resourceActionParameters {
+		values[param.GetName()] = param.GetValue()
+	}
+	defaults := make(map[string]string, len(discovered.Params))
+	for _, param := range discovered.Params {
+		defaults[param.Name] = param.Default
+	}
+	hidden := make(map[string]bool)
+	for _, param := range discovered.Params {
+		if param.VisibleWhen != nil {
+			value, ok := values[param.VisibleWhen.Param]
+			if !ok {
+				value = defaults[param.VisibleWhen.Param]
+			}
+			if value != param.VisibleWhen.Equals {
+				hidden[param.Name] = true
+				continue
+			}
+		}
+		value, ok := values[param.Name]
+		if param.Required && !ok && param.Default == "" {
+			return nil, fmt.Errorf("parameter %q of action %q is required", param.Name, discovered.Name)
+		}
+		if ok && len(param.AllowedValues) > 0 && !slices.Contains(param.AllowedValues, value) {
+			return nil, fmt.Errorf("invalid value %q of parameter %q of action %q, allowed values are: %s", value, param.Name, discovered.Name, strings.Join(param.AllowedValues, ", "))
+		}
+	}
+	if len(hidden) == 0 {
+		return resourceActionParameters, nil
+	}
+	visible := make([]*ResourceActionParameters, 0, len(resourceActionParameters))
+	for _, param := range resourceActionParameters {
+		if !hidden[param.GetName()] {
+			visible = append(visible, param)
+		}
+	}
+	return visible, nil
+}
+
+// executeCompositeResourceAction runs the steps of a composite action in order. Every step runs against the resource
+// as patched by the previous steps, and the resources impacted by the previous steps are passed to its script. The
+// composite action requires confirmation if any of its steps does, and fails as soon as one of its steps fails.
+func (vm VM) executeCompositeResourceAction(obj *unstructured.Unstructured, action appv1.ResourceActionDefinition, resourceActionParameters []*ResourceActionParameters) (*ActionResult, error) {
+	steps := make([]appv1.ResourceActionDefinition, 0, len(action.Steps))
+	requiresConfirmation := action.RequiresConfirmation
+	for _, stepName := range action.Steps {
+		step, err := vm.GetResourceAction(obj, stepName)
+		if err != nil {
+			return nil, fmt.Errorf("error getting step %q of action %q: %w", stepName, action.Name, err)
+		}
+		if len(step.Steps) > 0 {
+			return nil, fmt.Errorf("step %q of action %q is a composite action, which is not supported", stepName, action.Name)
+		}
+		if err := vm.ActionPolicy.check(stepName); err != nil {
+			return nil, fmt.Errorf("error running step %q of action %q: %w", stepName, action.Name, err)
+		}
+		if err := vm.authorizeAction(obj, stepName); err != nil {
+			return nil, fmt.Errorf("error running step %q of action %q: %w", stepName, action.Name, err)
+		}
+		requiresConfirmation = requiresConfirmation || step.RequiresConfirmation
+		steps = append(steps, step)
+	}
+	if err := vm.checkConfirmation(action.Name, requiresConfirmation, resourceActionParameters); err != nil {
+		return nil, err
+	}
+	if action.Precondition != "" {
+		if err := vm.checkPrecondition(obj, action.Precondition, resourceActionParameters); err != nil {
+			return nil, err
+		}
+	}
+
+	current := obj.DeepCopy()
+	result := &ActionResult{Status: ActionResultStatusNoop}
+	var messages []string
+	for _, step := range steps {
+		stepVM := vm
+		stepVM.previousResources = result.ImpactedResources
+		if stepVM.previousResources == nil {
+			stepVM.previousResources = []ImpactedResource{}
+		}
+		stepResult, err := stepVM.executeResourceActionDefinition(current, step, resourceActionParameters)
+		if err != nil {
+			return nil, fmt.Errorf("error running step %q of action %q: %w", step.Name, action.Name, err)
+		}
+		for _, impactedResource := range stepResult.ImpactedResources {
+			if impactedResource.K8SOperation == PatchOperation && isSameResource(impactedResource.UnstructuredObj, obj) {
+				// The source resource is patched once, with the changes of all the steps
+				current = impactedResource.UnstructuredObj
+				result.ImpactedResources = removeImpactedResource(result.ImpactedResources, obj)
+			}
+			result.ImpactedResources = append(result.ImpactedResources, impactedResource)
+		}
+		result.Warnings = append(result.Warnings, stepResult.Warnings...)
+		if stepResult.Message != "" {
+			messages = append(messages, stepResult.Message)
+		}
+		switch {
+		case stepResult.Status == ActionResultStatusWarning:
+			result.Status = ActionResultStatusWarning
+		case stepResult.Status == ActionResultStatusOK && result.Status == ActionResultStatusNoop:
+			result.Status = ActionResultStatusOK
+		}
+	}
+	result.Message = strings.Join(messages, "\n")
+	if err := vm.checkPostcondition(result, action.Postcondition, resourceActionParameters); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func isSameResource(a, b *unstructured.Unstructured) bool {
+	return a.GroupVersionKind().GroupKind() == b.GroupVersionKind().GroupKind() && a.GetNamespace() == b.GetNamespace() && a.GetName() == b.GetName()
+}
+
+func removeImpactedResource(impactedResources []ImpactedResource, obj *unstructured.Unstructured) []ImpactedResource {
+	var remaining []ImpactedResource
+	for _, impactedResource := range impactedResources {
+		if impactedResource.K8SOperation != PatchOperation || !isSameResource(impactedResource.UnstructuredObj, obj) {
+			remaining = append(remaining, impactedResource)
+		}
+	}
+	return remaining
+}
+
+// executeResourceActionDefinition runs the action script between its precondition and postcondition checks.
+func (vm VM) executeResourceActionDefinition(obj *unstructured.Unstructured, action appv1.ResourceActionDefinition, resourceActionParameters []*ResourceActionParameters) (*ActionResult, error) {
+	if action.Precondition != "" {
+		if err := vm.checkPrecondition(obj, action.Precondition, resourceActionParameters); err != nil {
+			return nil, err
+		}
+	}
+	result, err := vm.executeActionScript(obj, action, resourceActionParameters)
+	if err != nil {
+		return nil, err
+	}
+	if err := vm.checkPostcondition(result, action.Postcondition, resourceActionParameters); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// executeActionScript runs the script of the action with the backend of its language, which is Lua unless the action
+// is implemented in CUE, and validates the resources it produces against the schemas of their kinds.
+func (vm VM) executeActionScript(obj *unstructured.Unstructured, action appv1.ResourceActionDefinition, resourceActionParameters []*ResourceActionParameters) (*ActionResult, error) {
+	// The action is authorized by name before its script runs
+	var backend ActionBackend = luaBackend{vm: vm}
+	script := action.ActionLua
+	if action.ActionCUE != "" {
+		backend = cueBackend{vm: vm}
+		script = action.ActionCUE
+	}
+	result, err := backend.ExecuteResourceActionWithResult(obj, script, resourceActionParameters)
+	if err != nil {
+		return nil, err
+	}
+	vm.deriveCreatedResourceNames(obj, action.Name, resourceActionParameters, result.ImpactedResources)
+	if err := vm.validateOutputSchemas(result.ImpactedResources); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (vm VM) checkPrecondition(obj *unstructured.Unstructured, precondition string, resourceActionParameters []*ResourceActionParameters) error {
+	ok, message, err := vm.evaluateActionCondition(obj, precondition, resourceActionParameters)
+	if err != nil {
+		return fmt.Errorf("error evaluating precondition: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("precondition failed: %s", message)
+	}
+	return nil
+}
+
+func (vm VM) checkPostcondition(result *ActionResult, postcondition string, resourceActionParameters []*ResourceActionParameters) error {
+	if postcondition == "" {
+		return nil
+	}
+	for _, impactedResource := range result.ImpactedResources {
+		resource := impactedResource.UnstructuredObj
+		ok, message, err := vm.evaluateActionCondition(resource, postcondition, resourceActionParameters)
+		if err != nil {
+			return fmt.Errorf("error evaluating postcondition: %w", err)
+		}
+		if !ok {
+			return fmt.Errorf("postcondition failed for %s %s/%s: %s", resource.GetKind(), resource.GetNamespace(), resource.GetName(), message)
+		}
+	}
+	return nil
+}
+
+// checkConfirmation fails if the action requires confirmation and the confirmation token is not passed, unless the VM
+// skips the confirmation.
+func (vm VM) checkConfirmation(actionName string, requiresConfirmation bool, resourceActionParameters []*ResourceActionParameters) error {
+	if requiresConfirmation && !vm.SkipConfirmation && !isActionConfirmed(actionName, resourceActionParameters) {
+		return fmt.Errorf("action %q requires confirmation", actionName)
+	}
+	return nil
+}
+
+func isActionConfirmed(actionName string, resourceActionParameters []*ResourceActionParameters) bool {
+	for _, param := range resourceActionParameters {
+		if param.GetName() == ConfirmationTokenParameter {
+			return param.GetValue() == actionName
+		}
+	}
+	return false
+}
+
+// evaluateActionCondition runs a precondition or postcondition script, which returns a boolean and an optional
+// message explaining why the condition does not hold.
+func (vm VM) evaluateActionCondition(obj *unstructured.Unstructured, script string, resourceActionParameters []*ResourceActionParameters) (bool, string, error) {
+	l, err := vm.runLua(obj.DeepCopy(), script, resourceActionParameters)
+	if err != nil {
+		return false, "", err
+	}
+	returnValue := l.Get(-1)
+	var message string
+	if l.GetTop() > 1 {
+		if returnValue.Type() == lua.LTString {
+			message = returnValue.String()
+		}
+		returnValue = l.Get(-2)
+	}
+	ok, isBool := returnValue.(lua.LBool)
+	if !isBool {
+		return false, "", fmt.Errorf(incorrectReturnType, "boolean", returnValue.Type().String())
+	}
+	if !ok && message == "" {
+		message = "the condition of the action does not hold"
+	}
+	return bool(ok), message, nil
+}
+
+// getActionResultDetails converts the second return value of an action to the status, the warnings and the message of
+// the action. The value is either a table with optional status, warnings and message fields, or the warnings only.
+func getActionResultDetails(value lua.LValue) (*ActionResult, error) {
+	table, ok := value.(*lua.LTable)
+	if !ok || (table.RawGetString("status") == lua.LNil && table.RawGetString("warnings") == lua.LNil && table.RawGetString("message") == lua.LNil) {
+		warnings, err := getActionWarnings(value)
+		if err != nil {
+			return nil, err
+		}
+		return &ActionResult{Status: ActionResultStatusOK, Warnings: warnings}, nil
+	}
+	result := &ActionResult{Status: ActionResultStatusOK}
+	switch statusValue := table.RawGetString("status"); statusValue.Type() {
+	case lua.LTNil:
+	case lua.LTString:
+		result.Status = ActionResultStatus(statusValue.String())
+		if result.Status != ActionResultStatusOK && result.Status != ActionResultStatusNoop && result.Status != ActionResultStatusWarning {
+			return nil, fmt.Errorf("invalid action result status %q", result.Status)
+		}
+	default:
+		return nil, fmt.Errorf(incorrectReturnType, "string status", statusValue.Type().String())
+	}
+	switch messageValue := table.RawGetString("message"); messageValue.Type() {
+	case lua.LTNil:
+	case lua.LTString:
+		result.Message = messageValue.String()
+	default:
+		return nil, fmt.Errorf(incorrectReturnType, "string message", messageValue.Type().String())
+	}
+	warnings, err := getActionWarnings(table.RawGetString("warnings"))
+	if err != nil {
+		return nil, err
+	}
+	result.Warnings = warnings
+	return result, nil
+}
+
+// getActionWarnings converts the warnings returned by an action to a list of warnings. Empty warnings are omitted.
+func getActionWarnings(value lua.LValue) ([]string, error) {
+	var warnings []string
+	switch value.Type() {
+	case lua.LTNil:
+	case lua.LTString:
+		if value.String() != "" {
+			warnings = append(warnings, value.String())
+		}
+	case lua.LTTable:
+		var err error
+		value.(*lua.LTable).ForEach(func(_, item lua.LValue) {
+			if err != nil {
+				return
+			}
+			if item.Type() != lua.LTString {
+				err = fmt.Errorf("expect string warnings from Lua script, not %s", item.Type().String())
+				return
+			}
+			if item.String() != "" {
+				warnings = append(warnings, item.String())
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf(incorrectReturnType, "string or table warnings", value.Type().String())
+	}
+	return warnings, nil
+}
+
 // UnmarshalToImpactedResources unmarshals an ImpactedResource array representation in JSON to ImpactedResource array
 func UnmarshalToImpactedResources(resources string) ([]ImpactedResource, error) {
 	if resources == "" || resources == "null" {
@@ -248,6 +1032,10 @@ func cleanReturnedObj(newObj, obj map[string]any) map[string]any {
 			if !ok {
 				continue
 			}
+			if isRoundedInteger(newValueInterface, oldValueInterface) {
+				mapToReturn[key] = oldValueInterface
+				continue
+			}
 			switch newValue := newValueInterface.(type) {
 			case map[string]any:
 				if oldValue, ok := oldValueInterface.(map[string]any); ok {
@@ -276,6 +1064,14 @@ func cleanReturnedObj(newObj, obj map[string]any) map[string]any {
 func cleanReturnedArray(newObj, obj []any) []any {
 	arrayToReturn := newObj
 	for i := range newObj {
+		if i >= len(obj) {
+			// The items appended by the action have nothing to be compared with
+			break
+		}
+		if isRoundedInteger(newObj[i], obj[i]) {
+			arrayToReturn[i] = obj[i]
+			continue
+		}
 		switch newValue := newObj[i].(type) {
 		case map[string]any:
 			if oldValue, ok := obj[i].(map[string]any); ok {
@@ -292,14 +1088,105 @@ func cleanReturnedArray(newObj, obj []any) []any {
 	return arrayToReturn
 }
 
+// clearServerSetMetadata removes the metadata fields set by the API server, which created resources often copy from the
+// source resource, so that the created resources are ready to be applied.
+func clearServerSetMetadata(obj *unstructured.Unstructured) {
+	if obj == nil {
+		return
+	}
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "generation")
+}
+
+// isRoundedInteger returns whether newValue is the original integer oldValue after it went through the float64 numbers
+// of Lua. Integers beyond 2^53 lose precision as float64, so the original value is restored if the action did not
+// change it.
+func isRoundedInteger(newValue, oldValue any) bool {
+	oldInt, ok := oldValue.(int64)
+	if !ok {
+		return false
+	}
+	switch newNumber := newValue.(type) {
+	case int64:
+		return float64(newNumber) == float64(oldInt)
+	case float64:
+		return newNumber == float64(oldInt)
+	}
+	return false
+}
+
 func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, scripts []string) ([]appv1.ResourceAction, error) {
+	return vm.ExecuteResourceActionDiscoveryContext(context.Background(), obj, scripts)
+}
+
+// ExecuteResourceActionDiscoveryContext runs the discovery scripts like ExecuteResourceActionDiscovery, and stops them
+// once the given context is canceled or its deadline is exceeded, e.g. when the client of a discovery request goes
+// away. Every script still runs for at most the timeout of the VM.
+func (vm VM) ExecuteResourceActionDiscoveryContext(ctx context.Context, obj *unstructured.Unstructured, scripts []string) ([]appv1.ResourceAction, error) {
+	vm.parentCtx = ctx
 	if len(scripts) == 0 {
 		return nil, errors.New("no action discovery script provided")
 	}
+	if vm.DiscoveryCache == nil {
+		availableActions, err := vm.executeResourceActionDiscovery(obj, scripts)
+		if err != nil {
+			return nil, err
+		}
+		return vm.offeredActions(obj, availableActions)
+	}
+	key, err := discoveryCacheKey(obj, scripts)
+	if err != nil {
+		return nil, err
+	}
+	// The cached actions are not filtered, since the cache is shared by the VMs with different policies
+	if availableActions, ok := vm.DiscoveryCache.get(key); ok {
+		return vm.offeredActions(obj, availableActions)
+	}
+	availableActions, err := vm.executeResourceActionDiscovery(obj, scripts)
+	if err != nil {
+		return nil, err
+	}
+	vm.DiscoveryCache.set(key, availableActions)
+	return vm.offeredActions(obj, availableActions)
+}
+
+// offeredActions returns the discovered actions permitted by the policy of the VM, with the actions whose when
+// predicate does not hold for the resource disabled, and the display name of their definition for the actions
+// discovery gives none. The discovered actions are not modified, since they may be cached.
+func (vm VM) offeredActions(obj *unstructured.Unstructured, availableActions []appv1.ResourceAction) ([]appv1.ResourceAction, error) {
+	actions := slices.Clone(vm.ActionPolicy.filter(availableActions))
+	for i := range actions {
+		definition, err := vm.GetResourceAction(obj, actions[i].Name)
+		var doesNotExistErr *ScriptDoesNotExistError
+		switch {
+		case errors.As(err, &doesNotExistErr):
+			continue
+		case err != nil && actions[i].Disabled:
+			// A disabled action cannot be run, hence its broken definition does not prevent offering the others
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("error getting action %q: %w", actions[i].Name, err)
+		}
+		if actions[i].DisplayName == "" {
+			actions[i].DisplayName = definition.DisplayName
+		}
+		if actions[i].Disabled || definition.When == "" {
+			continue
+		}
+		offered, _, err := vm.evaluateActionCondition(obj, definition.When, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating when predicate of action %q: %w", actions[i].Name, err)
+		}
+		actions[i].Disabled = !offered
+	}
+	return actions, nil
+}
+
+func (vm VM) executeResourceActionDiscovery(obj *unstructured.Unstructured, scripts []string) ([]appv1.ResourceAction, error) {
 	availableActionsMap := make(map[string]appv1.ResourceAction)
 
 	for _, script := range scripts {
-		l, err := vm.runLua(obj, script)
+		l, err := vm.runLua(obj, script, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -337,6 +1224,9 @@ func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, scri
 			if err != nil {
 				return nil, fmt.Errorf("error unmarshaling resource action: %w", err)
 			}
+			if err := resolveParamDefaults(obj, &resourceAction); err != nil {
+				return nil, err
+			}
 			availableActionsMap[key] = resourceAction
 		}
 	}
@@ -349,6 +1239,45 @@ func (vm VM) ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, scri
 	return availableActions, nil
 }
 
+// resolveParamDefaults sets the default values of the parameters of the action declaring a DefaultFrom expression to
+// the value of the field of the resource it matches.
+func resolveParamDefaults(obj *unstructured.Unstructured, action *appv1.ResourceAction) error {
+	for i := range action.Params {
+		param := &action.Params[i]
+		if param.DefaultFrom == "" {
+			continue
+		}
+		expression := param.DefaultFrom
+		if !strings.HasPrefix(expression, "{") {
+			expression = "{" + expression + "}"
+		}
+		path := jsonpath.New(param.Name).AllowMissingKeys(true)
+		if err := path.Parse(expression); err != nil {
+			return fmt.Errorf("error parsing defaultFrom of parameter %q of action %q: %w", param.Name, action.Name, err)
+		}
+		results, err := path.FindResults(obj.Object)
+		if err != nil {
+			return fmt.Errorf("error resolving defaultFrom of parameter %q of action %q: %w", param.Name, action.Name, err)
+		}
+		if len(results) == 0 || len(results[0]) == 0 {
+			continue
+		}
+		switch value := results[0][0].Interface().(type) {
+		case string:
+			param.Default = value
+		case map[string]any, []any:
+			valueBytes, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("error marshaling default of parameter %q of action %q: %w", param.Name, action.Name, err)
+			}
+			param.Default = string(valueBytes)
+		default:
+			param.Default = fmt.Sprint(value)
+		}
+	}
+	return nil
+}
+
 // Actions are enabled by default
 func isActionDisabled(actionsMap any) bool {
 	actions, ok := actionsMap.(map[string]any)
@@ -376,6 +1305,17 @@ func noAvailableActions(jsonBytes []byte) bool {
 }
 
 func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) ([]string, error) {
+	discoveryScripts, err := vm.getResourceActionDiscovery(obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := vm.checkScriptSize(discoveryScripts...); err != nil {
+		return nil, err
+	}
+	return discoveryScripts, nil
+}
+
+func (vm VM) getResourceActionDiscovery(obj *unstructured.Unstructured) ([]string, error) {
 	key := GetConfigMapKey(obj.GroupVersionKind())
 	var discoveryScripts []string
 
@@ -394,8 +1334,7 @@ func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) ([]strin
 	}
 
 	// Fetch predefined Lua scripts
-	discoveryKey := key + "/actions/"
-	discoveryScript, err := vm.getPredefinedLuaScripts(discoveryKey, actionDiscoveryScriptFile)
+	discoveryScript, err := vm.getPredefinedVersionedLuaScripts(obj.GroupVersionKind(), "actions", actionDiscoveryScriptFile)
 	if err != nil {
 		var doesNotExistErr *ScriptDoesNotExistError
 		if errors.As(err, &doesNotExistErr) {
@@ -412,6 +1351,44 @@ func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) ([]strin
 
 // GetResourceAction attempts to read lua script from config and then filesystem for that resource
 func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string) (appv1.ResourceActionDefinition, error) {
+	action, err := vm.getResourceAction(obj, actionName)
+	if err != nil {
+		return appv1.ResourceActionDefinition{}, err
+	}
+	if err := vm.checkScriptSize(action.ActionLua, action.ActionCUE, action.Precondition, action.Postcondition, action.When); err != nil {
+		return appv1.ResourceActionDefinition{}, err
+	}
+	if err := vm.checkRequiredLibraries(action); err != nil {
+		return appv1.ResourceActionDefinition{}, err
+	}
+	return action, nil
+}
+
+// checkRequiredLibraries returns an error if the action requires a library which is not enabled, so that it fails
+// when it is loaded rather than when its script calls the library.
+func (vm VM) checkRequiredLibraries(action appv1.ResourceActionDefinition) error {
+	for _, name := range action.Requires {
+		if !vm.libraryEnabled(name) {
+			return fmt.Errorf("action %q requires the %q library, which is not available", action.Name, name)
+		}
+	}
+	return nil
+}
+
+// checkScriptSize returns an error if one of the scripts is larger than the maximum script size.
+func (vm VM) checkScriptSize(scripts ...string) error {
+	if vm.MaxScriptBytes <= 0 {
+		return nil
+	}
+	for _, script := range scripts {
+		if len(script) > vm.MaxScriptBytes {
+			return fmt.Errorf("action script exceeds max size of %d bytes", vm.MaxScriptBytes)
+		}
+	}
+	return nil
+}
+
+func (vm VM) getResourceAction(obj *unstructured.Unstructured, actionName string) (appv1.ResourceActionDefinition, error) {
 	key := GetConfigMapKey(obj.GroupVersionKind())
 	override, ok := vm.ResourceOverrides[key]
 	if ok && override.Actions != "" {
@@ -426,18 +1403,83 @@ func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string
 		}
 	}
 
-	actionKey := fmt.Sprintf("%s/actions/%s", key, actionName)
-	actionScript, err := vm.getPredefinedLuaScripts(actionKey, actionScriptFile)
+	if vm.ActionLoader != nil {
+		resolved, err := vm.ResolveResourceAction(obj, actionName)
+		if err != nil {
+			return appv1.ResourceActionDefinition{}, err
+		}
+		if len(resolved.Overridden) > 0 {
+			log.Debugf("%s action %q of %s overrides the %v actions", resolved.Layer, actionName, key, resolved.Overridden)
+		}
+		return resolved.Definition, nil
+	}
+	return vm.getPredefinedResourceAction(obj, actionName)
+}
+
+// getPredefinedResourceAction returns the built-in action with the given name.
+func (vm VM) getPredefinedResourceAction(obj *unstructured.Unstructured, actionName string) (appv1.ResourceActionDefinition, error) {
+	actionScript, err := vm.getPredefinedVersionedLuaScripts(obj.GroupVersionKind(), "actions/"+actionName, actionScriptFile)
+	var actionCUE string
+	var doesNotExistErr *ScriptDoesNotExistError
+	if errors.As(err, &doesNotExistErr) {
+		// The action may be implemented in CUE instead
+		actionCUE, err = vm.getPredefinedVersionedLuaScripts(obj.GroupVersionKind(), "actions/"+actionName, actionCUEScriptFile)
+	}
+	if err != nil {
+		return appv1.ResourceActionDefinition{}, err
+	}
+	precondition, err := vm.getOptionalPredefinedActionScript(obj.GroupVersionKind(), actionName, preconditionScriptFile)
+	if err != nil {
+		return appv1.ResourceActionDefinition{}, err
+	}
+	postcondition, err := vm.getOptionalPredefinedActionScript(obj.GroupVersionKind(), actionName, postconditionScriptFile)
+	if err != nil {
+		return appv1.ResourceActionDefinition{}, err
+	}
+	when, err := vm.getOptionalPredefinedActionScript(obj.GroupVersionKind(), actionName, whenScriptFile)
+	if err != nil {
+		return appv1.ResourceActionDefinition{}, err
+	}
+	manifestData, err := vm.getOptionalPredefinedActionScript(obj.GroupVersionKind(), actionName, actionManifestFile)
 	if err != nil {
 		return appv1.ResourceActionDefinition{}, err
 	}
+	var manifest actionManifest
+	if err := yaml.UnmarshalStrict([]byte(manifestData), &manifest); err != nil {
+		return appv1.ResourceActionDefinition{}, fmt.Errorf("error parsing %s of action %q: %w", actionManifestFile, actionName, err)
+	}
 
 	return appv1.ResourceActionDefinition{
-		Name:      actionName,
-		ActionLua: actionScript,
+		Name:          actionName,
+		ActionLua:     actionScript,
+		ActionCUE:     actionCUE,
+		Precondition:  precondition,
+		Postcondition: postcondition,
+		When:          when,
+		Requires:      manifest.Requires,
 	}, nil
 }
 
+// actionManifest describes a built-in action in its actionManifestFile.
+type actionManifest struct {
+	// Requires are the optional libraries used by the action
+	Requires []string `json:"requires,omitempty"`
+}
+
+// getOptionalPredefinedActionScript returns the given built-in script of the action, or an empty string if the
+// action does not have it.
+func (vm VM) getOptionalPredefinedActionScript(gvk schema.GroupVersionKind, actionName string, scriptFile string) (string, error) {
+	script, err := vm.getPredefinedVersionedLuaScripts(gvk, "actions/"+actionName, scriptFile)
+	if err != nil {
+		var doesNotExistErr *ScriptDoesNotExistError
+		if errors.As(err, &doesNotExistErr) {
+			return "", nil
+		}
+		return "", err
+	}
+	return script, nil
+}
+
 func GetConfigMapKey(gvk schema.GroupVersionKind) string {
 	if gvk.Group == "" {
 		return gvk.Kind
@@ -459,7 +1501,24 @@ func getWildcardHealthOverrideLua(overrides map[string]appv1.ResourceOverride, g
 	return "", false
 }
 
+// getPredefinedVersionedLuaScripts reads a built-in script provided for the exact API version of the GVK (e.g.
+// batch/CronJob/v1beta1/actions), falling back to the script provided for the group and kind.
+func (vm VM) getPredefinedVersionedLuaScripts(gvk schema.GroupVersionKind, subPath string, scriptFile string) (string, error) {
+	key := GetConfigMapKey(gvk)
+	if gvk.Version != "" {
+		script, err := vm.getPredefinedLuaScripts(fmt.Sprintf("%s/%s/%s", key, gvk.Version, subPath), scriptFile)
+		var doesNotExist *ScriptDoesNotExistError
+		if err == nil || !errors.As(err, &doesNotExist) {
+			return script, err
+		}
+	}
+	return vm.getPredefinedLuaScripts(fmt.Sprintf("%s/%s", key, subPath), scriptFile)
+}
+
 func (vm VM) getPredefinedLuaScripts(objKey string, scriptFile string) (string, error) {
+	if vm.ScriptLoader != nil {
+		return vm.ScriptLoader.ReadScript(objKey, scriptFile)
+	}
 	data, err := resource_customizations.Embedded.ReadFile(filepath.Join(objKey, scriptFile))
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -2,14 +2,21 @@ package lua
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	lua "github.com/yuin/gopher-lua"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
 	"sigs.k8s.io/yaml"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -311,6 +318,54 @@ func TestGetResourceActionWithOverride(t *testing.T) {
 	assert.Equal(t, test, action)
 }
 
+func TestGetResourceActionByNameWithDisplayName(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	restart := appv1.ResourceActionDefinition{
+		Name:        "restart",
+		DisplayName: "Restart Workload",
+		ActionLua:   "return obj",
+	}
+	pause := appv1.ResourceActionDefinition{
+		Name:        "pause",
+		DisplayName: "Pause",
+		ActionLua:   "return obj",
+	}
+	vm := VM{
+		ResourceOverrides: map[string]appv1.ResourceOverride{
+			"argoproj.io/Rollout": {
+				Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+					ActionDiscoveryLua: `return {restart = {}, pause = {displayName = "Pause Rollout"}}`,
+					Definitions:        []appv1.ResourceActionDefinition{restart, pause},
+				})),
+			},
+		},
+	}
+
+	action, err := vm.GetResourceAction(testObj, "restart")
+	require.NoError(t, err)
+	assert.Equal(t, restart, action)
+
+	_, err = vm.GetResourceAction(testObj, "Restart Workload")
+	require.Error(t, err)
+
+	displayNames := func(actions []appv1.ResourceAction) map[string]string {
+		names := make(map[string]string, len(actions))
+		for _, action := range actions {
+			names[action.Name] = action.DisplayName
+		}
+		return names
+	}
+	expected := map[string]string{"restart": "Restart Workload", "pause": "Pause Rollout"}
+	discoveryLua, err := vm.GetResourceActionDiscovery(testObj)
+	require.NoError(t, err)
+	discovered, err := vm.ExecuteResourceActionDiscovery(testObj, discoveryLua)
+	require.NoError(t, err)
+	assert.Equal(t, expected, displayNames(discovered), "the display name of the definition is used if discovery gives none")
+	listed, err := vm.ListResourceActions(testObj.GroupVersionKind())
+	require.NoError(t, err)
+	assert.Equal(t, expected, displayNames(listed))
+}
+
 func TestGetResourceActionDiscoveryPredefined(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
@@ -429,6 +484,33 @@ func TestExecuteResourceActionDiscoveryWithDuplicationActions(t *testing.T) {
 	}
 }
 
+const deprecatedDiscoveryLua = `
+restart = {}
+restart["deprecated"] = true
+restart["deprecationMessage"] = "use the rollout action instead"
+rollout = {}
+
+a = {restart = restart, rollout = rollout}
+return a
+`
+
+func TestExecuteResourceActionDiscoveryDeprecatedAction(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	actions, err := vm.ExecuteResourceActionDiscovery(testObj, []string{deprecatedDiscoveryLua})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []appv1.ResourceAction{
+		{
+			Name:               "restart",
+			Deprecated:         true,
+			DeprecationMessage: "use the rollout action instead",
+		},
+		{
+			Name: "rollout",
+		},
+	}, actions)
+}
+
 const discoveryLuaWithInvalidResourceAction = `
 resume = {name = 'resume', invalidField: "test""}
 a = {resume = resume}
@@ -477,13 +559,52 @@ func TestExecuteOldStyleResourceAction(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	expectedLuaUpdatedObj := StrToUnstructured(expectedLuaUpdatedResult)
 	vm := VM{}
-	newObjects, err := vm.ExecuteResourceAction(testObj, validActionLua)
+	newObjects, err := vm.ExecuteResourceAction(testObj, validActionLua, nil)
 	require.NoError(t, err)
 	assert.Len(t, newObjects, 1)
 	assert.Equal(t, newObjects[0].K8SOperation, K8SOperation("patch"))
 	assert.Equal(t, expectedLuaUpdatedObj, newObjects[0].UnstructuredObj)
 }
 
+const actionWithWarningsLua = `
+obj.metadata.labels["test"] = "test"
+return obj, {"scaling to 0 will take the app offline", ""}
+`
+
+const actionWithInvalidWarningsLua = `
+return obj, {1}
+`
+
+func TestExecuteResourceActionWithWarnings(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	expectedLuaUpdatedObj := StrToUnstructured(expectedLuaUpdatedResult)
+	vm := VM{}
+
+	t.Run("Warnings", func(t *testing.T) {
+		result, err := vm.ExecuteResourceActionWithResult(testObj.DeepCopy(), actionWithWarningsLua, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		assert.Equal(t, PatchOperation, result.ImpactedResources[0].K8SOperation)
+		assert.Equal(t, expectedLuaUpdatedObj, result.ImpactedResources[0].UnstructuredObj)
+		assert.Equal(t, []string{"scaling to 0 will take the app offline"}, result.Warnings)
+	})
+
+	t.Run("NoWarnings", func(t *testing.T) {
+		result, err := vm.ExecuteResourceActionWithResult(testObj.DeepCopy(), validActionLua, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		assert.Nil(t, result.Warnings)
+		jsonBytes, err := json.Marshal(result)
+		require.NoError(t, err)
+		assert.NotContains(t, string(jsonBytes), "warnings")
+	})
+
+	t.Run("InvalidWarnings", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionWithResult(testObj.DeepCopy(), actionWithInvalidWarningsLua, nil)
+		require.ErrorContains(t, err, "expect string warnings from Lua script, not number")
+	})
+}
+
 const cronJobObjYaml = `
 apiVersion: batch/v1
 kind: CronJob
@@ -650,7 +771,7 @@ func TestExecuteNewStyleCreateActionSingleResource(t *testing.T) {
 	expectedObjects, err := UnmarshalToImpactedResources(bytes.NewBuffer(jsonBytes).String())
 	require.NoError(t, err)
 	vm := VM{}
-	newObjects, err := vm.ExecuteResourceAction(testObj, createJobActionLua)
+	newObjects, err := vm.ExecuteResourceAction(testObj, createJobActionLua, nil)
 	require.NoError(t, err)
 	assert.Equal(t, expectedObjects, newObjects)
 }
@@ -663,7 +784,7 @@ func TestExecuteNewStyleCreateActionMultipleResources(t *testing.T) {
 	expectedObjects, err := UnmarshalToImpactedResources(bytes.NewBuffer(jsonBytes).String())
 	require.NoError(t, err)
 	vm := VM{}
-	newObjects, err := vm.ExecuteResourceAction(testObj, createMultipleJobsActionLua)
+	newObjects, err := vm.ExecuteResourceAction(testObj, createMultipleJobsActionLua, nil)
 	require.NoError(t, err)
 	assert.Equal(t, expectedObjects, newObjects)
 }
@@ -676,7 +797,7 @@ func TestExecuteNewStyleActionMixedOperationsOk(t *testing.T) {
 	expectedObjects, err := UnmarshalToImpactedResources(bytes.NewBuffer(jsonBytes).String())
 	require.NoError(t, err)
 	vm := VM{}
-	newObjects, err := vm.ExecuteResourceAction(testObj, mixedOperationActionLuaOk)
+	newObjects, err := vm.ExecuteResourceAction(testObj, mixedOperationActionLuaOk, nil)
 	require.NoError(t, err)
 	assert.Equal(t, expectedObjects, newObjects)
 }
@@ -684,14 +805,14 @@ func TestExecuteNewStyleActionMixedOperationsOk(t *testing.T) {
 func TestExecuteNewStyleActionMixedOperationsFailure(t *testing.T) {
 	testObj := StrToUnstructured(cronJobObjYaml)
 	vm := VM{}
-	_, err := vm.ExecuteResourceAction(testObj, createMixedOperationActionLuaFailing)
+	_, err := vm.ExecuteResourceAction(testObj, createMixedOperationActionLuaFailing, nil)
 	assert.ErrorContains(t, err, "unsupported operation")
 }
 
 func TestExecuteResourceActionNonTableReturn(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
-	_, err := vm.ExecuteResourceAction(testObj, returnInt)
+	_, err := vm.ExecuteResourceAction(testObj, returnInt, nil)
 	assert.Errorf(t, err, incorrectReturnType, "table", "number")
 }
 
@@ -703,7 +824,7 @@ return newObj
 func TestExecuteResourceActionInvalidUnstructured(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
-	_, err := vm.ExecuteResourceAction(testObj, invalidTableReturn)
+	_, err := vm.ExecuteResourceAction(testObj, invalidTableReturn, nil)
 	require.Error(t, err)
 }
 
@@ -758,7 +879,7 @@ func TestCleanPatch(t *testing.T) {
 	testObj := StrToUnstructured(objWithEmptyStruct)
 	expectedObj := StrToUnstructured(expectedUpdatedObjWithEmptyStruct)
 	vm := VM{}
-	newObjects, err := vm.ExecuteResourceAction(testObj, pausedToFalseLua)
+	newObjects, err := vm.ExecuteResourceAction(testObj, pausedToFalseLua, nil)
 	require.NoError(t, err)
 	assert.Len(t, newObjects, 1)
 	assert.Equal(t, newObjects[0].K8SOperation, K8SOperation("patch"))
@@ -882,3 +1003,1182 @@ return hs`
 		assert.Nil(t, status)
 	})
 }
+
+func TestGetResourceActionVersioned(t *testing.T) {
+	dir := t.TempDir()
+	writeScript(t, dir, "batch/CronJob/actions/discovery.lua", `return {["create-job"] = {}}`)
+	writeScript(t, dir, "batch/CronJob/actions/create-job/action.lua", "return 'unversioned'")
+	writeScript(t, dir, "batch/CronJob/v1beta1/actions/discovery.lua", `return {["create-job"] = {}, ["legacy"] = {}}`)
+	writeScript(t, dir, "batch/CronJob/v1beta1/actions/create-job/action.lua", "return 'v1beta1'")
+	loader, err := NewDirScriptLoader(dir)
+	require.NoError(t, err)
+	vm := VM{ScriptLoader: loader}
+
+	t.Run("Version specific action", func(t *testing.T) {
+		obj := StrToUnstructured(`{"apiVersion": "batch/v1beta1", "kind": "CronJob", "metadata": {"name": "hello"}}`)
+		action, err := vm.GetResourceAction(obj, "create-job")
+		require.NoError(t, err)
+		assert.Equal(t, "return 'v1beta1'", action.ActionLua)
+
+		discoveryScripts, err := vm.GetResourceActionDiscovery(obj)
+		require.NoError(t, err)
+		assert.Equal(t, []string{`return {["create-job"] = {}, ["legacy"] = {}}`}, discoveryScripts)
+	})
+
+	t.Run("Fallback to group and kind", func(t *testing.T) {
+		obj := StrToUnstructured(`{"apiVersion": "batch/v1", "kind": "CronJob", "metadata": {"name": "hello"}}`)
+		action, err := vm.GetResourceAction(obj, "create-job")
+		require.NoError(t, err)
+		assert.Equal(t, "return 'unversioned'", action.ActionLua)
+
+		discoveryScripts, err := vm.GetResourceActionDiscovery(obj)
+		require.NoError(t, err)
+		assert.Equal(t, []string{`return {["create-job"] = {}}`}, discoveryScripts)
+	})
+}
+
+const pausedPrecondition = `
+if obj.spec ~= nil and obj.spec.paused then
+  return false, "rollout is paused"
+end
+return true
+`
+
+func TestExecuteResourceActionDefinitionPrecondition(t *testing.T) {
+	vm := VM{}
+	action := appv1.ResourceActionDefinition{
+		Name:         "test",
+		ActionLua:    validActionLua,
+		Precondition: pausedPrecondition,
+	}
+
+	t.Run("Passing", func(t *testing.T) {
+		result, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), action, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		assert.Equal(t, StrToUnstructured(expectedLuaUpdatedResult), result.ImpactedResources[0].UnstructuredObj)
+	})
+
+	t.Run("Failing", func(t *testing.T) {
+		testObj := StrToUnstructured(objJSON)
+		testObj.Object["spec"] = map[string]any{"paused": true}
+		_, err := vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.EqualError(t, err, "precondition failed: rollout is paused")
+	})
+
+	t.Run("FailingWithoutMessage", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), appv1.ResourceActionDefinition{
+			ActionLua:    validActionLua,
+			Precondition: "return false",
+		}, nil)
+		require.EqualError(t, err, "precondition failed: the condition of the action does not hold")
+	})
+
+	t.Run("InvalidReturn", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), appv1.ResourceActionDefinition{
+			ActionLua:    validActionLua,
+			Precondition: "return 'yes'",
+		}, nil)
+		require.EqualError(t, err, "error evaluating precondition: expect boolean output from Lua script, not string")
+	})
+
+	t.Run("BuiltIn", func(t *testing.T) {
+		dir := t.TempDir()
+		writeScript(t, dir, "argoproj.io/Rollout/actions/test/action.lua", validActionLua)
+		writeScript(t, dir, "argoproj.io/Rollout/actions/test/precondition.lua", pausedPrecondition)
+		loader, err := NewDirScriptLoader(dir)
+		require.NoError(t, err)
+		vm := VM{ScriptLoader: loader}
+		testObj := StrToUnstructured(objJSON)
+		testObj.Object["spec"] = map[string]any{"paused": true}
+
+		action, err := vm.GetResourceAction(testObj, "test")
+		require.NoError(t, err)
+		assert.Equal(t, pausedPrecondition, action.Precondition)
+		_, err = vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.EqualError(t, err, "precondition failed: rollout is paused")
+	})
+}
+
+const deploymentWithContainersYaml = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  namespace: default
+spec:
+  template:
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.27
+`
+
+const removeContainersActionLua = `
+obj.spec.template.spec.containers = {}
+return obj
+`
+
+const setImageActionLua = `
+obj.spec.template.spec.containers[1].image = "nginx:1.28"
+return obj
+`
+
+const containersPostcondition = `
+if obj.kind == "Deployment" and #obj.spec.template.spec.containers == 0 then
+  return false, "the pod template must have at least one container"
+end
+return true
+`
+
+func TestExecuteResourceActionDefinitionPostcondition(t *testing.T) {
+	vm := VM{}
+
+	t.Run("Passing", func(t *testing.T) {
+		result, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(deploymentWithContainersYaml), appv1.ResourceActionDefinition{
+			ActionLua:     setImageActionLua,
+			Postcondition: containersPostcondition,
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		containers, _, err := unstructured.NestedSlice(result.ImpactedResources[0].UnstructuredObj.Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		assert.Equal(t, "nginx:1.28", containers[0].(map[string]any)["image"])
+	})
+
+	t.Run("EmptyContainerList", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(deploymentWithContainersYaml), appv1.ResourceActionDefinition{
+			ActionLua:     removeContainersActionLua,
+			Postcondition: containersPostcondition,
+		}, nil)
+		require.EqualError(t, err, "postcondition failed for Deployment default/nginx: the pod template must have at least one container")
+	})
+
+	t.Run("BuiltIn", func(t *testing.T) {
+		dir := t.TempDir()
+		writeScript(t, dir, "apps/Deployment/actions/test/action.lua", removeContainersActionLua)
+		writeScript(t, dir, "apps/Deployment/actions/test/postcondition.lua", containersPostcondition)
+		loader, err := NewDirScriptLoader(dir)
+		require.NoError(t, err)
+		vm := VM{ScriptLoader: loader}
+		testObj := StrToUnstructured(deploymentWithContainersYaml)
+
+		action, err := vm.GetResourceAction(testObj, "test")
+		require.NoError(t, err)
+		assert.Empty(t, action.Precondition)
+		assert.Equal(t, containersPostcondition, action.Postcondition)
+		_, err = vm.ExecuteResourceActionDefinition(testObj, action, nil)
+		require.ErrorContains(t, err, "postcondition failed for Deployment default/nginx")
+	})
+}
+
+const confirmationDiscoveryLua = `
+actions = {}
+actions["delete-pods"] = {["requiresConfirmation"] = true}
+actions["restart"] = {}
+return actions
+`
+
+func TestExecuteResourceActionDiscoveryRequiresConfirmation(t *testing.T) {
+	vm := VM{}
+	actions, err := vm.ExecuteResourceActionDiscovery(StrToUnstructured(objJSON), []string{confirmationDiscoveryLua})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []appv1.ResourceAction{
+		{Name: "delete-pods", RequiresConfirmation: true},
+		{Name: "restart"},
+	}, actions)
+}
+
+func TestExecuteResourceActionDefinitionRequiresConfirmation(t *testing.T) {
+	vm := VM{}
+	action := appv1.ResourceActionDefinition{
+		Name:                 "scale-to-zero",
+		ActionLua:            validActionLua,
+		RequiresConfirmation: true,
+	}
+	token := func(value string) []*ResourceActionParameters {
+		return NewParams().Set(ConfirmationTokenParameter, value).Build()
+	}
+
+	t.Run("WithoutToken", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), action, nil)
+		require.EqualError(t, err, `action "scale-to-zero" requires confirmation`)
+	})
+
+	t.Run("WrongToken", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), action, token("restart"))
+		require.EqualError(t, err, `action "scale-to-zero" requires confirmation`)
+	})
+
+	t.Run("WithToken", func(t *testing.T) {
+		result, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), action, token("scale-to-zero"))
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		assert.Equal(t, StrToUnstructured(expectedLuaUpdatedResult), result.ImpactedResources[0].UnstructuredObj)
+	})
+
+	t.Run("SkipConfirmation", func(t *testing.T) {
+		vm := VM{SkipConfirmation: true}
+		result, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), action, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+	})
+}
+
+const localizedDiscoveryLua = `
+actions = {}
+actions["restart"] = {
+  ["displayName"] = "Restart Workload",
+  ["localizedDisplayNames"] = {["de"] = "Workload neu starten", ["ja"] = "ワークロードを再起動"}
+}
+return actions
+`
+
+func TestExecuteResourceActionDiscoveryLocalizedDisplayNames(t *testing.T) {
+	vm := VM{}
+	actions, err := vm.ExecuteResourceActionDiscovery(StrToUnstructured(objJSON), []string{localizedDiscoveryLua})
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, appv1.ResourceAction{
+		Name:        "restart",
+		DisplayName: "Restart Workload",
+		LocalizedDisplayNames: map[string]string{
+			"de": "Workload neu starten",
+			"ja": "ワークロードを再起動",
+		},
+	}, actions[0])
+	assert.Equal(t, "ワークロードを再起動", actions[0].LocalizedDisplayName("ja-JP"))
+}
+
+func TestExecuteResourceActionWithResultStatus(t *testing.T) {
+	vm := VM{}
+	testData := []struct {
+		name             string
+		script           string
+		expectedStatus   ActionResultStatus
+		expectedWarnings []string
+		expectedMessage  string
+	}{{
+		name:           "Unspecified",
+		script:         validActionLua,
+		expectedStatus: ActionResultStatusOK,
+	}, {
+		name:           "OK",
+		script:         `return obj, {status = "ok"}`,
+		expectedStatus: ActionResultStatusOK,
+	}, {
+		name:           "Noop",
+		script:         `return obj, {status = "noop"}`,
+		expectedStatus: ActionResultStatusNoop,
+	}, {
+		name:             "Warning",
+		script:           `return obj, {status = "warning", warnings = {"the rollout is paused"}}`,
+		expectedStatus:   ActionResultStatusWarning,
+		expectedWarnings: []string{"the rollout is paused"},
+	}, {
+		name:             "WarningsOnly",
+		script:           `return obj, "the rollout is paused"`,
+		expectedStatus:   ActionResultStatusOK,
+		expectedWarnings: []string{"the rollout is paused"},
+	}, {
+		name:            "Message",
+		script:          `return obj, {message = "scaled to 3 replicas"}`,
+		expectedStatus:  ActionResultStatusOK,
+		expectedMessage: "scaled to 3 replicas",
+	}, {
+		name:             "MessageWithStatusAndWarnings",
+		script:           `return obj, {status = "warning", message = "restarted", warnings = "the rollout is paused"}`,
+		expectedStatus:   ActionResultStatusWarning,
+		expectedWarnings: []string{"the rollout is paused"},
+		expectedMessage:  "restarted",
+	}}
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result, err := vm.ExecuteResourceActionWithResult(StrToUnstructured(objJSON), data.script, nil)
+			require.NoError(t, err)
+			assert.Equal(t, data.expectedStatus, result.Status)
+			assert.Equal(t, data.expectedWarnings, result.Warnings)
+			assert.Equal(t, data.expectedMessage, result.Message)
+			require.Len(t, result.ImpactedResources, 1)
+
+			impactedResources, err := vm.ExecuteResourceAction(StrToUnstructured(objJSON), data.script, nil)
+			require.NoError(t, err)
+			assert.Equal(t, result.ImpactedResources, impactedResources)
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionWithResult(StrToUnstructured(objJSON), `return obj, {status = "failed"}`, nil)
+		require.EqualError(t, err, `invalid action result status "failed"`)
+	})
+	t.Run("InvalidMessage", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionWithResult(StrToUnstructured(objJSON), `return obj, {message = {"restarted"}}`, nil)
+		require.ErrorContains(t, err, "string message")
+	})
+}
+
+const objWithLargeIntegers = `{
+  "apiVersion": "batch/v1",
+  "kind": "Job",
+  "metadata": {"name": "large-integers", "namespace": "default"},
+  "spec": {
+    "activeDeadlineSeconds": 9007199254740993,
+    "backoffLimit": 6,
+    "template": {
+      "spec": {
+        "containers": [
+          {"name": "main", "ports": [{"containerPort": 9223372036854775807}]},
+          {"name": "sidecar", "ports": [{"containerPort": -9007199254740995}]}
+        ]
+      }
+    }
+  }
+}`
+
+func TestExecuteResourceActionPreservesLargeIntegers(t *testing.T) {
+	// Objects read from Kubernetes hold integers as int64
+	testObj, err := appv1.UnmarshalToUnstructured(objWithLargeIntegers)
+	require.NoError(t, err)
+	vm := VM{}
+	newObjects, err := vm.ExecuteResourceAction(testObj.DeepCopy(), `
+obj.metadata.labels = {test = "test"}
+obj.spec.backoffLimit = obj.spec.backoffLimit + 1
+return obj
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, newObjects, 1)
+	result := newObjects[0].UnstructuredObj.Object
+
+	activeDeadlineSeconds, _, err := unstructured.NestedInt64(result, "spec", "activeDeadlineSeconds")
+	require.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), activeDeadlineSeconds)
+	backoffLimit, _, err := unstructured.NestedInt64(result, "spec", "backoffLimit")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), backoffLimit)
+
+	containers, _, err := unstructured.NestedSlice(result, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	require.Len(t, containers, 2)
+	for i, expected := range []int64{9223372036854775807, -9007199254740995} {
+		ports, _, err := unstructured.NestedSlice(containers[i].(map[string]any), "ports")
+		require.NoError(t, err)
+		assert.Equal(t, expected, ports[0].(map[string]any)["containerPort"])
+	}
+}
+
+const createWithoutNamespaceActionLua = `
+resource = {}
+resource.apiVersion = "%s"
+resource.kind = "%s"
+resource.metadata = {}
+resource.metadata.name = "created"
+impactedResource = {}
+impactedResource.operation = "create"
+impactedResource.resource = resource
+result = {}
+result[1] = impactedResource
+return result
+`
+
+type fakeResourceInfoProvider map[schema.GroupKind]bool
+
+func (p fakeResourceInfoProvider) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	namespaced, ok := p[gk]
+	if !ok {
+		return false, fmt.Errorf("unknown kind %s", gk)
+	}
+	return namespaced, nil
+}
+
+func TestExecuteResourceActionCreatedResourceNamespace(t *testing.T) {
+	t.Run("NamespacedKindWithoutNamespace", func(t *testing.T) {
+		vm := VM{}
+		_, err := vm.ExecuteResourceAction(StrToUnstructured(cronJobObjYaml), fmt.Sprintf(createWithoutNamespaceActionLua, "batch/v1", "Job"), nil)
+		require.EqualError(t, err, `created resource Job "created" is namespaced but has no namespace`)
+	})
+
+	t.Run("ClusterScopedKind", func(t *testing.T) {
+		vm := VM{}
+		impactedResources, err := vm.ExecuteResourceAction(StrToUnstructured(cronJobObjYaml), fmt.Sprintf(createWithoutNamespaceActionLua, "rbac.authorization.k8s.io/v1", "ClusterRole"), nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Empty(t, impactedResources[0].UnstructuredObj.GetNamespace())
+	})
+
+	t.Run("ResourceInfoProvider", func(t *testing.T) {
+		vm := VM{ResourceInfoProvider: fakeResourceInfoProvider{
+			{Group: "example.com", Kind: "ClusterThing"}: false,
+		}}
+		_, err := vm.ExecuteResourceAction(StrToUnstructured(cronJobObjYaml), fmt.Sprintf(createWithoutNamespaceActionLua, "example.com/v1", "ClusterThing"), nil)
+		require.NoError(t, err)
+		_, err = vm.ExecuteResourceAction(StrToUnstructured(cronJobObjYaml), fmt.Sprintf(createWithoutNamespaceActionLua, "example.com/v1", "UnknownThing"), nil)
+		require.EqualError(t, err, `created resource UnknownThing "created" is namespaced but has no namespace`)
+	})
+}
+
+func TestExecuteResourceActionSubresource(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+
+	t.Run("Scale", func(t *testing.T) {
+		impactedResources, err := VM{}.ExecuteResourceAction(testObj, `return {{operation = "patch", subresource = "scale", resource = obj}}`, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, ScaleSubresource, impactedResources[0].Subresource)
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		_, err := VM{}.ExecuteResourceAction(testObj, `return {{operation = "patch", subresource = "exec", resource = obj}}`, nil)
+		require.EqualError(t, err, "unsupported subresource: exec")
+	})
+	t.Run("Create", func(t *testing.T) {
+		_, err := VM{}.ExecuteResourceAction(testObj, `return {{operation = "create", subresource = "status", resource = obj}}`, nil)
+		require.EqualError(t, err, "resource 0 targets the status subresource but is not patched")
+	})
+}
+
+func TestExecuteResourceActionCreateSource(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	_, err := VM{}.ExecuteResourceAction(testObj, `return {{operation = "create", resource = obj}}`, nil)
+	require.EqualError(t, err, "create operation must not target the source resource; use patch instead")
+
+	// Creating a resource of the same kind with another name is fine
+	impactedResources, err := VM{}.ExecuteResourceAction(testObj, `obj.metadata.name = "copy"
+return {{operation = "create", resource = obj}}`, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 1)
+}
+
+const cronJobWithServerSetMetadataYaml = `
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: hello
+  namespace: test-ns
+  creationTimestamp: "2024-01-01T00:00:00Z"
+  generation: 3
+`
+
+const createJobCopyingMetadataActionLua = `
+job = {}
+job.apiVersion = "batch/v1"
+job.kind = "Job"
+job.metadata = obj.metadata
+job.metadata.name = "hello-1"
+impactedResource = {}
+impactedResource.operation = "create"
+impactedResource.resource = job
+result = {}
+result[1] = impactedResource
+return result
+`
+
+func TestExecuteResourceActionClearsServerSetMetadataOnCreate(t *testing.T) {
+	testObj := StrToUnstructured(cronJobWithServerSetMetadataYaml)
+	vm := VM{}
+	impactedResources, err := vm.ExecuteResourceAction(testObj.DeepCopy(), createJobCopyingMetadataActionLua, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 1)
+	created := impactedResources[0].UnstructuredObj
+	assert.Equal(t, "hello-1", created.GetName())
+	assert.Equal(t, "test-ns", created.GetNamespace())
+	_, found, err := unstructured.NestedFieldNoCopy(created.Object, "metadata", "creationTimestamp")
+	require.NoError(t, err)
+	assert.False(t, found)
+	_, found, err = unstructured.NestedFieldNoCopy(created.Object, "metadata", "generation")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestExecuteResourceActionDefinitionComposite(t *testing.T) {
+	actions, err := os.ReadFile("testdata/composite-action.yaml")
+	require.NoError(t, err)
+	vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+		"apps/Deployment": {Actions: string(actions)},
+	}}
+	deployment := StrToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 3
+`)
+
+	t.Run("StepsOutputs", func(t *testing.T) {
+		action, err := vm.GetResourceAction(deployment, "snapshot-and-scale-down")
+		require.NoError(t, err)
+		result, err := vm.ExecuteResourceActionDefinition(deployment, action, nil)
+		require.NoError(t, err)
+
+		require.Len(t, result.ImpactedResources, 2)
+		snapshot := result.ImpactedResources[0]
+		assert.Equal(t, CreateOperation, snapshot.K8SOperation)
+		assert.Equal(t, "guestbook-snapshot", snapshot.UnstructuredObj.GetName())
+		replicas, _, err := unstructured.NestedString(snapshot.UnstructuredObj.Object, "data", "replicas")
+		require.NoError(t, err)
+		assert.Equal(t, "3", replicas)
+
+		scaledDown := result.ImpactedResources[1]
+		assert.Equal(t, PatchOperation, scaledDown.K8SOperation)
+		replicasValue, _, err := unstructured.NestedFieldNoCopy(scaledDown.UnstructuredObj.Object, "spec", "replicas")
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, replicasValue)
+		assert.Equal(t, "guestbook-snapshot", scaledDown.UnstructuredObj.GetAnnotations()["example.com/snapshot"])
+		assert.Equal(t, ActionResultStatusOK, result.Status)
+	})
+	t.Run("FailingStep", func(t *testing.T) {
+		action, err := vm.GetResourceAction(deployment, "snapshot-and-fail")
+		require.NoError(t, err)
+		_, err = vm.ExecuteResourceActionDefinition(deployment, action, nil)
+		require.ErrorContains(t, err, `error running step "fail" of action "snapshot-and-fail": `)
+		require.ErrorContains(t, err, "cannot scale down")
+	})
+	t.Run("UnknownStep", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(deployment, appv1.ResourceActionDefinition{Name: "unknown", Steps: []string{"snapshot", "does-not-exist"}}, nil)
+		require.ErrorContains(t, err, `error getting step "does-not-exist" of action "unknown"`)
+	})
+	t.Run("NestedComposite", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(deployment, appv1.ResourceActionDefinition{Name: "nested", Steps: []string{"snapshot-and-scale-down"}}, nil)
+		require.EqualError(t, err, `step "snapshot-and-scale-down" of action "nested" is a composite action, which is not supported`)
+	})
+}
+
+func TestMaxScriptBytes(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	hugeScript := "return obj\n" + strings.Repeat("-- generated\n", 100)
+	overrides := map[string]appv1.ResourceOverride{
+		"argoproj.io/Rollout": {
+			Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+				ActionDiscoveryLua: hugeScript,
+				Definitions: []appv1.ResourceActionDefinition{
+					{Name: "huge", ActionLua: hugeScript},
+					{Name: "huge-precondition", ActionLua: "return obj", Precondition: hugeScript},
+					{Name: "small", ActionLua: "return obj"},
+				},
+			})),
+		},
+	}
+
+	t.Run("Action", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides, MaxScriptBytes: 100}
+		_, err := vm.GetResourceAction(testObj, "huge")
+		require.EqualError(t, err, "action script exceeds max size of 100 bytes")
+		_, err = vm.GetResourceAction(testObj, "huge-precondition")
+		require.EqualError(t, err, "action script exceeds max size of 100 bytes")
+		action, err := vm.GetResourceAction(testObj, "small")
+		require.NoError(t, err)
+		assert.Equal(t, "return obj", action.ActionLua)
+	})
+	t.Run("Discovery", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides, MaxScriptBytes: 100}
+		_, err := vm.GetResourceActionDiscovery(testObj)
+		require.EqualError(t, err, "action script exceeds max size of 100 bytes")
+	})
+	t.Run("Unlimited", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides}
+		_, err := vm.GetResourceAction(testObj, "huge")
+		require.NoError(t, err)
+		_, err = vm.GetResourceActionDiscovery(testObj)
+		require.NoError(t, err)
+	})
+}
+
+func TestExecuteResourceActionWithoutParameters(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	script := `
+local replicas = actionParams["replicas"]
+if replicas == nil then
+  obj.metadata.labels["replicas"] = "default"
+else
+  obj.metadata.labels["replicas"] = replicas
+end
+return obj
+`
+	vm := VM{}
+	for name, params := range map[string][]*ResourceActionParameters{
+		"Nil":   nil,
+		"Empty": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			impactedResources, err := vm.ExecuteResourceAction(testObj, script, params)
+			require.NoError(t, err)
+			require.Len(t, impactedResources, 1)
+			assert.Equal(t, "default", impactedResources[0].UnstructuredObj.GetLabels()["replicas"])
+		})
+	}
+	t.Run("WithParameter", func(t *testing.T) {
+		impactedResources, err := vm.ExecuteResourceAction(testObj, script, NewParams().Set("replicas", "3").Build())
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, "3", impactedResources[0].UnstructuredObj.GetLabels()["replicas"])
+	})
+}
+
+func TestExecuteResourceActionContextTimeout(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	overrides := map[string]appv1.ResourceOverride{
+		"argoproj.io/Rollout": {
+			Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+				ActionDiscoveryLua: `
+actions = {}
+actions["slow"] = {["timeoutSeconds"] = 1}
+actions["fast"] = {}
+return actions
+`,
+				Definitions: []appv1.ResourceActionDefinition{
+					{Name: "slow", ActionLua: "while true do end"},
+					{Name: "fast", ActionLua: "while true do end"},
+				},
+			})),
+		},
+	}
+
+	t.Run("ActionTimeout", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides, Timeout: 50 * time.Millisecond}
+		fast, err := vm.DiscoverResourceAction(t.Context(), testObj, "fast")
+		require.NoError(t, err)
+		assert.Equal(t, 50*time.Millisecond, vm.actionTimeout(fast))
+		slow, err := vm.DiscoverResourceAction(t.Context(), testObj, "slow")
+		require.NoError(t, err)
+		assert.Equal(t, time.Second, vm.actionTimeout(slow))
+		vm.MaxActionTimeout = 300 * time.Millisecond
+		assert.Equal(t, 300*time.Millisecond, vm.actionTimeout(slow))
+		assert.Equal(t, 50*time.Millisecond, vm.actionTimeout(nil))
+	})
+	t.Run("OverridesDefault", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides, Timeout: 50 * time.Millisecond, MaxActionTimeout: 300 * time.Millisecond}
+		action, err := vm.GetResourceAction(testObj, "slow")
+		require.NoError(t, err)
+		start := time.Now()
+		_, err = vm.ExecuteResourceActionContext(t.Context(), testObj, action, nil)
+		require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+		elapsed := time.Since(start)
+		assert.GreaterOrEqual(t, elapsed, 300*time.Millisecond)
+		assert.Less(t, elapsed, time.Second)
+	})
+	t.Run("Default", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides, Timeout: 50 * time.Millisecond, MaxActionTimeout: 300 * time.Millisecond}
+		action, err := vm.GetResourceAction(testObj, "fast")
+		require.NoError(t, err)
+		start := time.Now()
+		_, err = vm.ExecuteResourceActionContext(t.Context(), testObj, action, nil)
+		require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+		assert.Less(t, time.Since(start), 300*time.Millisecond)
+	})
+}
+
+func TestExecuteResourceActionDiscoveryWhen(t *testing.T) {
+	overrides := map[string]appv1.ResourceOverride{
+		"argoproj.io/Rollout": {
+			Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+				ActionDiscoveryLua: `return {pause = {}, resume = {}, restart = {disabled = true}}`,
+				Definitions: []appv1.ResourceActionDefinition{
+					{Name: "pause", ActionLua: "return obj", When: "return obj.spec.paused ~= true"},
+					{Name: "resume", ActionLua: "return obj", When: "return obj.spec.paused == true"},
+					{Name: "restart", ActionLua: "return obj", When: "return true"},
+				},
+			})),
+		},
+	}
+	discover := func(t *testing.T, vm VM, obj *unstructured.Unstructured) map[string]bool {
+		t.Helper()
+		discoveryLua, err := vm.GetResourceActionDiscovery(obj)
+		require.NoError(t, err)
+		actions, err := vm.ExecuteResourceActionDiscovery(obj, discoveryLua)
+		require.NoError(t, err)
+		disabled := make(map[string]bool)
+		for _, action := range actions {
+			disabled[action.Name] = action.Disabled
+		}
+		return disabled
+	}
+
+	t.Run("Running", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides}
+		assert.Equal(t, map[string]bool{"pause": false, "resume": true, "restart": true}, discover(t, vm, StrToUnstructured(pausableRolloutYaml)))
+	})
+	t.Run("Paused", func(t *testing.T) {
+		vm := VM{ResourceOverrides: overrides, DiscoveryCache: NewDiscoveryCache(10)}
+		obj := StrToUnstructured(pausableRolloutYaml)
+		require.NoError(t, unstructured.SetNestedField(obj.Object, true, "spec", "paused"))
+		for range 2 {
+			assert.Equal(t, map[string]bool{"pause": true, "resume": false, "restart": true}, discover(t, vm, obj), "the cached actions must not be modified")
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+			"argoproj.io/Rollout": {
+				Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+					ActionDiscoveryLua: `return {pause = {}}`,
+					Definitions:        []appv1.ResourceActionDefinition{{Name: "pause", ActionLua: "return obj", When: `return "yes"`}},
+				})),
+			},
+		}}
+		obj := StrToUnstructured(pausableRolloutYaml)
+		discoveryLua, err := vm.GetResourceActionDiscovery(obj)
+		require.NoError(t, err)
+		_, err = vm.ExecuteResourceActionDiscovery(obj, discoveryLua)
+		require.EqualError(t, err, `error evaluating when predicate of action "pause": expect boolean output from Lua script, not string`)
+	})
+}
+
+func TestExecuteResourceActionDiscoveryContext(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	discoveryLua := []string{`
+while true do end
+return {}
+`}
+
+	t.Run("Canceled", func(t *testing.T) {
+		vm := VM{Timeout: time.Minute}
+		ctx, cancel := context.WithCancel(t.Context())
+		time.AfterFunc(50*time.Millisecond, cancel)
+		start := time.Now()
+		_, err := vm.ExecuteResourceActionDiscoveryContext(ctx, testObj, discoveryLua)
+		require.ErrorContains(t, err, context.Canceled.Error())
+		assert.Less(t, time.Since(start), time.Second)
+	})
+	t.Run("Deadline", func(t *testing.T) {
+		vm := VM{Timeout: time.Minute}
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		_, err := vm.ExecuteResourceActionDiscoveryContext(ctx, testObj, discoveryLua)
+		require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+	})
+	t.Run("ScriptTimeout", func(t *testing.T) {
+		vm := VM{Timeout: 50 * time.Millisecond}
+		start := time.Now()
+		_, err := vm.ExecuteResourceActionDiscoveryContext(t.Context(), testObj, discoveryLua)
+		require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestExecuteResourceActionKubeVersion(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	script := `
+if kubeVersion == nil then
+  obj.metadata.labels["autoscaling"] = "unknown"
+elseif kubeVersion.major > 1 or kubeVersion.minor >= 23 then
+  obj.metadata.labels["autoscaling"] = "v2"
+else
+  obj.metadata.labels["autoscaling"] = "v2beta2"
+end
+return obj
+`
+	for _, tc := range []struct {
+		name     string
+		version  *version.Info
+		expected string
+	}{
+		{name: "Absent", expected: "unknown"},
+		{name: "Recent", version: &version.Info{Major: "1", Minor: "28", GitVersion: "v1.28.3"}, expected: "v2"},
+		{name: "SuffixedMinor", version: &version.Info{Major: "1", Minor: "22+", GitVersion: "v1.22.17-gke.1"}, expected: "v2beta2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			vm := VM{KubeVersion: tc.version}
+			impactedResources, err := vm.ExecuteResourceAction(testObj, script, nil)
+			require.NoError(t, err)
+			require.Len(t, impactedResources, 1)
+			assert.Equal(t, tc.expected, impactedResources[0].UnstructuredObj.GetLabels()["autoscaling"])
+		})
+	}
+}
+
+func TestExecuteResourceActionPreviousObject(t *testing.T) {
+	deployment := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:%s
+`
+	testObj := StrToUnstructured(fmt.Sprintf(deployment, "v2"))
+	// Restarts the deployment only if its image changed since the previous state
+	script := `
+local restart = "unknown"
+if previousObject ~= nil then
+  restart = tostring(obj.spec.template.spec.containers[1].image ~= previousObject.spec.template.spec.containers[1].image)
+end
+obj.metadata.annotations = {["example.com/restart"] = restart}
+return obj
+`
+	for _, tc := range []struct {
+		name     string
+		previous *unstructured.Unstructured
+		expected string
+	}{
+		{name: "Absent", expected: "unknown"},
+		{name: "Unchanged", previous: StrToUnstructured(fmt.Sprintf(deployment, "v2")), expected: "false"},
+		{name: "Changed", previous: StrToUnstructured(fmt.Sprintf(deployment, "v1")), expected: "true"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			vm := VM{PreviousObject: tc.previous}
+			impactedResources, err := vm.ExecuteResourceAction(testObj, script, nil)
+			require.NoError(t, err)
+			require.Len(t, impactedResources, 1)
+			assert.Equal(t, tc.expected, impactedResources[0].UnstructuredObj.GetAnnotations()["example.com/restart"])
+		})
+	}
+
+	t.Run("CUE", func(t *testing.T) {
+		vm := VM{PreviousObject: StrToUnstructured(fmt.Sprintf(deployment, "v1"))}
+		result, err := vm.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "test", ActionCUE: `
+patch: metadata: annotations: "example.com/previous-image": previousObject.spec.template.spec.containers[0].image
+`}, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		assert.Equal(t, "guestbook:v1", result.ImpactedResources[0].UnstructuredObj.GetAnnotations()["example.com/previous-image"])
+	})
+}
+
+func TestExecuteResourceActionMergeMetadata(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	existingLabels := testObj.GetLabels()
+	require.NotEmpty(t, existingLabels)
+
+	t.Run("Merge", func(t *testing.T) {
+		impactedResources, err := VM{}.ExecuteResourceAction(testObj, `
+return {
+  mergeLabels = {["team"] = "payments"},
+  mergeAnnotations = {["argocd.argoproj.io/refreshed-by"] = "action"}
+}
+`, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, PatchOperation, impactedResources[0].K8SOperation)
+		result := impactedResources[0].UnstructuredObj
+		for key, value := range existingLabels {
+			assert.Equal(t, value, result.GetLabels()[key])
+		}
+		assert.Equal(t, "payments", result.GetLabels()["team"])
+		assert.Equal(t, "action", result.GetAnnotations()["argocd.argoproj.io/refreshed-by"])
+		assert.Equal(t, testObj.Object["spec"], result.Object["spec"])
+		assert.Equal(t, existingLabels, testObj.GetLabels(), "the source resource must not be modified")
+	})
+	t.Run("Overwrite", func(t *testing.T) {
+		var key string
+		for key = range existingLabels {
+			break
+		}
+		impactedResources, err := VM{}.ExecuteResourceAction(testObj, `return {mergeLabels = {[actionParams["key"]] = "overwritten"}}`, NewParams().Set("key", key).Build())
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, "overwritten", impactedResources[0].UnstructuredObj.GetLabels()[key])
+		assert.Len(t, impactedResources[0].UnstructuredObj.GetLabels(), len(existingLabels))
+	})
+	t.Run("NoExistingAnnotations", func(t *testing.T) {
+		obj := testObj.DeepCopy()
+		obj.SetAnnotations(nil)
+		impactedResources, err := VM{}.ExecuteResourceAction(obj, `return {mergeAnnotations = {["a"] = "b"}}`, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, map[string]string{"a": "b"}, impactedResources[0].UnstructuredObj.GetAnnotations())
+	})
+	t.Run("InvalidValue", func(t *testing.T) {
+		_, err := VM{}.ExecuteResourceAction(testObj, `return {mergeLabels = {["replicas"] = 3}}`, nil)
+		require.ErrorContains(t, err, "mergeLabels must be a table of strings")
+	})
+}
+
+func TestExecuteResourceActionDoesNotShareSourceObject(t *testing.T) {
+	sourceObj := StrToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+  namespace: default
+  labels:
+    app: test
+spec:
+  selector: {}
+  template:
+    metadata:
+      annotations: {}
+`)
+	original := sourceObj.DeepCopy()
+
+	impactedResources, err := VM{}.ExecuteResourceAction(sourceObj, `
+obj.metadata.labels["app"] = "modified"
+return obj
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 1)
+	assert.Equal(t, original, sourceObj)
+
+	// The empty maps of the source are restored in the result, which must not share them
+	result := impactedResources[0].UnstructuredObj
+	require.NoError(t, unstructured.SetNestedField(result.Object, "modified", "spec", "selector", "app"))
+	require.NoError(t, unstructured.SetNestedField(result.Object, "modified", "spec", "template", "metadata", "annotations", "app"))
+	assert.Equal(t, original, sourceObj)
+}
+
+func TestExecuteResourceActionContextVisibleParameters(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	overrides := map[string]appv1.ResourceOverride{
+		"argoproj.io/Rollout": {
+			Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+				ActionDiscoveryLua: `
+actions = {}
+actions["scale"] = {
+  ["params"] = {
+    {["name"] = "mode", ["default"] = "now"},
+    {["name"] = "replicas", ["type"] = "number", ["required"] = true},
+    {["name"] = "at", ["required"] = true, ["visibleWhen"] = {["param"] = "mode", ["equals"] = "scheduled"}}
+  }
+}
+return actions
+`,
+				Definitions: []appv1.ResourceActionDefinition{{Name: "scale", ActionLua: `
+obj.metadata.labels["replicas"] = actionParams["replicas"]
+obj.metadata.labels["at"] = actionParams["at"] or "unset"
+return obj
+`}},
+			})),
+		},
+	}
+	vm := VM{ResourceOverrides: overrides}
+
+	t.Run("Discovery", func(t *testing.T) {
+		discovered, err := vm.DiscoverResourceAction(t.Context(), testObj, "scale")
+		require.NoError(t, err)
+		require.NotNil(t, discovered)
+		require.Len(t, discovered.Params, 3)
+		assert.Equal(t, appv1.ResourceActionParam{Name: "mode", Default: "now"}, discovered.Params[0])
+		assert.Equal(t, appv1.ResourceActionParam{Name: "replicas", Type: "number", Required: true}, discovered.Params[1])
+		assert.Equal(t, appv1.ResourceActionParam{
+			Name:        "at",
+			Required:    true,
+			VisibleWhen: &appv1.ResourceActionParamCondition{Param: "mode", Equals: "scheduled"},
+		}, discovered.Params[2])
+	})
+
+	action, err := vm.GetResourceAction(testObj, "scale")
+	require.NoError(t, err)
+	run := func(t *testing.T, params []*ResourceActionParameters) (map[string]string, error) {
+		t.Helper()
+		result, err := vm.ExecuteResourceActionContext(t.Context(), testObj, action, params)
+		if err != nil {
+			return nil, err
+		}
+		require.Len(t, result.ImpactedResources, 1)
+		return result.ImpactedResources[0].UnstructuredObj.GetLabels(), nil
+	}
+
+	t.Run("HiddenRequiredParameter", func(t *testing.T) {
+		labels, err := run(t, NewParams().Set("replicas", "3").Build())
+		require.NoError(t, err)
+		assert.Equal(t, "3", labels["replicas"])
+		assert.Equal(t, "unset", labels["at"])
+	})
+	t.Run("HiddenParameterIgnored", func(t *testing.T) {
+		labels, err := run(t, NewParams().Set("replicas", "3").Set("at", "10:00").Build())
+		require.NoError(t, err)
+		assert.Equal(t, "unset", labels["at"])
+	})
+	t.Run("VisibleParameter", func(t *testing.T) {
+		labels, err := run(t, NewParams().Set("replicas", "3").Set("mode", "scheduled").Set("at", "10:00").Build())
+		require.NoError(t, err)
+		assert.Equal(t, "10:00", labels["at"])
+	})
+	t.Run("VisibleRequiredParameterMissing", func(t *testing.T) {
+		_, err := run(t, NewParams().Set("replicas", "3").Set("mode", "scheduled").Build())
+		require.EqualError(t, err, `parameter "at" of action "scale" is required`)
+	})
+	t.Run("RequiredParameterMissing", func(t *testing.T) {
+		_, err := run(t, nil)
+		require.EqualError(t, err, `parameter "replicas" of action "scale" is required`)
+	})
+}
+
+func TestExecuteResourceActionConfig(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	script := `
+obj.metadata.labels["registry"] = actionConfig["registry"] or "unset"
+return obj
+`
+	t.Run("Set", func(t *testing.T) {
+		vm := VM{ActionConfig: map[string]string{"registry": "registry.example.com"}}
+		impactedResources, err := vm.ExecuteResourceAction(testObj, script, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, "registry.example.com", impactedResources[0].UnstructuredObj.GetLabels()["registry"])
+	})
+	t.Run("Missing", func(t *testing.T) {
+		impactedResources, err := VM{}.ExecuteResourceAction(testObj, script, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, "unset", impactedResources[0].UnstructuredObj.GetLabels()["registry"])
+	})
+}
+
+func TestResolveParamDefaults(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	require.NoError(t, unstructured.SetNestedField(testObj.Object, int64(4), "spec", "replicas"))
+	action := appv1.ResourceAction{Name: "scale", Params: []appv1.ResourceActionParam{
+		{Name: "replicas", DefaultFrom: "{.spec.replicas}"},
+		{Name: "name", DefaultFrom: ".metadata.name"},
+		{Name: "labels", DefaultFrom: "{.metadata.labels}"},
+		{Name: "missing", DefaultFrom: "{.spec.missing}", Default: "fallback"},
+		{Name: "static", Default: "static"},
+	}}
+	require.NoError(t, resolveParamDefaults(testObj, &action))
+	assert.Equal(t, "4", action.Params[0].Default)
+	assert.Equal(t, testObj.GetName(), action.Params[1].Default)
+	labels, err := json.Marshal(testObj.GetLabels())
+	require.NoError(t, err)
+	assert.JSONEq(t, string(labels), action.Params[2].Default)
+	assert.Equal(t, "fallback", action.Params[3].Default)
+	assert.Equal(t, "static", action.Params[4].Default)
+
+	t.Run("Discovery", func(t *testing.T) {
+		actions, err := os.ReadFile("testdata/default-from-action.yaml")
+		require.NoError(t, err)
+		vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+			"apps/Deployment": {Actions: string(actions)},
+		}}
+		deployment := StrToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 3
+`)
+		discovered, err := vm.DiscoverResourceAction(t.Context(), deployment, "scale")
+		require.NoError(t, err)
+		require.NotNil(t, discovered)
+		assert.Equal(t, []appv1.ResourceActionParam{{Name: "replicas", Type: "number", DefaultFrom: "{.spec.replicas}", Default: "3"}}, discovered.Params)
+
+		unstructured.RemoveNestedField(deployment.Object, "spec", "replicas")
+		discovered, err = vm.DiscoverResourceAction(t.Context(), deployment, "scale")
+		require.NoError(t, err)
+		require.NotNil(t, discovered)
+		assert.Equal(t, "1", discovered.Params[0].Default)
+	})
+	t.Run("InvalidExpression", func(t *testing.T) {
+		action := appv1.ResourceAction{Name: "scale", Params: []appv1.ResourceActionParam{{Name: "replicas", DefaultFrom: "{.spec[}"}}}
+		require.ErrorContains(t, resolveParamDefaults(testObj, &action), `error parsing defaultFrom of parameter "replicas" of action "scale"`)
+	})
+}
+
+func TestExecuteResourceActionContextParameterValues(t *testing.T) {
+	actions, err := os.ReadFile("testdata/suggestions-action.yaml")
+	require.NoError(t, err)
+	vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+		"apps/Deployment": {Actions: string(actions)},
+	}}
+	deployment := StrToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  template:
+    spec:
+      containers:
+      - name: guestbook
+      - name: sidecar
+`)
+
+	t.Run("Discovery", func(t *testing.T) {
+		discovered, err := vm.DiscoverResourceAction(t.Context(), deployment, "set-log-level")
+		require.NoError(t, err)
+		require.NotNil(t, discovered)
+		assert.Equal(t, []appv1.ResourceActionParam{
+			{Name: "container", Suggestions: []string{"guestbook", "sidecar"}},
+			{Name: "level", AllowedValues: []string{"debug", "info", "warn", "error"}, Default: "info"},
+		}, discovered.Params)
+	})
+
+	action, err := vm.GetResourceAction(deployment, "set-log-level")
+	require.NoError(t, err)
+	run := func(t *testing.T, params []*ResourceActionParameters) (string, error) {
+		t.Helper()
+		result, err := vm.ExecuteResourceActionContext(t.Context(), deployment, action, params)
+		if err != nil {
+			return "", err
+		}
+		require.Len(t, result.ImpactedResources, 1)
+		return result.ImpactedResources[0].UnstructuredObj.GetAnnotations()["example.com/log-level"], nil
+	}
+
+	t.Run("SuggestedValue", func(t *testing.T) {
+		logLevel, err := run(t, NewParams().Set("container", "sidecar").Set("level", "debug").Build())
+		require.NoError(t, err)
+		assert.Equal(t, "sidecar=debug", logLevel)
+	})
+	t.Run("UnsuggestedValue", func(t *testing.T) {
+		logLevel, err := run(t, NewParams().Set("container", "init").Build())
+		require.NoError(t, err)
+		assert.Equal(t, "init=info", logLevel)
+	})
+	t.Run("DisallowedValue", func(t *testing.T) {
+		_, err := run(t, NewParams().Set("level", "trace").Build())
+		require.EqualError(t, err, `invalid value "trace" of parameter "level" of action "set-log-level", allowed values are: debug, info, warn, error`)
+	})
+}
+
+func TestGetResourceActionRequiredLibraries(t *testing.T) {
+	dir := t.TempDir()
+	writeScript(t, dir, "argoproj.io/Rollout/actions/test/action.lua", `return obj`)
+	writeScript(t, dir, "argoproj.io/Rollout/actions/test/manifest.yaml", "requires: [re, yaml]\n")
+	loader, err := NewDirScriptLoader(dir)
+	require.NoError(t, err)
+	testObj := StrToUnstructured(objJSON)
+
+	t.Run("AllLibrariesEnabled", func(t *testing.T) {
+		vm := VM{ScriptLoader: loader}
+		action, err := vm.GetResourceAction(testObj, "test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"re", "yaml"}, action.Requires)
+	})
+	t.Run("RequiredLibraryEnabled", func(t *testing.T) {
+		vm := VM{ScriptLoader: loader, Libraries: []string{"os", "re", "yaml"}}
+		_, err := vm.GetResourceAction(testObj, "test")
+		require.NoError(t, err)
+	})
+	t.Run("MissingLibrary", func(t *testing.T) {
+		vm := VM{ScriptLoader: loader, Libraries: []string{"os", "re"}}
+		_, err := vm.GetResourceAction(testObj, "test")
+		require.EqualError(t, err, `action "test" requires the "yaml" library, which is not available`)
+	})
+	t.Run("UnknownLibrary", func(t *testing.T) {
+		vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+			"argoproj.io/Rollout": {
+				Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+					Definitions: []appv1.ResourceActionDefinition{{Name: "test", ActionLua: `return obj`, Requires: []string{"json"}}},
+				})),
+			},
+		}}
+		_, err := vm.GetResourceAction(testObj, "test")
+		require.EqualError(t, err, `action "test" requires the "json" library, which is not available`)
+	})
+	t.Run("InvalidManifest", func(t *testing.T) {
+		dir := t.TempDir()
+		writeScript(t, dir, "argoproj.io/Rollout/actions/test/action.lua", `return obj`)
+		writeScript(t, dir, "argoproj.io/Rollout/actions/test/manifest.yaml", "require: [re]\n")
+		loader, err := NewDirScriptLoader(dir)
+		require.NoError(t, err)
+		vm := VM{ScriptLoader: loader}
+		_, err = vm.GetResourceAction(testObj, "test")
+		require.ErrorContains(t, err, `error parsing manifest.yaml of action "test"`)
+	})
+}
+
+func TestDisabledLibrariesAreNotOpened(t *testing.T) {
+	vm := VM{Libraries: []string{"os"}}
+	_, err := vm.ExecuteResourceAction(StrToUnstructured(objJSON), `
+local re = require("re")
+return obj
+`, nil)
+	require.ErrorContains(t, err, `module re not found`)
+}
@@ -0,0 +1,130 @@
+package lua
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PatchType is the way the resource returned by a patch action is applied to the source resource.
+type PatchType string
+
+// MergePatchType applies the returned resource to the source resource as a JSON merge patch (RFC 7386), in which the
+// fields set to null are removed from the source resource. Since Lua tables cannot hold nil values, the scripts set
+// such fields to the null global.
+const MergePatchType PatchType = "merge"
+
+// NullGlobal is the name of the global holding the value which the scripts set the fields of merge patches to in
+// order to remove them.
+const NullGlobal = "null"
+
+// luaNull is the value of the null userdata of the scripts.
+type luaNull struct{}
+
+// nullMarker replaces the null userdata in the output of the scripts, since the JSON encoder of the Lua values cannot
+// encode userdata.
+const nullMarker = "\x00argocd:null\x00"
+
+func newNullValue(l *lua.LState) lua.LValue {
+	ud := l.NewUserData()
+	ud.Value = luaNull{}
+	return ud
+}
+
+// replaceNulls replaces the null userdata of the table and of its nested tables with nullMarker.
+func replaceNulls(value lua.LValue, visited map[*lua.LTable]bool) {
+	table, ok := value.(*lua.LTable)
+	if !ok || visited[table] {
+		return
+	}
+	visited[table] = true
+	var nullKeys []lua.LValue
+	table.ForEach(func(key, value lua.LValue) {
+		if ud, ok := value.(*lua.LUserData); ok {
+			if _, isNull := ud.Value.(luaNull); isNull {
+				nullKeys = append(nullKeys, key)
+			}
+			return
+		}
+		replaceNulls(value, visited)
+	})
+	for _, key := range nullKeys {
+		table.RawSet(key, lua.LString(nullMarker))
+	}
+}
+
+// restoreNulls replaces the nullMarker values of the decoded output of a script with nil, and returns whether it
+// replaced any.
+func restoreNulls(value any) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		if v == nullMarker {
+			return nil, true
+		}
+	case map[string]any:
+		restored := false
+		for key, item := range v {
+			var ok bool
+			v[key], ok = restoreNulls(item)
+			restored = restored || ok
+		}
+		return v, restored
+	case []any:
+		restored := false
+		for i, item := range v {
+			var ok bool
+			v[i], ok = restoreNulls(item)
+			restored = restored || ok
+		}
+		return v, restored
+	}
+	return value, false
+}
+
+// applyPatchTypes applies the merge patches among the impacted resources to the source resource, so that the impacted
+// resources hold the patched resources like those of the other patches. It returns an error if null values are used
+// outside of merge patches.
+func applyPatchTypes(source *unstructured.Unstructured, impactedResources []ImpactedResource) error {
+	for i := range impactedResources {
+		impactedResource := &impactedResources[i]
+		_, hasNulls := restoreNulls(impactedResource.UnstructuredObj.Object)
+		switch impactedResource.PatchType {
+		case "":
+			if hasNulls {
+				return fmt.Errorf("resource %d sets null values, which are only supported by %q patches", i, MergePatchType)
+			}
+			continue
+		case MergePatchType:
+		default:
+			return fmt.Errorf("unsupported patch type: %s", impactedResource.PatchType)
+		}
+		if impactedResource.K8SOperation != PatchOperation {
+			return fmt.Errorf("resource %d has patch type %q but is not patched", i, impactedResource.PatchType)
+		}
+		if len(impactedResource.ListMergeKeys) > 0 {
+			return errors.New("listMergeKeys is not supported by merge patches")
+		}
+		sourceBytes, err := json.Marshal(source.Object)
+		if err != nil {
+			return err
+		}
+		patchBytes, err := json.Marshal(impactedResource.UnstructuredObj.Object)
+		if err != nil {
+			return err
+		}
+		patchedBytes, err := jsonpatch.MergePatch(sourceBytes, patchBytes)
+		if err != nil {
+			return fmt.Errorf("error applying merge patch of resource %d: %w", i, err)
+		}
+		patched := &unstructured.Unstructured{}
+		if err := patched.UnmarshalJSON(patchedBytes); err != nil {
+			return fmt.Errorf("error applying merge patch of resource %d: %w", i, err)
+		}
+		impactedResource.UnstructuredObj = patched
+	}
+	return nil
+}
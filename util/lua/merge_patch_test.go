@@ -0,0 +1,60 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExecuteResourceActionMergePatch(t *testing.T) {
+	rollout := StrToUnstructured(objJSON)
+	rollout.SetAnnotations(map[string]string{"example.com/owner": "team-a", "example.com/paused-by": "ops"})
+	vm := VM{}
+
+	t.Run("NullRemovesField", func(t *testing.T) {
+		impactedResources, err := vm.ExecuteResourceAction(rollout, `
+obj.metadata.annotations["example.com/paused-by"] = null
+obj.metadata.labels = null
+return {{operation = "patch", patchType = "merge", resource = obj}}
+`, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		patched := impactedResources[0].UnstructuredObj
+		assert.Equal(t, map[string]string{"example.com/owner": "team-a"}, patched.GetAnnotations())
+		_, found, err := unstructured.NestedFieldNoCopy(patched.Object, "metadata", "labels")
+		require.NoError(t, err)
+		assert.False(t, found)
+		assert.Equal(t, rollout.GetName(), patched.GetName())
+	})
+	t.Run("PartialPatch", func(t *testing.T) {
+		impactedResources, err := vm.ExecuteResourceAction(rollout, `
+local patch = {apiVersion = obj.apiVersion, kind = obj.kind, metadata = {labels = {tier = "frontend"}}}
+return {{operation = "patch", patchType = "merge", resource = patch}}
+`, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		patched := impactedResources[0].UnstructuredObj
+		assert.Equal(t, "frontend", patched.GetLabels()["tier"])
+		assert.Equal(t, rollout.Object["spec"], patched.Object["spec"], "the fields missing from the patch must be kept")
+	})
+	t.Run("NullOutsideMergePatch", func(t *testing.T) {
+		_, err := vm.ExecuteResourceAction(rollout, `
+obj.metadata.annotations["example.com/paused-by"] = null
+return obj
+`, nil)
+		require.EqualError(t, err, `resource 0 sets null values, which are only supported by "merge" patches`)
+	})
+	t.Run("UnsupportedPatchType", func(t *testing.T) {
+		_, err := vm.ExecuteResourceAction(rollout, `return {{operation = "patch", patchType = "strategic", resource = obj}}`, nil)
+		require.EqualError(t, err, "unsupported patch type: strategic")
+	})
+	t.Run("CreateWithPatchType", func(t *testing.T) {
+		_, err := vm.ExecuteResourceAction(rollout, `
+local configMap = {apiVersion = "v1", kind = "ConfigMap", metadata = {name = "test", namespace = "default"}}
+return {{operation = "create", patchType = "merge", resource = configMap}}
+`, nil)
+		require.EqualError(t, err, `resource 0 has patch type "merge" but is not patched`)
+	})
+}
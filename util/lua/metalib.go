@@ -0,0 +1,54 @@
+package lua
+
+// metalib reads the metadata of the resources in the Lua scripts, without raising errors if the metadata is absent.
+
+import (
+	lua "github.com/yuin/gopher-lua"
+)
+
+// MetaLibName is the name of the metadata library.
+const MetaLibName = "meta"
+
+func OpenMeta(l *lua.LState) int {
+	mod := l.RegisterModule(MetaLibName, metaFuncs)
+	l.Push(mod)
+	return 1
+}
+
+func MetaLoader(l *lua.LState) int {
+	mod := l.SetFuncs(l.NewTable(), metaFuncs)
+	l.Push(mod)
+	return 1
+}
+
+var metaFuncs = map[string]lua.LGFunction{
+	"annotation": metaAnnotation,
+	"label":      metaLabel,
+}
+
+// metaAnnotation returns the annotation of the resource with the given key, or nil if the resource, its metadata or
+// its annotations are absent.
+func metaAnnotation(l *lua.LState) int {
+	l.Push(metadataMapValue(l.Get(1), "annotations", l.CheckString(2)))
+	return 1
+}
+
+// metaLabel returns the label of the resource with the given key, or nil if the resource, its metadata or its labels
+// are absent.
+func metaLabel(l *lua.LState) int {
+	l.Push(metadataMapValue(l.Get(1), "labels", l.CheckString(2)))
+	return 1
+}
+
+func metadataMapValue(obj lua.LValue, field, key string) lua.LValue {
+	path := []string{"metadata", field, key}
+	value := obj
+	for _, name := range path {
+		table, ok := value.(*lua.LTable)
+		if !ok {
+			return lua.LNil
+		}
+		value = table.RawGetString(name)
+	}
+	return value
+}
@@ -0,0 +1,54 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMetaLib(t *testing.T) {
+	vm := VM{}
+	run := func(t *testing.T, obj *unstructured.Unstructured, script string) *lua.LState {
+		t.Helper()
+		l, err := vm.runLua(obj, script, nil)
+		require.NoError(t, err)
+		return l
+	}
+	withMetadata := func(metadata map[string]any) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "metadata": metadata}}
+	}
+	obj := withMetadata(map[string]any{
+		"name":        "test",
+		"annotations": map[string]any{"argocd.argoproj.io/sync-wave": "1"},
+		"labels":      map[string]any{"app": "guestbook"},
+	})
+
+	t.Run("Present", func(t *testing.T) {
+		l := run(t, obj, `return meta.annotation(obj, "argocd.argoproj.io/sync-wave"), meta.label(obj, "app")`)
+		assert.Equal(t, lua.LString("1"), l.Get(-2))
+		assert.Equal(t, lua.LString("guestbook"), l.Get(-1))
+	})
+	t.Run("Absent", func(t *testing.T) {
+		l := run(t, obj, `return meta.annotation(obj, "missing"), meta.label(obj, "missing")`)
+		assert.Equal(t, lua.LNil, l.Get(-2))
+		assert.Equal(t, lua.LNil, l.Get(-1))
+	})
+	t.Run("NilMap", func(t *testing.T) {
+		l := run(t, withMetadata(map[string]any{"name": "test"}), `return meta.annotation(obj, "missing"), meta.label(obj, "missing")`)
+		assert.Equal(t, lua.LNil, l.Get(-2))
+		assert.Equal(t, lua.LNil, l.Get(-1))
+	})
+	t.Run("NilObject", func(t *testing.T) {
+		l := run(t, obj, `return meta.annotation(nil, "missing"), meta.label(obj.spec, "missing")`)
+		assert.Equal(t, lua.LNil, l.Get(-2))
+		assert.Equal(t, lua.LNil, l.Get(-1))
+	})
+	t.Run("Require", func(t *testing.T) {
+		l := run(t, obj, `local m = require("meta")
+return m.label(obj, "app")`)
+		assert.Equal(t, lua.LString("guestbook"), l.Get(-1))
+	})
+}
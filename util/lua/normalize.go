@@ -0,0 +1,143 @@
+package lua
+
+import (
+	"math"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// VolatilePath is the path of a field which actions set to the current time or to a random value, in the resources of
+// a kind, or of all the kinds if Kind is empty.
+type VolatilePath struct {
+	Kind string
+	Path []string
+}
+
+// volatileRegistry holds the annotations and fields which the actions set to the current time or to a random value.
+// It holds those of the built-in actions, and callers register those of other actions.
+var volatileRegistry = struct {
+	lock        sync.RWMutex
+	annotations []string
+	paths       []VolatilePath
+}{
+	annotations: []string{
+		"kubectl.kubernetes.io/restartedAt",
+		"reconcile.fluxcd.io/requestedAt",
+		"force-sync",
+		"workflows.argoproj.io/scheduled-time",
+	},
+	paths: []VolatilePath{
+		{Kind: "Rollout", Path: []string{"spec", "restartAt"}},
+	},
+}
+
+// RegisterVolatileAnnotations registers annotations which actions set to the current time or to a random value, so
+// that NormalizeActionOutput zeroes them.
+func RegisterVolatileAnnotations(annotations ...string) {
+	volatileRegistry.lock.Lock()
+	defer volatileRegistry.lock.Unlock()
+	for _, annotation := range annotations {
+		if !slices.Contains(volatileRegistry.annotations, annotation) {
+			volatileRegistry.annotations = append(volatileRegistry.annotations, annotation)
+		}
+	}
+}
+
+// RegisterVolatilePaths registers fields which actions set to the current time or to a random value, so that
+// NormalizeActionOutput removes them.
+func RegisterVolatilePaths(paths ...VolatilePath) {
+	volatileRegistry.lock.Lock()
+	defer volatileRegistry.lock.Unlock()
+	volatileRegistry.paths = append(volatileRegistry.paths, paths...)
+}
+
+// VolatileAnnotations returns the registered volatile annotations.
+func VolatileAnnotations() []string {
+	volatileRegistry.lock.RLock()
+	defer volatileRegistry.lock.RUnlock()
+	return slices.Clone(volatileRegistry.annotations)
+}
+
+// VolatilePaths returns the registered volatile fields.
+func VolatilePaths() []VolatilePath {
+	volatileRegistry.lock.RLock()
+	defer volatileRegistry.lock.RUnlock()
+	return slices.Clone(volatileRegistry.paths)
+}
+
+// serverManagedMetadata are the metadata fields set by the API server, which the resources produced by the actions
+// only carry over from their source.
+var serverManagedMetadata = []string{"creationTimestamp", "generation", "resourceVersion", "uid", "managedFields"}
+
+// ActionOutputNormalizer scrubs the resources produced by actions of what differs between runs, so that they can be
+// compared with golden files. It implements the Normalizer of the gitops-engine diff package.
+type ActionOutputNormalizer struct {
+	// VolatileAnnotations are the annotations to zero, in the metadata of the resource and of its nested templates.
+	// The registered VolatileAnnotations are zeroed if it is nil.
+	VolatileAnnotations []string
+	// VolatilePaths are the fields to remove. The registered VolatilePaths are removed if it is nil.
+	VolatilePaths []VolatilePath
+}
+
+// NormalizeActionOutput normalizes the resource with an ActionOutputNormalizer using the registered volatile
+// annotations and fields.
+func NormalizeActionOutput(obj *unstructured.Unstructured) error {
+	return ActionOutputNormalizer{}.Normalize(obj)
+}
+
+// Normalize removes the server managed metadata and the volatile fields of the resource, zeroes its volatile
+// annotations and turns the integral float64 numbers, which the integers become in Lua, back into int64 numbers.
+func (n ActionOutputNormalizer) Normalize(obj *unstructured.Unstructured) error {
+	if obj == nil {
+		return nil
+	}
+	for _, field := range serverManagedMetadata {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	volatilePaths := n.VolatilePaths
+	if volatilePaths == nil {
+		volatilePaths = VolatilePaths()
+	}
+	for _, volatilePath := range volatilePaths {
+		if volatilePath.Kind == "" || volatilePath.Kind == obj.GetKind() {
+			unstructured.RemoveNestedField(obj.Object, volatilePath.Path...)
+		}
+	}
+	volatileAnnotations := n.VolatileAnnotations
+	if volatileAnnotations == nil {
+		volatileAnnotations = VolatileAnnotations()
+	}
+	obj.Object = normalizeValue(obj.Object, volatileAnnotations).(map[string]any)
+	return nil
+}
+
+func normalizeValue(value any, volatileAnnotations []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeValue(item, volatileAnnotations)
+		}
+		if metadata, ok := v["metadata"].(map[string]any); ok {
+			if annotations, ok := metadata["annotations"].(map[string]any); ok {
+				for key := range annotations {
+					if slices.Contains(volatileAnnotations, key) {
+						annotations[key] = ""
+					}
+				}
+			}
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeValue(item, volatileAnnotations)
+		}
+		return v
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return int64(v)
+		}
+	}
+	return value
+}
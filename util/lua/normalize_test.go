@@ -0,0 +1,97 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const restartedDeploymentYaml = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  creationTimestamp: "2024-01-01T00:00:00Z"
+  generation: 3
+  resourceVersion: "123"
+  uid: 6a5d3c6e-0b4e-4c52-9c1f-2f7b0b6d3e4a
+  annotations:
+    example.com/owner: team-a
+spec:
+  replicas: 2
+  template:
+    metadata:
+      annotations:
+        kubectl.kubernetes.io/restartedAt: "2024-06-01T12:00:00Z"
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:v1
+`
+
+const reconciledKustomizationYaml = `
+apiVersion: kustomize.toolkit.fluxcd.io/v1
+kind: Kustomization
+metadata:
+  name: podinfo
+  namespace: flux-system
+  annotations:
+    reconcile.fluxcd.io/requestedAt: "By Argo CD at: 2024-06-01T12:00:00"
+spec:
+  interval: 10m
+`
+
+func TestNormalizeActionOutput(t *testing.T) {
+	t.Run("Deployment", func(t *testing.T) {
+		obj := StrToUnstructured(restartedDeploymentYaml)
+		// The integers of the resources produced by Lua actions are float64 numbers
+		require.NoError(t, unstructured.SetNestedField(obj.Object, float64(2), "spec", "replicas"))
+		require.NoError(t, NormalizeActionOutput(obj))
+
+		for _, field := range []string{"creationTimestamp", "generation", "resourceVersion", "uid"} {
+			_, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "metadata", field)
+			assert.False(t, found, field)
+		}
+		assert.Equal(t, map[string]string{"example.com/owner": "team-a"}, obj.GetAnnotations())
+		restartedAt, _, _ := unstructured.NestedString(obj.Object, "spec", "template", "metadata", "annotations", "kubectl.kubernetes.io/restartedAt")
+		assert.Empty(t, restartedAt)
+		replicas, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "replicas")
+		assert.Equal(t, int64(2), replicas)
+	})
+	t.Run("Flux", func(t *testing.T) {
+		obj := StrToUnstructured(reconciledKustomizationYaml)
+		other := obj.DeepCopy()
+		other.SetAnnotations(map[string]string{"reconcile.fluxcd.io/requestedAt": "By Argo CD at: 2024-06-02T08:30:00"})
+		require.NoError(t, NormalizeActionOutput(obj))
+		require.NoError(t, NormalizeActionOutput(other))
+		assert.Equal(t, map[string]string{"reconcile.fluxcd.io/requestedAt": ""}, obj.GetAnnotations())
+		assert.Equal(t, obj, other)
+	})
+	t.Run("VolatileAnnotations", func(t *testing.T) {
+		obj := StrToUnstructured(reconciledKustomizationYaml)
+		obj.SetAnnotations(map[string]string{"reconcile.fluxcd.io/requestedAt": "now", "example.com/run-id": "42"})
+		require.NoError(t, ActionOutputNormalizer{VolatileAnnotations: []string{"example.com/run-id"}}.Normalize(obj))
+		assert.Equal(t, map[string]string{"reconcile.fluxcd.io/requestedAt": "now", "example.com/run-id": ""}, obj.GetAnnotations())
+	})
+	t.Run("Registered", func(t *testing.T) {
+		RegisterVolatileAnnotations("example.com/triggered-at")
+		RegisterVolatilePaths(VolatilePath{Kind: "Kustomization", Path: []string{"spec", "triggeredAt"}})
+		assert.Contains(t, VolatileAnnotations(), "example.com/triggered-at")
+
+		obj := StrToUnstructured(reconciledKustomizationYaml)
+		obj.SetAnnotations(map[string]string{"example.com/triggered-at": "2024-06-01T12:00:00Z"})
+		require.NoError(t, unstructured.SetNestedField(obj.Object, "2024-06-01T12:00:00Z", "spec", "triggeredAt"))
+		other := obj.DeepCopy()
+		other.SetAnnotations(map[string]string{"example.com/triggered-at": "2024-06-02T08:30:00Z"})
+		require.NoError(t, unstructured.SetNestedField(other.Object, "2024-06-02T08:30:00Z", "spec", "triggeredAt"))
+
+		require.NoError(t, NormalizeActionOutput(obj))
+		require.NoError(t, NormalizeActionOutput(other))
+		assert.Equal(t, obj, other)
+		_, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "triggeredAt")
+		assert.False(t, found)
+	})
+}
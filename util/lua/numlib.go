@@ -0,0 +1,56 @@
+package lua
+
+// numlib converts the numbers of the Lua scripts, which are all float64 numbers, so that the integer fields of the
+// resources are not set to fractional values.
+
+import (
+	"fmt"
+	"math"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// NumLibName is the name of the number library.
+const NumLibName = "num"
+
+// maxExactInteger is the largest integer which float64 numbers, and thus the Lua numbers, hold exactly.
+const maxExactInteger = 1 << 53
+
+func OpenNum(l *lua.LState) int {
+	mod := l.RegisterModule(NumLibName, numFuncs)
+	l.Push(mod)
+	return 1
+}
+
+func NumLoader(l *lua.LState) int {
+	mod := l.SetFuncs(l.NewTable(), numFuncs)
+	l.Push(mod)
+	return 1
+}
+
+var numFuncs = map[string]lua.LGFunction{
+	"toInt": numToInt,
+}
+
+// numToInt returns the given number, or the number the given string holds, e.g. a parameter, if it is an integer, and
+// raises an error otherwise.
+func numToInt(l *lua.LState) int {
+	value, err := toInt(float64(l.CheckNumber(1)))
+	if err != nil {
+		l.RaiseError("%s", err.Error())
+	}
+	l.Push(lua.LNumber(value))
+	return 1
+}
+
+// toInt converts a Lua number to an integer. It returns an error if the number has a fractional part, or is too large
+// for float64 numbers to hold it exactly.
+func toInt(value float64) (int64, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value != math.Trunc(value) {
+		return 0, fmt.Errorf("%v is not an integer", value)
+	}
+	if math.Abs(value) > maxExactInteger {
+		return 0, fmt.Errorf("%v is too large to be an exact integer", value)
+	}
+	return int64(value), nil
+}
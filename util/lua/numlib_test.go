@@ -0,0 +1,69 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func TestNumLib(t *testing.T) {
+	vm := VM{}
+	run := func(t *testing.T, script string) (*lua.LState, error) {
+		t.Helper()
+		return vm.runLua(StrToUnstructured(objJSON), script, nil)
+	}
+
+	t.Run("Whole", func(t *testing.T) {
+		l, err := run(t, `return num.toInt(3), num.toInt(-2.0), num.toInt("42")`)
+		require.NoError(t, err)
+		assert.Equal(t, lua.LNumber(3), l.Get(-3))
+		assert.Equal(t, lua.LNumber(-2), l.Get(-2))
+		assert.Equal(t, lua.LNumber(42), l.Get(-1))
+	})
+	t.Run("Fractional", func(t *testing.T) {
+		_, err := run(t, `return num.toInt(2.5)`)
+		require.ErrorContains(t, err, "2.5 is not an integer")
+		_, err = run(t, `return num.toInt(0/0)`)
+		require.ErrorContains(t, err, "is not an integer")
+	})
+	t.Run("TooLarge", func(t *testing.T) {
+		_, err := run(t, `return num.toInt(2^60)`)
+		require.ErrorContains(t, err, "is too large to be an exact integer")
+	})
+	t.Run("NotANumber", func(t *testing.T) {
+		_, err := run(t, `return num.toInt("three")`)
+		require.ErrorContains(t, err, "number expected")
+	})
+	t.Run("Require", func(t *testing.T) {
+		l, err := run(t, `local n = require("num")
+return n.toInt(7)`)
+		require.NoError(t, err)
+		assert.Equal(t, lua.LNumber(7), l.Get(-1))
+	})
+}
+
+func TestExecuteResourceActionIntegerFields(t *testing.T) {
+	vm := VM{}
+	t.Run("Whole", func(t *testing.T) {
+		impactedResources, err := vm.ExecuteResourceAction(StrToUnstructured(pausableRolloutYaml), `
+obj.spec.replicas = 6 / 2
+return {{operation = "patch", resource = obj, integerFields = {"spec.replicas", "spec.missing"}}}`, nil)
+		require.NoError(t, err)
+		require.Len(t, impactedResources, 1)
+		assert.Equal(t, int64(3), impactedResources[0].UnstructuredObj.Object["spec"].(map[string]any)["replicas"])
+	})
+	t.Run("Fractional", func(t *testing.T) {
+		_, err := vm.ExecuteResourceAction(StrToUnstructured(pausableRolloutYaml), `
+obj.spec.replicas = 5 / 2
+return {{operation = "patch", resource = obj, integerFields = {"spec.replicas"}}}`, nil)
+		require.EqualError(t, err, `resource 0, integer field "spec.replicas": 2.5 is not an integer`)
+	})
+	t.Run("NotANumber", func(t *testing.T) {
+		_, err := vm.ExecuteResourceAction(StrToUnstructured(pausableRolloutYaml), `
+obj.spec.replicas = "two"
+return {{operation = "patch", resource = obj, integerFields = {"spec.replicas"}}}`, nil)
+		require.EqualError(t, err, `resource 0, integer field "spec.replicas" is a string, not a number`)
+	})
+}
@@ -0,0 +1,49 @@
+package lua
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// ResourceSchemaProvider provides the OpenAPI schemas of the kinds, e.g. those of the CRDs of a cluster, against which
+// the resources produced by the actions are validated.
+type ResourceSchemaProvider interface {
+	// ResourceSchema returns the schema of the kind, or nil if the kind has no known schema.
+	ResourceSchema(gvk schema.GroupVersionKind) (*spec.Schema, error)
+}
+
+// validateOutputSchemas returns an error listing the offending paths if a resource impacted by an action does not
+// match the schema of its kind. The resources are not validated if the VM has no ResourceSchemaProvider.
+func (vm VM) validateOutputSchemas(impactedResources []ImpactedResource) error {
+	if vm.SchemaProvider == nil {
+		return nil
+	}
+	for i, impactedResource := range impactedResources {
+		obj := impactedResource.UnstructuredObj
+		if obj == nil {
+			continue
+		}
+		resourceSchema, err := vm.SchemaProvider.ResourceSchema(obj.GroupVersionKind())
+		if err != nil {
+			return fmt.Errorf("error getting the schema of resource %d, %s %q: %w", i, obj.GetKind(), obj.GetName(), err)
+		}
+		if resourceSchema == nil {
+			continue
+		}
+		result := validate.NewSchemaValidator(resourceSchema, nil, "", strfmt.Default).Validate(obj.Object)
+		if result.IsValid() {
+			continue
+		}
+		messages := make([]string, 0, len(result.Errors))
+		for _, err := range result.Errors {
+			messages = append(messages, err.Error())
+		}
+		return fmt.Errorf("resource %d, %s %q does not match its schema: %s", i, obj.GetKind(), obj.GetName(), strings.Join(messages, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package lua
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeSchemaProvider map[schema.GroupVersionKind]*spec.Schema
+
+func (p fakeSchemaProvider) ResourceSchema(gvk schema.GroupVersionKind) (*spec.Schema, error) {
+	if gvk.Kind == "Broken" {
+		return nil, errors.New("schema unavailable")
+	}
+	return p[gvk], nil
+}
+
+const rolloutSchemaJSON = `{
+  "type": "object",
+  "properties": {
+    "spec": {
+      "type": "object",
+      "properties": {
+        "replicas": {"type": "integer"},
+        "paused": {"type": "boolean"}
+      }
+    }
+  }
+}`
+
+func TestExecuteResourceActionOutputSchema(t *testing.T) {
+	var rolloutSchema spec.Schema
+	require.NoError(t, json.Unmarshal([]byte(rolloutSchemaJSON), &rolloutSchema))
+	vm := VM{SchemaProvider: fakeSchemaProvider{
+		{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}: &rolloutSchema,
+	}}
+	testObj := StrToUnstructured(objJSON)
+
+	t.Run("Valid", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "scale", ActionLua: `obj.spec = {replicas = 3}
+return obj`}, nil)
+		require.NoError(t, err)
+	})
+	t.Run("WrongType", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "scale", ActionLua: `obj.spec = {replicas = "3", paused = "yes"}
+return obj`}, nil)
+		require.ErrorContains(t, err, `resource 0, Rollout "helm-guestbook" does not match its schema`)
+		assert.ErrorContains(t, err, "spec.replicas in body must be of type integer")
+		assert.ErrorContains(t, err, "spec.paused in body must be of type boolean")
+	})
+	t.Run("UnknownKind", func(t *testing.T) {
+		_, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objWithNoScriptJSON), appv1.ResourceActionDefinition{Name: "scale", ActionLua: `obj.spec = {replicas = "3"}
+return obj`}, nil)
+		require.NoError(t, err)
+	})
+	t.Run("ProviderError", func(t *testing.T) {
+		created := `return {{operation = "create", resource = {apiVersion = "example.com/v1", kind = "Broken", metadata = {name = "broken", namespace = "default"}}}}`
+		_, err := vm.ExecuteResourceActionDefinition(testObj, appv1.ResourceActionDefinition{Name: "create", ActionLua: created}, nil)
+		require.EqualError(t, err, `error getting the schema of resource 0, Broken "broken": schema unavailable`)
+	})
+}
@@ -0,0 +1,55 @@
+package lua
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ParamBuilder builds the parameters passed to a resource action.
+type ParamBuilder struct {
+	params []*ResourceActionParameters
+}
+
+// NewParams returns a ParamBuilder without any parameter.
+func NewParams() *ParamBuilder {
+	return &ParamBuilder{}
+}
+
+// Set sets the value of the named parameter, replacing its previous value if it is already set.
+func (b *ParamBuilder) Set(name, value string) *ParamBuilder {
+	for _, param := range b.params {
+		if param.GetName() == name {
+			param.Value = &value
+			return b
+		}
+	}
+	b.params = append(b.params, &ResourceActionParameters{Name: &name, Value: &value})
+	return b
+}
+
+// SetTyped sets the value of the named parameter to the string representation of the given value. Since action
+// scripts receive parameters as strings, booleans are formatted as true or false and numbers without exponent.
+func (b *ParamBuilder) SetTyped(name string, value any) *ParamBuilder {
+	switch v := value.(type) {
+	case string:
+		return b.Set(name, v)
+	case bool:
+		return b.Set(name, strconv.FormatBool(v))
+	case float32:
+		return b.Set(name, strconv.FormatFloat(float64(v), 'f', -1, 32))
+	case float64:
+		return b.Set(name, strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		return b.Set(name, fmt.Sprint(v))
+	}
+}
+
+// Build returns the parameters in the order they were first set.
+func (b *ParamBuilder) Build() []*ResourceActionParameters {
+	params := make([]*ResourceActionParameters, 0, len(b.params))
+	for _, param := range b.params {
+		name, value := param.GetName(), param.GetValue()
+		params = append(params, &ResourceActionParameters{Name: &name, Value: &value})
+	}
+	return params
+}
@@ -0,0 +1,110 @@
+package lua
+
+import (
+	"strconv"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// jsonSchemaDraft07 is the meta-schema of the documents returned by ResourceActionParamsJSONSchema.
+const jsonSchemaDraft07 = "http://json-schema.org/draft-07/schema#"
+
+// ResourceActionParamsJSONSchema returns a JSON Schema (draft-07) document describing the parameters of the action,
+// so that clients can generate forms for them. The parameters are described as properties of an object, typed after
+// their Type, with their Default, AllowedValues as an enum and Suggestions as examples. A Required parameter without
+// default is required, unconditionally or when its VisibleWhen condition holds. Defaults and allowed values which are
+// not valid for the type of the parameter are left out.
+func ResourceActionParamsJSONSchema(action appv1.ResourceAction) map[string]any {
+	properties := make(map[string]any, len(action.Params))
+	required := []string{}
+	var conditions []any
+	for _, param := range action.Params {
+		schemaType := jsonSchemaType(param.Type)
+		property := map[string]any{"type": schemaType}
+		if param.Default != "" {
+			if value, ok := jsonSchemaValue(schemaType, param.Default); ok {
+				property["default"] = value
+			}
+		}
+		if len(param.AllowedValues) > 0 {
+			enum := make([]any, 0, len(param.AllowedValues))
+			for _, allowed := range param.AllowedValues {
+				if value, ok := jsonSchemaValue(schemaType, allowed); ok {
+					enum = append(enum, value)
+				}
+			}
+			property["enum"] = enum
+		}
+		if len(param.Suggestions) > 0 {
+			examples := make([]any, 0, len(param.Suggestions))
+			for _, suggestion := range param.Suggestions {
+				examples = append(examples, suggestion)
+			}
+			property["examples"] = examples
+		}
+		properties[param.Name] = property
+
+		if !param.Required || param.Default != "" {
+			continue
+		}
+		if param.VisibleWhen == nil {
+			required = append(required, param.Name)
+			continue
+		}
+		conditions = append(conditions, map[string]any{
+			"if": map[string]any{
+				"properties": map[string]any{param.VisibleWhen.Param: map[string]any{"const": param.VisibleWhen.Equals}},
+				"required":   []string{param.VisibleWhen.Param},
+			},
+			"then": map[string]any{"required": []string{param.Name}},
+		})
+	}
+
+	schema := map[string]any{
+		"$schema":    jsonSchemaDraft07,
+		"title":      action.Name,
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+	if action.DisplayName != "" {
+		schema["title"] = action.DisplayName
+	}
+	if len(conditions) > 0 {
+		schema["allOf"] = conditions
+	}
+	return schema
+}
+
+// jsonSchemaType returns the JSON Schema type of the parameter type. Unknown types are strings, since action scripts
+// receive all the parameters as strings.
+func jsonSchemaType(paramType string) string {
+	switch paramType {
+	case "number", "float":
+		return "number"
+	case "integer", "int":
+		return "integer"
+	case "boolean", "bool":
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+// jsonSchemaValue returns the value of the parameter converted to the JSON Schema type, and whether it is valid for
+// the type.
+func jsonSchemaValue(schemaType string, value string) (any, bool) {
+	switch schemaType {
+	case "number":
+		f, err := strconv.ParseFloat(value, 64)
+		return f, err == nil
+	case "integer":
+		i, err := strconv.ParseInt(value, 10, 64)
+		return i, err == nil
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		return b, err == nil
+	default:
+		return value, true
+	}
+}
@@ -0,0 +1,68 @@
+package lua
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestResourceActionParamsJSONSchema(t *testing.T) {
+	action := appv1.ResourceAction{
+		Name:        "scale",
+		DisplayName: "Scale",
+		Params: []appv1.ResourceActionParam{
+			{Name: "replicas", Type: "integer", Required: true},
+			{Name: "ratio", Type: "number", Default: "0.5"},
+			{Name: "wait", Type: "boolean", Default: "true"},
+			{Name: "mode", AllowedValues: []string{"now", "scheduled"}, Default: "now"},
+			{Name: "at", Required: true, VisibleWhen: &appv1.ResourceActionParamCondition{Param: "mode", Equals: "scheduled"}},
+			{Name: "container", Suggestions: []string{"guestbook", "sidecar"}},
+			{Name: "timeout", Type: "integer", Default: "soon"},
+		},
+	}
+	schemaJSON, err := json.Marshal(ResourceActionParamsJSONSchema(action))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "Scale",
+  "type": "object",
+  "properties": {
+    "replicas": {"type": "integer"},
+    "ratio": {"type": "number", "default": 0.5},
+    "wait": {"type": "boolean", "default": true},
+    "mode": {"type": "string", "default": "now", "enum": ["now", "scheduled"]},
+    "at": {"type": "string"},
+    "container": {"type": "string", "examples": ["guestbook", "sidecar"]},
+    "timeout": {"type": "integer"}
+  },
+  "required": ["replicas"],
+  "allOf": [
+    {"if": {"properties": {"mode": {"const": "scheduled"}}, "required": ["mode"]}, "then": {"required": ["at"]}}
+  ]
+}`, string(schemaJSON))
+
+	var schema spec.Schema
+	require.NoError(t, json.Unmarshal(schemaJSON, &schema))
+	validateInput := func(input string) error {
+		var data map[string]any
+		require.NoError(t, json.Unmarshal([]byte(input), &data))
+		return validate.AgainstSchema(&schema, data, strfmt.Default)
+	}
+	require.NoError(t, validateInput(`{"replicas": 3, "ratio": 0.25, "wait": false, "mode": "scheduled", "at": "tomorrow", "container": "other"}`))
+	require.Error(t, validateInput(`{"ratio": 0.25}`), "replicas is required")
+	require.Error(t, validateInput(`{"replicas": "3"}`), "replicas is an integer")
+	require.Error(t, validateInput(`{"replicas": 3, "mode": "later"}`), "mode is not an allowed value")
+
+	t.Run("WithoutParameters", func(t *testing.T) {
+		schemaJSON, err := json.Marshal(ResourceActionParamsJSONSchema(appv1.ResourceAction{Name: "restart"}))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"$schema": "http://json-schema.org/draft-07/schema#", "title": "restart", "type": "object", "properties": {}, "required": []}`, string(schemaJSON))
+	})
+}
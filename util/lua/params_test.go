@@ -0,0 +1,77 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func paramValues(params []*ResourceActionParameters) map[string]string {
+	values := make(map[string]string)
+	for _, param := range params {
+		values[param.GetName()] = param.GetValue()
+	}
+	return values
+}
+
+func TestParamBuilder(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		params := NewParams().Build()
+		assert.Empty(t, params)
+		assert.NotNil(t, params)
+	})
+	t.Run("Set", func(t *testing.T) {
+		params := NewParams().Set("replicas", "3").Set("image", "nginx:1.27").Build()
+		require.Len(t, params, 2)
+		assert.Equal(t, "replicas", params[0].GetName())
+		assert.Equal(t, "3", params[0].GetValue())
+		assert.Equal(t, "image", params[1].GetName())
+		assert.Equal(t, "nginx:1.27", params[1].GetValue())
+	})
+	t.Run("Replace", func(t *testing.T) {
+		params := NewParams().Set("replicas", "3").Set("image", "nginx").Set("replicas", "5").Build()
+		require.Len(t, params, 2)
+		assert.Equal(t, map[string]string{"replicas": "5", "image": "nginx"}, paramValues(params))
+	})
+	t.Run("SetTyped", func(t *testing.T) {
+		params := NewParams().
+			SetTyped("enabled", true).
+			SetTyped("replicas", 3).
+			SetTyped("partition", int64(1234567890123)).
+			SetTyped("weight", 0.25).
+			SetTyped("large", 1e21).
+			SetTyped("ratio", float32(1.5)).
+			SetTyped("name", "canary").
+			Build()
+		assert.Equal(t, map[string]string{
+			"enabled":   "true",
+			"replicas":  "3",
+			"partition": "1234567890123",
+			"weight":    "0.25",
+			"large":     "1000000000000000000000",
+			"ratio":     "1.5",
+			"name":      "canary",
+		}, paramValues(params))
+	})
+	t.Run("BuildCopies", func(t *testing.T) {
+		builder := NewParams().Set("replicas", "3")
+		params := builder.Build()
+		builder.Set("replicas", "5")
+		assert.Equal(t, "3", params[0].GetValue())
+		assert.Equal(t, "5", builder.Build()[0].GetValue())
+	})
+	t.Run("Execute", func(t *testing.T) {
+		vm := VM{}
+		result, err := vm.ExecuteResourceActionWithResult(StrToUnstructured(objJSON), `
+obj.metadata.labels["replicas"] = actionParams["replicas"]
+obj.metadata.labels["enabled"] = actionParams["enabled"]
+return obj
+`, NewParams().Set("replicas", "3").SetTyped("enabled", true).Build())
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		labels := result.ImpactedResources[0].UnstructuredObj.GetLabels()
+		assert.Equal(t, "3", labels["replicas"])
+		assert.Equal(t, "true", labels["enabled"])
+	})
+}
@@ -0,0 +1,93 @@
+package lua
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ApplyPatch returns the source resource patched with the resource produced by a patch action, previewing the result
+// of the patch. The maps of the patched resource are merged into those of the source recursively, and its other values
+// replace those of the source. The lists declared in ListMergeKeys are merged by key: the items of the patched list
+// are merged into the source items with the same key, or appended, and the other source items are kept.
+func ApplyPatch(source *unstructured.Unstructured, impactedResource ImpactedResource) (*unstructured.Unstructured, error) {
+	if impactedResource.K8SOperation != PatchOperation {
+		return nil, fmt.Errorf("unsupported operation for patch preview: %s", impactedResource.K8SOperation)
+	}
+	merged, err := mergePatchValue(runtime.DeepCopyJSON(source.Object), runtime.DeepCopyJSON(impactedResource.UnstructuredObj.Object), nil, impactedResource.ListMergeKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: merged.(map[string]any)}, nil
+}
+
+func mergePatchValue(source, patch any, path []string, listMergeKeys map[string]string) (any, error) {
+	switch patchValue := patch.(type) {
+	case map[string]any:
+		sourceMap, ok := source.(map[string]any)
+		if !ok {
+			return patchValue, nil
+		}
+		for key, value := range patchValue {
+			merged, err := mergePatchValue(sourceMap[key], value, append(slices.Clone(path), key), listMergeKeys)
+			if err != nil {
+				return nil, err
+			}
+			sourceMap[key] = merged
+		}
+		return sourceMap, nil
+	case []any:
+		mergeKey, ok := listMergeKeys[strings.Join(path, ".")]
+		sourceList, isList := source.([]any)
+		if !ok || !isList {
+			return patchValue, nil
+		}
+		return mergePatchList(sourceList, patchValue, path, mergeKey, listMergeKeys)
+	default:
+		return patch, nil
+	}
+}
+
+func mergePatchList(source, patch []any, path []string, mergeKey string, listMergeKeys map[string]string) ([]any, error) {
+	merged := source
+	for _, patchItem := range patch {
+		key, err := listItemKey(patchItem, path, mergeKey)
+		if err != nil {
+			return nil, err
+		}
+		index := -1
+		for i, sourceItem := range merged {
+			if sourceKey, err := listItemKey(sourceItem, path, mergeKey); err == nil && sourceKey == key {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			merged = append(merged, patchItem)
+			continue
+		}
+		mergedItem, err := mergePatchValue(merged[index], patchItem, path, listMergeKeys)
+		if err != nil {
+			return nil, err
+		}
+		merged[index] = mergedItem
+	}
+	return merged, nil
+}
+
+// listItemKey returns the merge key of the list item, formatted so that keys of different types, e.g. int64 and float64
+// numbers, can be compared.
+func listItemKey(item any, path []string, mergeKey string) (string, error) {
+	itemMap, ok := item.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("item of list %s is not an object, it cannot be merged by key %q", strings.Join(path, "."), mergeKey)
+	}
+	key, ok := itemMap[mergeKey]
+	if !ok {
+		return "", fmt.Errorf("item of list %s has no merge key %q", strings.Join(path, "."), mergeKey)
+	}
+	return fmt.Sprint(key), nil
+}
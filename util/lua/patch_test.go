@@ -0,0 +1,104 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const rolloutWithContainers = `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:v1
+        env:
+        - name: LOG_LEVEL
+          value: info
+        - name: REGION
+          value: eu
+      - name: sidecar
+        image: sidecar:v1
+`
+
+func TestApplyPatchListMergeKeys(t *testing.T) {
+	source := StrToUnstructured(rolloutWithContainers)
+	impactedResources, err := VM{}.ExecuteResourceAction(source, `
+local patched = {}
+patched.apiVersion = obj.apiVersion
+patched.kind = obj.kind
+patched.metadata = {name = obj.metadata.name, namespace = obj.metadata.namespace}
+patched.spec = {template = {spec = {containers = {
+  {name = "guestbook", image = "guestbook:v2", env = {{name = "LOG_LEVEL", value = "debug"}}},
+  {name = "debugger", image = "debugger:v1"}
+}}}}
+return {{
+  operation = "patch",
+  resource = patched,
+  listMergeKeys = {
+    ["spec.template.spec.containers"] = "name",
+    ["spec.template.spec.containers.env"] = "name"
+  }
+}}
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 1)
+	assert.Equal(t, map[string]string{"spec.template.spec.containers": "name", "spec.template.spec.containers.env": "name"}, impactedResources[0].ListMergeKeys)
+
+	merged, err := ApplyPatch(source, impactedResources[0])
+	require.NoError(t, err)
+	assert.Equal(t, StrToUnstructured(`
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:v2
+        env:
+        - name: LOG_LEVEL
+          value: debug
+        - name: REGION
+          value: eu
+      - name: sidecar
+        image: sidecar:v1
+      - name: debugger
+        image: debugger:v1
+`), merged)
+	assert.Equal(t, StrToUnstructured(rolloutWithContainers), source, "the source resource must not be modified")
+
+	t.Run("WithoutListMergeKeys", func(t *testing.T) {
+		impactedResource := impactedResources[0]
+		impactedResource.ListMergeKeys = nil
+		merged, err := ApplyPatch(source, impactedResource)
+		require.NoError(t, err)
+		containers, _, err := unstructured.NestedSlice(merged.Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		assert.Len(t, containers, 2, "the containers are replaced")
+		assert.Equal(t, source.Object["spec"].(map[string]any)["replicas"], merged.Object["spec"].(map[string]any)["replicas"], "the other fields are kept")
+	})
+	t.Run("MissingMergeKey", func(t *testing.T) {
+		impactedResource := impactedResources[0]
+		impactedResource.ListMergeKeys = map[string]string{"spec.template.spec.containers": "id"}
+		_, err := ApplyPatch(source, impactedResource)
+		require.EqualError(t, err, `item of list spec.template.spec.containers has no merge key "id"`)
+	})
+	t.Run("CreateOperation", func(t *testing.T) {
+		_, err := ApplyPatch(source, ImpactedResource{UnstructuredObj: source, K8SOperation: CreateOperation})
+		require.EqualError(t, err, "unsupported operation for patch preview: create")
+	})
+}
@@ -0,0 +1,88 @@
+package lua
+
+import (
+	lua "github.com/yuin/gopher-lua"
+)
+
+// PreparedVM is a VM whose scripts all run in a single Lua state, initialized once with the libraries and the
+// registered globals of the VM, e.g. to discover the actions of a resource and then run one of them without
+// initializing a state twice. The globals set by a script, and the changes it makes to the libraries, are reverted
+// before the next script runs. A PreparedVM runs one script at a time, and must thus not be used concurrently.
+type PreparedVM struct {
+	VM
+}
+
+// Prepare returns a PreparedVM with the configuration of the VM. It must be closed once it is not used anymore.
+func (vm VM) Prepare() *PreparedVM {
+	prepared := &PreparedVM{VM: vm}
+	prepared.prepared = newPreparedState(vm.newLuaState())
+	return prepared
+}
+
+// Close releases the Lua state of the VM.
+func (p *PreparedVM) Close() {
+	p.prepared.l.Close()
+}
+
+// preparedState is the Lua state of a PreparedVM along with the snapshot of its globals, and of the tables they hold,
+// taken once the state was initialized.
+type preparedState struct {
+	l       *lua.LState
+	globals map[lua.LValue]lua.LValue
+	tables  map[*lua.LTable]map[lua.LValue]lua.LValue
+}
+
+func newPreparedState(l *lua.LState) *preparedState {
+	state := &preparedState{l: l, globals: snapshotTable(l.G.Global), tables: make(map[*lua.LTable]map[lua.LValue]lua.LValue)}
+	// The libraries are snapshotted along with their tables, such as package.preload
+	for _, value := range state.globals {
+		library, ok := value.(*lua.LTable)
+		if !ok || library == l.G.Global {
+			continue
+		}
+		state.tables[library] = snapshotTable(library)
+		for _, field := range state.tables[library] {
+			if table, ok := field.(*lua.LTable); ok && table != l.G.Global {
+				state.tables[table] = snapshotTable(table)
+			}
+		}
+	}
+	// The modules loaded by require are cached in the registry
+	if loaded, ok := l.G.Registry.RawGetString("_LOADED").(*lua.LTable); ok {
+		state.tables[loaded] = snapshotTable(loaded)
+	}
+	return state
+}
+
+// reset reverts the globals and the tables of the state to their snapshot, and returns the state.
+func (s *preparedState) reset() *lua.LState {
+	restoreTable(s.l.G.Global, s.globals)
+	for table, snapshot := range s.tables {
+		restoreTable(table, snapshot)
+	}
+	s.l.SetTop(0)
+	return s.l
+}
+
+func snapshotTable(table *lua.LTable) map[lua.LValue]lua.LValue {
+	snapshot := make(map[lua.LValue]lua.LValue)
+	table.ForEach(func(key, value lua.LValue) {
+		snapshot[key] = value
+	})
+	return snapshot
+}
+
+func restoreTable(table *lua.LTable, snapshot map[lua.LValue]lua.LValue) {
+	var added []lua.LValue
+	table.ForEach(func(key, _ lua.LValue) {
+		if _, ok := snapshot[key]; !ok {
+			added = append(added, key)
+		}
+	})
+	for _, key := range added {
+		table.RawSet(key, lua.LNil)
+	}
+	for key, value := range snapshot {
+		table.RawSet(key, value)
+	}
+}
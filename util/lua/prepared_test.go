@@ -0,0 +1,87 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const leakingDiscoveryLua = `
+leaked = "discovery"
+table.leaked = true
+package.preload["leaked"] = function() return {} end
+actions = {}
+actions["scale"] = {}
+return actions
+`
+
+const leakCheckingActionLua = `
+if leaked ~= nil or actions ~= nil or table.leaked ~= nil or package.preload["leaked"] ~= nil then
+  error("the globals of the discovery leaked", 0)
+end
+obj.metadata.labels["scaled"] = "true"
+return obj
+`
+
+func TestPreparedVM(t *testing.T) {
+	vm := VM{}.Prepare()
+	defer vm.Close()
+	obj := StrToUnstructured(objJSON)
+
+	for range 2 {
+		actions, err := vm.ExecuteResourceActionDiscovery(obj, []string{leakingDiscoveryLua})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+		assert.Equal(t, "scale", actions[0].Name)
+
+		result, err := vm.ExecuteResourceActionDefinition(obj, appv1.ResourceActionDefinition{Name: "scale", ActionLua: leakCheckingActionLua}, nil)
+		require.NoError(t, err)
+		require.Len(t, result.ImpactedResources, 1)
+		assert.Equal(t, "true", result.ImpactedResources[0].UnstructuredObj.GetLabels()["scaled"])
+	}
+
+	t.Run("ErrorDoesNotBreakTheState", func(t *testing.T) {
+		_, err := vm.ExecuteResourceAction(obj, `leaked = true
+error("failed")`, nil)
+		require.Error(t, err)
+		_, err = vm.ExecuteResourceAction(obj, leakCheckingActionLua, nil)
+		require.NoError(t, err)
+	})
+	t.Run("PerRunGlobals", func(t *testing.T) {
+		withParams := NewParams().Set("replicas", "3").Build()
+		_, err := vm.ExecuteResourceAction(obj, `assert(actionParams["replicas"] == "3")
+return obj`, withParams)
+		require.NoError(t, err)
+		_, err = vm.ExecuteResourceAction(obj, `assert(actionParams["replicas"] == nil)
+return obj`, nil)
+		require.NoError(t, err)
+	})
+}
+
+func BenchmarkPreparedVM(b *testing.B) {
+	obj := StrToUnstructured(objJSON)
+	action := appv1.ResourceActionDefinition{Name: "scale", ActionLua: leakCheckingActionLua}
+	run := func(b *testing.B, vm VM) {
+		b.Helper()
+		_, err := vm.ExecuteResourceActionDiscovery(obj, []string{leakingDiscoveryLua})
+		require.NoError(b, err)
+		_, err = vm.ExecuteResourceActionDefinition(obj, action, nil)
+		require.NoError(b, err)
+	}
+
+	b.Run("Fresh", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			run(b, VM{})
+		}
+	})
+	b.Run("Prepared", func(b *testing.B) {
+		vm := VM{}.Prepare()
+		defer vm.Close()
+		for n := 0; n < b.N; n++ {
+			run(b, vm.VM)
+		}
+	})
+}
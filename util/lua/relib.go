@@ -0,0 +1,70 @@
+package lua
+
+// relib exposes regular expressions backed by the RE2 syntax of the Go regexp package to the Lua scripts, since Lua
+// patterns are limited and behave differently from the regular expressions users are familiar with.
+
+import (
+	"regexp"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// ReLibName is the name of the regular expressions library.
+const ReLibName = "re"
+
+func OpenRe(l *lua.LState) int {
+	mod := l.RegisterModule(ReLibName, reFuncs)
+	l.Push(mod)
+	return 1
+}
+
+func ReLoader(l *lua.LState) int {
+	mod := l.SetFuncs(l.NewTable(), reFuncs)
+	l.Push(mod)
+	return 1
+}
+
+var reFuncs = map[string]lua.LGFunction{
+	"match":      reMatch,
+	"find":       reFind,
+	"replaceAll": reReplaceAll,
+}
+
+func checkRegexp(l *lua.LState, n int) *regexp.Regexp {
+	pattern := l.CheckString(n)
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		l.RaiseError("invalid regular expression %q: %s", pattern, err.Error())
+	}
+	return re
+}
+
+// reMatch returns whether the string contains a match of the regular expression.
+func reMatch(l *lua.LState) int {
+	re := checkRegexp(l, 1)
+	l.Push(lua.LBool(re.MatchString(l.CheckString(2))))
+	return 1
+}
+
+// reFind returns the leftmost match of the regular expression in the string followed by its capture groups, or nil if
+// the string does not contain a match.
+func reFind(l *lua.LState) int {
+	re := checkRegexp(l, 1)
+	matches := re.FindStringSubmatch(l.CheckString(2))
+	if matches == nil {
+		l.Push(lua.LNil)
+		return 1
+	}
+	for _, match := range matches {
+		l.Push(lua.LString(match))
+	}
+	return len(matches)
+}
+
+// reReplaceAll replaces all the matches of the regular expression in the string with the replacement, in which $1 or
+// ${name} is replaced by the text of the corresponding capture group.
+func reReplaceAll(l *lua.LState) int {
+	re := checkRegexp(l, 1)
+	l.Push(lua.LString(re.ReplaceAllString(l.CheckString(2), l.CheckString(3))))
+	return 1
+}
@@ -0,0 +1,86 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func runReScript(t *testing.T, script string) *lua.LState {
+	t.Helper()
+	vm := VM{}
+	l, err := vm.runLua(StrToUnstructured(objJSON), script, nil)
+	require.NoError(t, err)
+	return l
+}
+
+func TestReLib(t *testing.T) {
+	t.Run("Match", func(t *testing.T) {
+		l := runReScript(t, `return re.match("^nginx:[0-9.]+$", "nginx:1.27.2"), re.match("^nginx:[0-9.]+$", "nginx:latest")`)
+		assert.Equal(t, lua.LTrue, l.Get(-2))
+		assert.Equal(t, lua.LFalse, l.Get(-1))
+	})
+	t.Run("Find", func(t *testing.T) {
+		l := runReScript(t, `return re.find("^([^/:]+)/([^:]+):(.+)$", "registry.example.com/guestbook:v2")`)
+		require.Equal(t, 4, l.GetTop())
+		assert.Equal(t, lua.LString("registry.example.com/guestbook:v2"), l.Get(1))
+		assert.Equal(t, lua.LString("registry.example.com"), l.Get(2))
+		assert.Equal(t, lua.LString("guestbook"), l.Get(3))
+		assert.Equal(t, lua.LString("v2"), l.Get(4))
+	})
+	t.Run("FindNoMatch", func(t *testing.T) {
+		l := runReScript(t, `return re.find("[0-9]+", "latest")`)
+		assert.Equal(t, lua.LNil, l.Get(-1))
+	})
+	t.Run("ReplaceAll", func(t *testing.T) {
+		l := runReScript(t, `return re.replaceAll("registry\\.old\\.com/([a-z]+)", "registry.old.com/app registry.old.com/sidecar", "registry.new.com/$1")`)
+		assert.Equal(t, lua.LString("registry.new.com/app registry.new.com/sidecar"), l.Get(-1))
+	})
+	t.Run("Require", func(t *testing.T) {
+		l := runReScript(t, `local regexp = require("re")
+return regexp.match("a+", "caaat")`)
+		assert.Equal(t, lua.LTrue, l.Get(-1))
+	})
+	t.Run("InvalidPattern", func(t *testing.T) {
+		vm := VM{}
+		_, err := vm.runLua(StrToUnstructured(objJSON), `return re.match("(unclosed", "value")`, nil)
+		require.ErrorContains(t, err, `invalid regular expression "(unclosed": error parsing regexp: missing closing ): `+"`(unclosed`")
+	})
+	t.Run("InvalidPatternCaught", func(t *testing.T) {
+		l := runReScript(t, `local ok, err = pcall(re.replaceAll, "[", "value", "")
+return ok, err`)
+		assert.Equal(t, lua.LFalse, l.Get(-2))
+		assert.Contains(t, l.Get(-1).String(), `invalid regular expression "["`)
+	})
+}
+
+func TestExecuteResourceActionWithRe(t *testing.T) {
+	deployment := StrToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: registry.old.com/guestbook:v1
+`)
+	vm := VM{}
+	impactedResources, err := vm.ExecuteResourceAction(deployment, `
+for _, container in ipairs(obj.spec.template.spec.containers) do
+  container.image = re.replaceAll("^registry\\.old\\.com/", container.image, "registry.new.com/")
+end
+return obj
+`, nil)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 1)
+	containers, _, err := unstructured.NestedSlice(impactedResources[0].UnstructuredObj.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	assert.Equal(t, "registry.new.com/guestbook:v1", containers[0].(map[string]any)["image"])
+}
@@ -0,0 +1,104 @@
+package lua
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// disabledByDiscoveryReason is the reason of the actions disabled by the discovery scripts, which give none.
+const disabledByDiscoveryReason = "disabled by the action discovery for the current state of the resource"
+
+// ResourceActionDetails is an action discovered for a resource along with what clients need to offer it.
+type ResourceActionDetails struct {
+	appv1.ResourceAction
+	// DisabledReason explains why the action is disabled, e.g. with the message of its precondition. It is empty if
+	// the action is enabled.
+	DisabledReason string `json:"disabledReason,omitempty"`
+	// ParamsSchema is the JSON Schema of the parameters of the action, see ResourceActionParamsJSONSchema.
+	ParamsSchema map[string]any `json:"paramsSchema"`
+}
+
+// GetResourceActions discovers the actions of the resource and returns them with the JSON Schema of their parameters
+// and the reason the disabled ones are disabled, so that no further call is needed to offer them. The discovery
+// scripts are run once. The preconditions of the enabled actions are evaluated without parameters, and the actions
+// whose precondition does not hold, or which have no definition, are disabled.
+func (vm VM) GetResourceActions(obj *unstructured.Unstructured) ([]ResourceActionDetails, error) {
+	discoveryScripts, err := vm.GetResourceActionDiscovery(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting action discovery: %w", err)
+	}
+	if len(discoveryScripts) == 0 {
+		return []ResourceActionDetails{}, nil
+	}
+	actions, err := vm.ExecuteResourceActionDiscovery(obj, discoveryScripts)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering actions: %w", err)
+	}
+
+	details := make([]ResourceActionDetails, 0, len(actions))
+	for _, action := range actions {
+		detail := ResourceActionDetails{ResourceAction: action, ParamsSchema: ResourceActionParamsJSONSchema(action)}
+		if action.Disabled {
+			detail.DisabledReason = disabledByDiscoveryReason
+		}
+		definition, err := vm.GetResourceAction(obj, action.Name)
+		var doesNotExistErr *ScriptDoesNotExistError
+		switch {
+		case errors.As(err, &doesNotExistErr):
+			detail.disable(fmt.Sprintf("action %q has no definition", action.Name))
+		case err != nil:
+			return nil, fmt.Errorf("error getting action %q: %w", action.Name, err)
+		default:
+			detail.RequiresConfirmation = detail.RequiresConfirmation || definition.RequiresConfirmation
+			if !detail.Disabled && definition.Precondition != "" {
+				if err := vm.checkPrecondition(obj, definition.Precondition, nil); err != nil {
+					detail.disable(err.Error())
+				}
+			}
+		}
+		details = append(details, detail)
+	}
+	return details, nil
+}
+
+// disable disables the action for the given reason, unless it is already disabled.
+func (d *ResourceActionDetails) disable(reason string) {
+	if d.Disabled {
+		return
+	}
+	d.Disabled = true
+	d.DisabledReason = reason
+}
+
+// BatchResourceActions are the actions discovered for several resources, keyed by resource.
+type BatchResourceActions struct {
+	// Actions are the actions of the resources whose actions could be discovered
+	Actions map[kube.ResourceKey][]ResourceActionDetails
+	// Errors are the errors of the resources whose actions could not be discovered, e.g. since their discovery script
+	// is broken
+	Errors map[kube.ResourceKey]error
+}
+
+// GetResourceActionsBatch runs GetResourceActions for every resource. A resource whose actions cannot be discovered
+// does not prevent discovering those of the others, so that clients can show partial results.
+func (vm VM) GetResourceActionsBatch(objs []*unstructured.Unstructured) BatchResourceActions {
+	result := BatchResourceActions{
+		Actions: make(map[kube.ResourceKey][]ResourceActionDetails, len(objs)),
+		Errors:  make(map[kube.ResourceKey]error),
+	}
+	for _, obj := range objs {
+		key := kube.GetResourceKey(obj)
+		actions, err := vm.GetResourceActions(obj)
+		if err != nil {
+			result.Errors[key] = err
+			continue
+		}
+		result.Actions[key] = actions
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestGetResourceActions(t *testing.T) {
+	vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+		"argoproj.io/Rollout": {Actions: `
+discovery.lua: |
+  local actions = {}
+  actions["scale"] = {["displayName"] = "Scale", ["params"] = {{["name"] = "replicas", ["type"] = "integer", ["required"] = true}}}
+  actions["pause"] = {["disabled"] = true}
+  actions["promote"] = {}
+  actions["orphan"] = {}
+  actions["delete-pods"] = {}
+  return actions
+definitions:
+- name: scale
+  action.lua: return obj
+- name: pause
+  action.lua: return obj
+- name: promote
+  precondition: return false, "the rollout is not paused"
+  action.lua: return obj
+- name: delete-pods
+  requiresConfirmation: true
+  action.lua: return obj
+`},
+	}}
+
+	actions, err := vm.GetResourceActions(StrToUnstructured(objJSON))
+	require.NoError(t, err)
+	byName := map[string]ResourceActionDetails{}
+	for _, action := range actions {
+		byName[action.Name] = action
+	}
+	require.Len(t, byName, 5)
+
+	scale := byName["scale"]
+	assert.False(t, scale.Disabled)
+	assert.Empty(t, scale.DisabledReason)
+	assert.Equal(t, "Scale", scale.DisplayName)
+	assert.Equal(t, []appv1.ResourceActionParam{{Name: "replicas", Type: "integer", Required: true}}, scale.Params)
+	assert.Equal(t, ResourceActionParamsJSONSchema(scale.ResourceAction), scale.ParamsSchema)
+	assert.Equal(t, []string{"replicas"}, scale.ParamsSchema["required"])
+
+	assert.True(t, byName["pause"].Disabled)
+	assert.Equal(t, disabledByDiscoveryReason, byName["pause"].DisabledReason)
+
+	assert.True(t, byName["promote"].Disabled)
+	assert.Equal(t, "precondition failed: the rollout is not paused", byName["promote"].DisabledReason)
+
+	assert.True(t, byName["orphan"].Disabled)
+	assert.Equal(t, `action "orphan" has no definition`, byName["orphan"].DisabledReason)
+
+	assert.False(t, byName["delete-pods"].Disabled)
+	assert.True(t, byName["delete-pods"].RequiresConfirmation)
+
+	t.Run("NoDiscovery", func(t *testing.T) {
+		actions, err := VM{}.GetResourceActions(StrToUnstructured(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "test"}}`))
+		require.NoError(t, err)
+		assert.Empty(t, actions)
+	})
+}
+
+func TestGetResourceActionsBatch(t *testing.T) {
+	vm := VM{ResourceOverrides: map[string]appv1.ResourceOverride{
+		"argoproj.io/Rollout": {Actions: `
+discovery.lua: |
+  return {["promote"] = {}}
+definitions:
+- name: promote
+  action.lua: return obj
+`},
+		"not-an-endpoint.io/Test": {Actions: `
+discovery.lua: |
+  error("broken discovery")
+`},
+	}}
+	rollout := StrToUnstructured(objJSON)
+	broken := StrToUnstructured(objWithNoScriptJSON)
+
+	result := vm.GetResourceActionsBatch([]*unstructured.Unstructured{rollout, broken})
+	require.Len(t, result.Actions, 1)
+	actions := result.Actions[kube.GetResourceKey(rollout)]
+	require.Len(t, actions, 1)
+	assert.Equal(t, "promote", actions[0].Name)
+
+	require.Len(t, result.Errors, 1)
+	require.ErrorContains(t, result.Errors[kube.GetResourceKey(broken)], "broken discovery")
+}
@@ -0,0 +1,75 @@
+package lua
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+)
+
+// scriptChunkName is the name of the compiled scripts in the errors, which is the same as for the scripts run with
+// DoString.
+const scriptChunkName = "<string>"
+
+// ScriptCache caches the compiled scripts, keyed by the hash of their content only. The objects, parameters and
+// globals injected into the scripts, e.g. the ActionConfig or KubeVersion of the VM, are set anew in every run, so a
+// single cache can be shared by the VMs of different tenants or clusters. The cache is cleared once it holds its
+// maximum number of entries.
+type ScriptCache struct {
+	maxEntries int
+
+	lock    sync.RWMutex
+	entries map[string]*lua.FunctionProto
+}
+
+// NewScriptCache returns a ScriptCache holding up to the given number of compiled scripts.
+func NewScriptCache(maxEntries int) *ScriptCache {
+	return &ScriptCache{maxEntries: maxEntries, entries: make(map[string]*lua.FunctionProto)}
+}
+
+// Len returns the number of cached compiled scripts.
+func (c *ScriptCache) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return len(c.entries)
+}
+
+// Clear removes all the cached compiled scripts.
+func (c *ScriptCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries = make(map[string]*lua.FunctionProto)
+}
+
+// compile returns the compiled script, compiling it if it is not cached yet. Compiled scripts are immutable, so they
+// can be run by several Lua states at once.
+func (c *ScriptCache) compile(script string) (*lua.FunctionProto, error) {
+	hash := sha256.Sum256([]byte(script))
+	key := hex.EncodeToString(hash[:])
+	c.lock.RLock()
+	proto, ok := c.entries[key]
+	c.lock.RUnlock()
+	if ok {
+		return proto, nil
+	}
+
+	chunk, err := parse.Parse(strings.NewReader(script), scriptChunkName)
+	if err != nil {
+		return nil, &lua.ApiError{Type: lua.ApiErrorSyntax, Object: lua.LString(err.Error()), Cause: err}
+	}
+	proto, err = lua.Compile(chunk, scriptChunkName)
+	if err != nil {
+		return nil, &lua.ApiError{Type: lua.ApiErrorSyntax, Object: lua.LString(err.Error()), Cause: err}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.entries = make(map[string]*lua.FunctionProto)
+	}
+	c.entries[key] = proto
+	return proto, nil
+}
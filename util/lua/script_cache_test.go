@@ -0,0 +1,81 @@
+package lua
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// tenantActionLua records the globals injected into the script, and a global of its own which must not be kept
+// between runs.
+const tenantActionLua = `
+runs = (runs or 0) + 1
+obj.metadata.annotations = {
+  tenant = actionConfig["tenant"],
+  cluster = kubeVersion.gitVersion,
+  runs = tostring(runs),
+  replicas = actionParams["replicas"]
+}
+return obj
+`
+
+func TestScriptCacheTenantIsolation(t *testing.T) {
+	cache := NewScriptCache(10)
+	tenants := map[string]VM{
+		"a": {ScriptCache: cache, ActionConfig: map[string]string{"tenant": "a"}, KubeVersion: &version.Info{Major: "1", Minor: "29", GitVersion: "v1.29.0"}},
+		"b": {ScriptCache: cache, ActionConfig: map[string]string{"tenant": "b"}, KubeVersion: &version.Info{Major: "1", Minor: "31", GitVersion: "v1.31.2"}},
+	}
+	replicas := map[string]string{"a": "1", "b": "2"}
+
+	for range 2 {
+		for tenant, vm := range tenants {
+			params := NewParams().Set("replicas", replicas[tenant]).Build()
+			impactedResources, err := vm.ExecuteResourceAction(StrToUnstructured(objJSON), tenantActionLua, params)
+			require.NoError(t, err)
+			require.Len(t, impactedResources, 1)
+			assert.Equal(t, map[string]string{
+				"tenant":   tenant,
+				"cluster":  vm.KubeVersion.GitVersion,
+				"runs":     "1",
+				"replicas": replicas[tenant],
+			}, impactedResources[0].UnstructuredObj.GetAnnotations())
+		}
+	}
+	assert.Equal(t, 1, cache.Len(), "the script must be compiled once for both tenants")
+
+	t.Run("Concurrent", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for tenant, vm := range tenants {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 20 {
+					impactedResources, err := vm.ExecuteResourceAction(StrToUnstructured(objJSON), tenantActionLua, nil)
+					if !assert.NoError(t, err) {
+						return
+					}
+					assert.Equal(t, tenant, impactedResources[0].UnstructuredObj.GetAnnotations()["tenant"])
+				}
+			}()
+		}
+		wg.Wait()
+	})
+	t.Run("SyntaxError", func(t *testing.T) {
+		_, err := tenants["a"].ExecuteResourceAction(StrToUnstructured(objJSON), "return {", nil)
+		require.ErrorContains(t, err, "<string>")
+		assert.Equal(t, 1, cache.Len())
+	})
+	t.Run("MaxEntries", func(t *testing.T) {
+		vm := VM{ScriptCache: NewScriptCache(1)}
+		for _, script := range []string{"return obj", "return obj -- changed"} {
+			_, err := vm.ExecuteResourceAction(StrToUnstructured(objJSON), script, nil)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, vm.ScriptCache.Len())
+		vm.ScriptCache.Clear()
+		assert.Equal(t, 0, vm.ScriptCache.Len())
+	})
+}
@@ -0,0 +1,90 @@
+package lua
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ScriptLoader loads resource customization scripts from a file system laid out like the resource_customizations
+// directory and caches them in memory. The cache is only refreshed by Reload, which allows updating the scripts of a
+// long-running process without a restart.
+type ScriptLoader struct {
+	fsys fs.FS
+
+	lock    sync.RWMutex
+	scripts map[string]string
+}
+
+// NewScriptLoader returns a ScriptLoader reading scripts from the given file system. All scripts are loaded eagerly.
+func NewScriptLoader(fsys fs.FS) (*ScriptLoader, error) {
+	loader := &ScriptLoader{fsys: fsys}
+	if err := loader.Reload(); err != nil {
+		return nil, err
+	}
+	return loader, nil
+}
+
+// NewDirScriptLoader returns a ScriptLoader reading scripts from the given directory.
+func NewDirScriptLoader(dir string) (*ScriptLoader, error) {
+	return NewScriptLoader(os.DirFS(dir))
+}
+
+// Reload walks the file system again and replaces the cached scripts. Scripts being executed while reloading keep
+// using the version they were read with.
+func (l *ScriptLoader) Reload() error {
+	scripts := make(map[string]string)
+	err := fs.WalkDir(l.fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || (!strings.HasSuffix(p, ".lua") && !strings.HasSuffix(p, ".cue") && path.Base(p) != actionManifestFile) {
+			return nil
+		}
+		data, err := fs.ReadFile(l.fsys, p)
+		if err != nil {
+			return err
+		}
+		scripts[p] = string(data)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.scripts = scripts
+	return nil
+}
+
+// ReadScript returns the cached script for the given object key and script file.
+func (l *ScriptLoader) ReadScript(objKey string, scriptFile string) (string, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	script, ok := l.scripts[path.Join(objKey, scriptFile)]
+	if !ok {
+		return "", &ScriptDoesNotExistError{ScriptName: objKey}
+	}
+	return script, nil
+}
+
+// actionNames returns the names of the actions with a Lua or CUE action script in the given actions directory.
+func (l *ScriptLoader) actionNames(actionsDir string) []string {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	var names []string
+	for p := range l.scripts {
+		dir, file := path.Split(p)
+		if file != actionScriptFile && file != actionCUEScriptFile {
+			continue
+		}
+		if name, ok := strings.CutPrefix(strings.TrimSuffix(dir, "/"), actionsDir+"/"); ok && !strings.Contains(name, "/") {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
@@ -0,0 +1,73 @@
+package lua
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeScript(t *testing.T, dir, path, script string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(script), 0o644))
+}
+
+func TestScriptLoaderReload(t *testing.T) {
+	dir := t.TempDir()
+	writeScript(t, dir, "argoproj.io/Rollout/actions/test/action.lua", "return 'v1'")
+	loader, err := NewDirScriptLoader(dir)
+	require.NoError(t, err)
+	vm := VM{ScriptLoader: loader}
+	testObj := StrToUnstructured(objJSON)
+
+	action, err := vm.GetResourceAction(testObj, "test")
+	require.NoError(t, err)
+	assert.Equal(t, "return 'v1'", action.ActionLua)
+
+	writeScript(t, dir, "argoproj.io/Rollout/actions/test/action.lua", "return 'v2'")
+	action, err = vm.GetResourceAction(testObj, "test")
+	require.NoError(t, err)
+	assert.Equal(t, "return 'v1'", action.ActionLua, "scripts must be cached until reloaded")
+
+	require.NoError(t, loader.Reload())
+	action, err = vm.GetResourceAction(testObj, "test")
+	require.NoError(t, err)
+	assert.Equal(t, "return 'v2'", action.ActionLua)
+
+	_, err = vm.GetResourceAction(testObj, "missing")
+	var doesNotExist *ScriptDoesNotExistError
+	require.ErrorAs(t, err, &doesNotExist)
+}
+
+func TestScriptLoaderConcurrentReload(t *testing.T) {
+	dir := t.TempDir()
+	writeScript(t, dir, "argoproj.io/Rollout/actions/test/action.lua", validActionLua)
+	loader, err := NewDirScriptLoader(dir)
+	require.NoError(t, err)
+	vm := VM{ScriptLoader: loader}
+	testObj := StrToUnstructured(objJSON)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				action, err := vm.GetResourceAction(testObj, "test")
+				if !assert.NoError(t, err) {
+					return
+				}
+				_, err = vm.ExecuteResourceAction(testObj.DeepCopy(), action.ActionLua, nil)
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	for range 20 {
+		require.NoError(t, loader.Reload())
+	}
+	wg.Wait()
+}
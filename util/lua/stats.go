@@ -0,0 +1,52 @@
+package lua
+
+import (
+	"runtime"
+	"time"
+)
+
+// ActionStats are statistics about the execution of an action, so that operators can profile the expensive ones. The
+// statistics are zero unless the VM tracks them. The Lua VM counts neither the instructions it executes nor the memory
+// of the scripts, so that the statistics are those of the process while the action runs.
+type ActionStats struct {
+	// Duration is the wall time of the action, including its conditions and its steps
+	Duration time.Duration `json:"duration"`
+	// AllocatedBytes is the number of bytes allocated on the heap while the action runs. Since the allocations of the
+	// Lua VM are not tracked separately, the allocations of the other goroutines running meanwhile are counted too.
+	AllocatedBytes uint64 `json:"allocatedBytes"`
+	// Mallocs is the number of heap objects allocated while the action runs, counted like AllocatedBytes
+	Mallocs uint64 `json:"mallocs"`
+}
+
+// statsTracker measures the statistics of an action from its creation.
+type statsTracker struct {
+	start  time.Time
+	before runtime.MemStats
+}
+
+// trackStats returns a tracker measuring the statistics of an action from now on, or nil if the VM does not track
+// them, since reading the memory statistics stops the world.
+func (vm VM) trackStats() *statsTracker {
+	if !vm.TrackStats {
+		return nil
+	}
+	tracker := &statsTracker{}
+	runtime.ReadMemStats(&tracker.before)
+	tracker.start = time.Now()
+	return tracker
+}
+
+// stats returns the statistics measured since the creation of the tracker, or zero statistics if it is nil.
+func (t *statsTracker) stats() ActionStats {
+	if t == nil {
+		return ActionStats{}
+	}
+	duration := time.Since(t.start)
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+	return ActionStats{
+		Duration:       duration,
+		AllocatedBytes: after.TotalAlloc - t.before.TotalAlloc,
+		Mallocs:        after.Mallocs - t.before.Mallocs,
+	}
+}
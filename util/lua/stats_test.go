@@ -0,0 +1,33 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestActionStats(t *testing.T) {
+	action := appv1.ResourceActionDefinition{Name: "label", ActionLua: `
+for i = 1, 100 do
+  obj.metadata.labels["label-" .. i] = "value"
+end
+return obj`}
+
+	t.Run("Tracked", func(t *testing.T) {
+		vm := VM{TrackStats: true}
+		result, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), action, nil)
+		require.NoError(t, err)
+		assert.Positive(t, result.Stats.Duration)
+		assert.Positive(t, result.Stats.AllocatedBytes)
+		assert.Positive(t, result.Stats.Mallocs)
+	})
+	t.Run("NotTracked", func(t *testing.T) {
+		vm := VM{}
+		result, err := vm.ExecuteResourceActionDefinition(StrToUnstructured(objJSON), action, nil)
+		require.NoError(t, err)
+		assert.Equal(t, ActionStats{}, result.Stats)
+	})
+}
@@ -0,0 +1,23 @@
+-- Creates a config map in a first wave, then mounts it in the pods of the deployment in a second wave, once it exists
+local configMap = {}
+configMap.apiVersion = "v1"
+configMap.kind = "ConfigMap"
+configMap.metadata = {name = obj.metadata.name .. "-config", namespace = obj.metadata.namespace}
+configMap.data = {["log-level"] = "info"}
+
+local podSpec = obj.spec.template.spec
+if podSpec.volumes == nil then
+  podSpec.volumes = {}
+end
+table.insert(podSpec.volumes, {name = "config", configMap = {name = configMap.metadata.name}})
+for _, container in ipairs(podSpec.containers) do
+  if container.volumeMounts == nil then
+    container.volumeMounts = {}
+  end
+  table.insert(container.volumeMounts, {name = "config", mountPath = "/etc/config"})
+end
+
+return {
+  {operation = "create", resource = configMap, wave = 0},
+  {operation = "patch", resource = obj, wave = 1},
+}
@@ -0,0 +1,12 @@
+-- Records the names of the running pods among the related resources in an annotation
+local names = {}
+for _, resource in ipairs(relatedResources) do
+  if resource.kind == "Pod" and resource.status ~= nil and resource.status.phase == "Running" then
+    table.insert(names, resource.metadata.name)
+  end
+end
+if obj.metadata.annotations == nil then
+  obj.metadata.annotations = {}
+end
+obj.metadata.annotations["example.com/running-pods"] = table.concat(names, ",")
+return obj
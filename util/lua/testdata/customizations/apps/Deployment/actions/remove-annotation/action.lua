@@ -0,0 +1,7 @@
+-- Removes the annotation passed as parameter with a merge patch, in which the fields set to null are removed
+local patch = {
+  apiVersion = obj.apiVersion,
+  kind = obj.kind,
+  metadata = {annotations = {[actionParams["annotation"]] = null}},
+}
+return {{operation = "patch", patchType = "merge", resource = patch}}
@@ -0,0 +1,7 @@
+-- Scales the deployment through its scale subresource to the number of replicas passed as parameter
+local replicas = tonumber(actionParams["replicas"])
+if replicas == nil or replicas < 0 then
+  error("replicas must be a non-negative number", 0)
+end
+obj.spec.replicas = replicas
+return {{operation = "patch", subresource = "scale", resource = obj}}
@@ -0,0 +1,3 @@
+-- Sets the number of replicas of the deployment to the one passed as parameter
+obj.spec.replicas = tonumber(actionParams["replicas"])
+return obj
@@ -0,0 +1,6 @@
+// Labels the deployment and its pods with the tier passed as parameter
+patch: {
+	metadata: labels: tier: actionParams.tier
+	spec: template: metadata: labels: tier: actionParams.tier
+}
+message: "set the tier of \(obj.metadata.name) to \(actionParams.tier)"
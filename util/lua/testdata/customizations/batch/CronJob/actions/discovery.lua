@@ -0,0 +1,8 @@
+-- Only the action reverting the current state of the cron job is enabled
+local actions = {}
+local suspended = obj.spec.suspend == true
+actions["suspend"] = {["disabled"] = suspended}
+actions["resume"] = {["disabled"] = not suspended}
+-- The trigger action is disabled by its when predicate instead
+actions["trigger"] = {}
+return actions
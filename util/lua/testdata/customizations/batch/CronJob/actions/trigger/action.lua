@@ -0,0 +1,9 @@
+-- Creates a job from the job template of the cron job
+local job = {}
+job.apiVersion = "batch/v1"
+job.kind = "Job"
+job.metadata = {}
+job.metadata.name = obj.metadata.name .. "-manual"
+job.metadata.namespace = obj.metadata.namespace
+job.spec = obj.spec.jobTemplate.spec
+return {{operation = "create", resource = job}}
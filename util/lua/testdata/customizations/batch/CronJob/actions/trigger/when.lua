@@ -0,0 +1,2 @@
+-- A suspended cron job is not triggered manually
+return obj.spec.suspend ~= true
@@ -0,0 +1,68 @@
+package lua
+
+// timelib parses durations and formats timestamps in the Lua scripts. The current time is read from the clock of the
+// VM, so that the scripts are deterministic in tests.
+
+import (
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// TimeLibName is the name of the time library.
+const TimeLibName = "time"
+
+func OpenTime(now func() time.Time) lua.LGFunction {
+	return func(l *lua.LState) int {
+		mod := l.RegisterModule(TimeLibName, timeFuncs(now))
+		l.Push(mod)
+		return 1
+	}
+}
+
+func TimeLoader(now func() time.Time) lua.LGFunction {
+	return func(l *lua.LState) int {
+		mod := l.SetFuncs(l.NewTable(), timeFuncs(now))
+		l.Push(mod)
+		return 1
+	}
+}
+
+// timeFuncs returns the functions of the library. formatRFC3339 formats the given Unix time, and addSeconds adds
+// seconds to the given RFC 3339 timestamp; both use the current time if it is nil.
+func timeFuncs(now func() time.Time) map[string]lua.LGFunction {
+	return map[string]lua.LGFunction{
+		"parseDuration": timeParseDuration,
+		"formatRFC3339": func(l *lua.LState) int {
+			t := now()
+			if l.Get(1) != lua.LNil {
+				t = time.Unix(int64(l.CheckNumber(1)), 0)
+			}
+			l.Push(lua.LString(t.UTC().Format(time.RFC3339)))
+			return 1
+		},
+		"addSeconds": func(l *lua.LState) int {
+			t := now()
+			if l.Get(1) != lua.LNil {
+				var err error
+				t, err = time.Parse(time.RFC3339, l.CheckString(1))
+				if err != nil {
+					l.RaiseError("invalid RFC 3339 timestamp: %s", err.Error())
+				}
+			}
+			seconds := float64(l.CheckNumber(2))
+			l.Push(lua.LString(t.Add(time.Duration(seconds * float64(time.Second))).UTC().Format(time.RFC3339)))
+			return 1
+		},
+	}
+}
+
+// timeParseDuration returns the number of seconds of a duration in the format of Go, e.g. 1h30m.
+func timeParseDuration(l *lua.LState) int {
+	d, err := time.ParseDuration(l.CheckString(1))
+	if err != nil {
+		l.RaiseError("invalid duration: %s", err.Error())
+	}
+	l.Push(lua.LNumber(d.Seconds()))
+	return 1
+}
@@ -0,0 +1,50 @@
+package lua
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func TestTimeLib(t *testing.T) {
+	pinned := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	vm := VM{Now: func() time.Time { return pinned }}
+	run := func(t *testing.T, script string) *lua.LState {
+		t.Helper()
+		l, err := vm.runLua(StrToUnstructured(objJSON), script, nil)
+		require.NoError(t, err)
+		return l
+	}
+
+	t.Run("ParseDuration", func(t *testing.T) {
+		l := run(t, `return time.parseDuration("1h30m"), time.parseDuration("1.5s")`)
+		assert.Equal(t, lua.LNumber(5400), l.Get(-2))
+		assert.Equal(t, lua.LNumber(1.5), l.Get(-1))
+	})
+	t.Run("FormatRFC3339", func(t *testing.T) {
+		l := run(t, `return time.formatRFC3339(), time.formatRFC3339(0)`)
+		assert.Equal(t, lua.LString("2024-03-01T12:00:00Z"), l.Get(-2))
+		assert.Equal(t, lua.LString("1970-01-01T00:00:00Z"), l.Get(-1))
+	})
+	t.Run("AddSeconds", func(t *testing.T) {
+		l := run(t, `return time.addSeconds(nil, time.parseDuration("1h30m")), time.addSeconds("2024-12-31T23:59:30+01:00", 60)`)
+		assert.Equal(t, lua.LString("2024-03-01T13:30:00Z"), l.Get(-2))
+		assert.Equal(t, lua.LString("2024-12-31T23:00:30Z"), l.Get(-1))
+	})
+	t.Run("Require", func(t *testing.T) {
+		l := run(t, `local t = require("time")
+return t.formatRFC3339()`)
+		assert.Equal(t, lua.LString("2024-03-01T12:00:00Z"), l.Get(-1))
+	})
+	t.Run("InvalidDuration", func(t *testing.T) {
+		_, err := vm.runLua(StrToUnstructured(objJSON), `return time.parseDuration("90 minutes")`, nil)
+		require.ErrorContains(t, err, "invalid duration")
+	})
+	t.Run("InvalidTimestamp", func(t *testing.T) {
+		_, err := vm.runLua(StrToUnstructured(objJSON), `return time.addSeconds("yesterday", 1)`, nil)
+		require.ErrorContains(t, err, "invalid RFC 3339 timestamp")
+	})
+}
@@ -0,0 +1,43 @@
+package lua
+
+import (
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// ToggleFuncName is the name of the global function setting the boolean fields of the resources, e.g. spec.paused or
+// spec.suspend, for the pause and suspend actions of the kinds which expose one.
+const ToggleFuncName = "toggle"
+
+// toggleField sets the boolean field of the resource at the given path, e.g. spec.paused or $.spec.paused, to the
+// given value, or flips it if no value is given, and returns the resource. The objects along the path must exist, and
+// the field must be unset or a boolean.
+func toggleField(l *lua.LState) int {
+	obj := l.CheckTable(1)
+	path := l.CheckString(2)
+	fields := strings.Split(strings.TrimPrefix(strings.TrimPrefix(path, "$"), "."), ".")
+	parent := obj
+	for _, field := range fields[:len(fields)-1] {
+		table, ok := parent.RawGetString(field).(*lua.LTable)
+		if field == "" || !ok {
+			l.RaiseError("path %q does not exist in the resource", path)
+		}
+		parent = table
+	}
+	field := fields[len(fields)-1]
+	if field == "" {
+		l.RaiseError("invalid path %q", path)
+	}
+	current := parent.RawGetString(field)
+	if current != lua.LNil && current.Type() != lua.LTBool {
+		l.RaiseError("field %q is a %s, not a boolean", path, current.Type().String())
+	}
+	value := !lua.LVAsBool(current)
+	if l.GetTop() >= 3 {
+		value = l.CheckBool(3)
+	}
+	parent.RawSetString(field, lua.LBool(value))
+	l.Push(obj)
+	return 1
+}
@@ -0,0 +1,64 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const pausableRolloutYaml = `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 1
+`
+
+func TestToggle(t *testing.T) {
+	vm := VM{}
+	run := func(t *testing.T, script string) (*unstructured.Unstructured, error) {
+		t.Helper()
+		impactedResources, err := vm.ExecuteResourceAction(StrToUnstructured(pausableRolloutYaml), script, nil)
+		if err != nil {
+			return nil, err
+		}
+		require.Len(t, impactedResources, 1)
+		return impactedResources[0].UnstructuredObj, nil
+	}
+	paused := func(t *testing.T, obj *unstructured.Unstructured) bool {
+		t.Helper()
+		value, found, err := unstructured.NestedBool(obj.Object, "spec", "paused")
+		require.NoError(t, err)
+		require.True(t, found)
+		return value
+	}
+
+	t.Run("True", func(t *testing.T) {
+		obj, err := run(t, `return toggle(obj, "spec.paused", true)`)
+		require.NoError(t, err)
+		assert.True(t, paused(t, obj))
+	})
+	t.Run("False", func(t *testing.T) {
+		obj, err := run(t, `obj.spec.paused = true
+return toggle(obj, "$.spec.paused", false)`)
+		require.NoError(t, err)
+		assert.False(t, paused(t, obj))
+	})
+	t.Run("Flip", func(t *testing.T) {
+		obj, err := run(t, `return toggle(toggle(toggle(obj, "spec.paused"), "spec.paused"), "spec.paused")`)
+		require.NoError(t, err)
+		assert.True(t, paused(t, obj))
+	})
+	t.Run("MissingPath", func(t *testing.T) {
+		_, err := run(t, `return toggle(obj, "spec.missing.paused", true)`)
+		require.ErrorContains(t, err, `path "spec.missing.paused" does not exist in the resource`)
+	})
+	t.Run("NotBoolean", func(t *testing.T) {
+		_, err := run(t, `return toggle(obj, "spec.replicas", true)`)
+		require.ErrorContains(t, err, `field "spec.replicas" is a number, not a boolean`)
+	})
+}
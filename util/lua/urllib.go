@@ -0,0 +1,46 @@
+package lua
+
+// urllib exposes the URL query escaping of the Go net/url package to the Lua scripts.
+
+import (
+	"net/url"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// URLLibName is the name of the URL library.
+const URLLibName = "url"
+
+func OpenURL(l *lua.LState) int {
+	mod := l.RegisterModule(URLLibName, urlFuncs)
+	l.Push(mod)
+	return 1
+}
+
+func URLLoader(l *lua.LState) int {
+	mod := l.SetFuncs(l.NewTable(), urlFuncs)
+	l.Push(mod)
+	return 1
+}
+
+var urlFuncs = map[string]lua.LGFunction{
+	"encode": urlEncode,
+	"decode": urlDecode,
+}
+
+// urlEncode escapes the string so that it can be safely placed inside a URL query.
+func urlEncode(l *lua.LState) int {
+	l.Push(lua.LString(url.QueryEscape(l.CheckString(1))))
+	return 1
+}
+
+// urlDecode reverses urlEncode, and raises an error if the string is not correctly escaped.
+func urlDecode(l *lua.LState) int {
+	s := l.CheckString(1)
+	decoded, err := url.QueryUnescape(s)
+	if err != nil {
+		l.RaiseError("invalid URL-encoded string %q: %s", s, err.Error())
+	}
+	l.Push(lua.LString(decoded))
+	return 1
+}
@@ -0,0 +1,52 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func TestURLLib(t *testing.T) {
+	vm := VM{}
+	run := func(t *testing.T, script string) *lua.LState {
+		t.Helper()
+		l, err := vm.runLua(StrToUnstructured(objJSON), script, nil)
+		require.NoError(t, err)
+		return l
+	}
+
+	t.Run("Encode", func(t *testing.T) {
+		l := run(t, `return url.encode("ref=refs/heads/main&path=a b/c?d#e")`)
+		assert.Equal(t, lua.LString("ref%3Drefs%2Fheads%2Fmain%26path%3Da+b%2Fc%3Fd%23e"), l.Get(-1))
+	})
+	t.Run("Decode", func(t *testing.T) {
+		l := run(t, `return url.decode("oci%3A%2F%2Fghcr.io%2Fstefanprodan%2Fmanifests%2Fpodinfo+v1")`)
+		assert.Equal(t, lua.LString("oci://ghcr.io/stefanprodan/manifests/podinfo v1"), l.Get(-1))
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		for _, value := range []string{
+			"",
+			"plain",
+			":/?#[]@!$&'()*+,;=%",
+			"space and+plus",
+			"https://example.com/path?query=value&other=a%20b",
+			"unicode ✓ привет",
+		} {
+			l, err := vm.runLua(StrToUnstructured(objJSON), `local value = actionParams["value"]
+return url.decode(url.encode(value)) == value, url.encode(value)`, NewParams().Set("value", value).Build())
+			require.NoError(t, err)
+			assert.Equal(t, lua.LTrue, l.Get(-2), "value %q encoded as %s", value, l.Get(-1))
+		}
+	})
+	t.Run("Require", func(t *testing.T) {
+		l := run(t, `local u = require("url")
+return u.encode("a b")`)
+		assert.Equal(t, lua.LString("a+b"), l.Get(-1))
+	})
+	t.Run("InvalidEncoding", func(t *testing.T) {
+		_, err := vm.runLua(StrToUnstructured(objJSON), `return url.decode("100%")`, nil)
+		require.ErrorContains(t, err, `invalid URL-encoded string "100%": invalid URL escape "%"`)
+	})
+}
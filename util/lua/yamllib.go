@@ -0,0 +1,55 @@
+package lua
+
+// yamllib exposes the YAML encoding and decoding of sigs.k8s.io/yaml to the Lua scripts, so that actions can edit the
+// YAML documents embedded in annotations and fields.
+
+import (
+	lua "github.com/yuin/gopher-lua"
+	luajson "layeh.com/gopher-json"
+	"sigs.k8s.io/yaml"
+)
+
+// YAMLLibName is the name of the YAML library.
+const YAMLLibName = "yaml"
+
+func OpenYAML(l *lua.LState) int {
+	mod := l.RegisterModule(YAMLLibName, yamlFuncs)
+	l.Push(mod)
+	return 1
+}
+
+func YAMLLoader(l *lua.LState) int {
+	mod := l.SetFuncs(l.NewTable(), yamlFuncs)
+	l.Push(mod)
+	return 1
+}
+
+var yamlFuncs = map[string]lua.LGFunction{
+	"encode": yamlEncode,
+	"decode": yamlDecode,
+}
+
+// yamlEncode returns the YAML document representing the value. Keys of tables are sorted, so that the same value is
+// always encoded to the same document.
+func yamlEncode(l *lua.LState) int {
+	jsonBytes, err := luajson.Encode(l.CheckAny(1))
+	if err != nil {
+		l.RaiseError("cannot encode value to YAML: %s", err.Error())
+	}
+	yamlBytes, err := yaml.JSONToYAML(jsonBytes)
+	if err != nil {
+		l.RaiseError("cannot encode value to YAML: %s", err.Error())
+	}
+	l.Push(lua.LString(yamlBytes))
+	return 1
+}
+
+// yamlDecode returns the value of the YAML document, with mappings and sequences decoded as tables.
+func yamlDecode(l *lua.LState) int {
+	var value any
+	if err := yaml.Unmarshal([]byte(l.CheckString(1)), &value); err != nil {
+		l.RaiseError("invalid YAML document: %s", err.Error())
+	}
+	l.Push(decodeValue(l, value))
+	return 1
+}
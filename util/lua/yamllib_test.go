@@ -0,0 +1,69 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+)
+
+const embeddedYAML = `image:
+  repository: guestbook
+  tag: v1
+ports:
+- 8080
+- 8443
+replicas: 3
+`
+
+func TestYAMLLib(t *testing.T) {
+	vm := VM{}
+	run := func(t *testing.T, script string) *lua.LState {
+		t.Helper()
+		l, err := vm.runLua(StrToUnstructured(objJSON), script, NewParams().Set("document", embeddedYAML).Build())
+		require.NoError(t, err)
+		return l
+	}
+
+	t.Run("Decode", func(t *testing.T) {
+		l := run(t, `local values = yaml.decode(actionParams["document"])
+return values.replicas, values.image.tag, values.ports[2]`)
+		assert.Equal(t, lua.LNumber(3), l.Get(-3))
+		assert.Equal(t, lua.LString("v1"), l.Get(-2))
+		assert.Equal(t, lua.LNumber(8443), l.Get(-1))
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		l := run(t, `return yaml.encode(yaml.decode(actionParams["document"]))`)
+		assert.Equal(t, lua.LString(embeddedYAML), l.Get(-1))
+	})
+	t.Run("Modify", func(t *testing.T) {
+		l := run(t, `local values = yaml.decode(actionParams["document"])
+values.image.tag = "v2"
+values.replicas = 0
+return yaml.encode(values)`)
+		assert.Equal(t, lua.LString(`image:
+  repository: guestbook
+  tag: v2
+ports:
+- 8080
+- 8443
+replicas: 0
+`), l.Get(-1))
+	})
+	t.Run("EncodeDeterministic", func(t *testing.T) {
+		l := run(t, `local value = {zeta = {b = 2, a = 1}, alpha = {"x", "y"}, middle = true}
+return yaml.encode(value), yaml.encode(value)`)
+		assert.Equal(t, lua.LString("alpha:\n- x\n- \"y\"\nmiddle: true\nzeta:\n  a: 1\n  b: 2\n"), l.Get(-2))
+		assert.Equal(t, l.Get(-2), l.Get(-1))
+	})
+	t.Run("Require", func(t *testing.T) {
+		l := run(t, `local y = require("yaml")
+return y.decode("key: value").key`)
+		assert.Equal(t, lua.LString("value"), l.Get(-1))
+	})
+	t.Run("InvalidDocument", func(t *testing.T) {
+		_, err := vm.runLua(StrToUnstructured(objJSON), `return yaml.decode("key: [unclosed")`, nil)
+		require.ErrorContains(t, err, "invalid YAML document: ")
+	})
+}
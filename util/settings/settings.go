@@ -465,6 +465,8 @@ const (
 	resourceInclusionsKey = "resource.inclusions"
 	// resourceIgnoreResourceUpdatesEnabledKey is the key to a boolean determining whether the resourceIgnoreUpdates feature is enabled
 	resourceIgnoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
+	// resourceActionConfigKey is the key to the map of values passed to the resource actions
+	resourceActionConfigKey = "resource.actionConfig"
 	// resourceSensitiveAnnotationsKey is the key to list of annotations to mask in secret resource
 	resourceSensitiveAnnotationsKey = "resource.sensitive.mask.annotations"
 	// resourceCustomLabelKey is the key to a custom label to show in node info, if present
@@ -891,6 +893,22 @@ func (mgr *SettingsManager) GetDeepLinks(deeplinkType string) ([]DeepLink, error
 	return deepLinks, nil
 }
 
+// GetResourceActionConfig returns the values passed to the resource actions, such as organization specific constants.
+func (mgr *SettingsManager) GetResourceActionConfig() (map[string]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	actionConfig := make(map[string]string)
+	if value, ok := argoCDCM.Data[resourceActionConfigKey]; ok {
+		err := yaml.Unmarshal([]byte(value), &actionConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshalling resource action config: %w", err)
+		}
+	}
+	return actionConfig, nil
+}
+
 func (mgr *SettingsManager) GetEnabledSourceTypes() (map[string]bool, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -1831,6 +1831,30 @@ func TestIsImpersonationEnabled(t *testing.T) {
 		"when user enables the flag in argocd-cm config map, IsImpersonationEnabled() must not return any error")
 }
 
+func TestSettingsManager_GetResourceActionConfig(t *testing.T) {
+	t.Run("Missing", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		actionConfig, err := settingsManager.GetResourceActionConfig()
+		require.NoError(t, err)
+		assert.Empty(t, actionConfig)
+	})
+	t.Run("Values", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			resourceActionConfigKey: "registry: registry.example.com\nnodeSelector: pool=default\n",
+		})
+		actionConfig, err := settingsManager.GetResourceActionConfig()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"registry": "registry.example.com", "nodeSelector": "pool=default"}, actionConfig)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			resourceActionConfigKey: "registry: [registry.example.com]",
+		})
+		_, err := settingsManager.GetResourceActionConfig()
+		require.ErrorContains(t, err, "error unmarshalling resource action config")
+	})
+}
+
 func TestSettingsManager_GetHideSecretAnnotations(t *testing.T) {
 	tests := []struct {
 		name   string